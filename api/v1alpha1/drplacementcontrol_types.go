@@ -76,6 +76,15 @@ const (
 	// Protected condition provides the latest available observation regarding the protection status of the workload,
 	// on the cluster it is expected to be available on.
 	ConditionProtected = "Protected"
+
+	// RecipeParametersValid condition provides the latest available observation regarding the validity of the
+	// recipe parameters against the parameters declared by the recipe. It is only present when the workload is
+	// protected with a recipe.
+	ConditionRecipeParametersValid = "RecipeParametersValid"
+
+	// SplitBrain condition provides the latest available observation regarding more than one cluster acting as the
+	// primary of the workload, and the resolution of it. It is only present once a split-brain has been detected.
+	ConditionSplitBrain = "SplitBrain"
 )
 
 const (
@@ -93,6 +102,19 @@ const (
 	ReasonProtected            = "Protected"
 )
 
+const (
+	ReasonRecipeParametersValid   = "Valid"
+	ReasonRecipeParametersInvalid = "Invalid"
+	ReasonRecipeParametersUnknown = "Unknown"
+	ReasonRecipeNotFound          = "RecipeNotFound"
+)
+
+const (
+	ReasonSplitBrainDetected  = "Detected"
+	ReasonSplitBrainResolving = "Resolving"
+	ReasonSplitBrainResolved  = "Resolved"
+)
+
 type ProgressionStatus string
 
 const (
@@ -136,6 +158,13 @@ type DRPlacementControlSpec struct {
 	// +kubebuilder:validation:Optional
 	ProtectedNamespaces *[]string `json:"protectedNamespaces,omitempty"`
 
+	// NamespaceMappings relate the protected namespaces that are named differently on the clusters of the
+	// DRPolicy, such as to recover the application in a different namespace on a peer cluster. The PVCs and
+	// kube objects of a namespace are recovered on a cluster in the namespace it is mapped to. The namespaces
+	// in ProtectedNamespaces may be named as on any of the clusters. Requires ProtectedNamespaces.
+	// +kubebuilder:validation:Optional
+	NamespaceMappings []NamespaceMapping `json:"namespaceMappings,omitempty"`
+
 	// DRPolicyRef is the reference to the DRPolicy participating in the DR replication for this DRPC
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="drPolicyRef is immutable"
@@ -163,6 +192,25 @@ type DRPlacementControlSpec struct {
 
 	// +optional
 	VolSyncSpec *VolSyncSpec `json:"volSyncSpec,omitempty"`
+
+	// ProtectOnly protects the application without modifying its PVCs and PVs, as set in the VRGs.
+	// +optional
+	ProtectOnly bool `json:"protectOnly,omitempty"`
+
+	// SplitBrainWinner is the cluster whose data is kept to resolve a split-brain, as reported in the SplitBrain
+	// condition. The VRGs on the other clusters are demoted to secondary and resynced from it, after which the DR
+	// action moves the workload to the cluster it places it on. It is only applied when set after the split-brain is
+	// detected.
+	// +optional
+	SplitBrainWinner string `json:"splitBrainWinner,omitempty"`
+}
+
+// NamespaceMapping relates the namespaces of an application that are named differently on the clusters of a
+// DRPolicy
+type NamespaceMapping struct {
+	// NamespaceNames are the names of the namespace on the clusters, by DRCluster name
+	// +kubebuilder:validation:MinProperties=2
+	NamespaceNames map[string]string `json:"namespaceNames"`
 }
 
 // PlacementDecision defines the decision made by controller
@@ -193,6 +241,10 @@ type VRGResourceMeta struct {
 	//+optional
 	ProtectedPVCs []string `json:"protectedpvcs,omitempty"`
 
+	// List of PVCs that are selected by the VRG resource, but deliberately excluded from protection
+	//+optional
+	ExcludedPVCs []ExcludedPVC `json:"excludedpvcs,omitempty"`
+
 	// List of CGs that are protected by the VRG resource
 	//+optional
 	PVCGroups []Groups `json:"pvcgroups,omitempty"`
@@ -203,6 +255,67 @@ type VRGResourceMeta struct {
 	ResourceVersion string `json:"resourceVersion,omitempty"`
 }
 
+// SplitBrainCluster is the state of the VRG on a cluster of a split-brain
+type SplitBrainCluster struct {
+	// ClusterName is the name of the cluster
+	ClusterName string `json:"clusterName"`
+
+	// State is the replication state of the VRG on the cluster
+	//+optional
+	State State `json:"state,omitempty"`
+
+	// VRGGeneration is the generation of the VRG on the cluster
+	//+optional
+	VRGGeneration int64 `json:"vrgGeneration,omitempty"`
+
+	// VRGObservedGeneration is the generation of the VRG on the cluster last processed
+	//+optional
+	VRGObservedGeneration int64 `json:"vrgObservedGeneration,omitempty"`
+
+	// LastKnownPrimary is whether the VRG on the cluster is the last known primary VRG in the S3 stores
+	//+optional
+	LastKnownPrimary bool `json:"lastKnownPrimary,omitempty"`
+
+	// SplitBrainPVCs are the PVCs whose VolumeReplication the storage reports in split-brain on the cluster
+	//+optional
+	SplitBrainPVCs []string `json:"splitBrainPVCs,omitempty"`
+}
+
+// SplitBrainResolution is the decision of the operator to resolve a split-brain
+type SplitBrainResolution struct {
+	// Winner is the cluster whose data is kept
+	Winner string `json:"winner"`
+
+	// Losers are the clusters whose VRGs are demoted to secondary and resynced from the winner
+	//+optional
+	Losers []string `json:"losers,omitempty"`
+
+	// DecisionTime is the time at which the winner was applied
+	DecisionTime metav1.Time `json:"decisionTime"`
+
+	// ResolvedTime is the time at which the VRGs of the losers were secondary, and no split-brain was reported
+	//+optional
+	ResolvedTime *metav1.Time `json:"resolvedTime,omitempty"`
+}
+
+// SplitBrainStatus is the state of the most recent split-brain of the workload
+type SplitBrainStatus struct {
+	// DetectedTime is the time at which the split-brain was detected
+	DetectedTime metav1.Time `json:"detectedTime"`
+
+	// DetectedGeneration is the generation of the DRPC when the split-brain was detected. A winner is only
+	// applied from a later generation.
+	DetectedGeneration int64 `json:"detectedGeneration"`
+
+	// Clusters are the states of the VRGs of the split-brain
+	//+optional
+	Clusters []SplitBrainCluster `json:"clusters,omitempty"`
+
+	// Resolution is the decision of the operator to resolve the split-brain
+	//+optional
+	Resolution *SplitBrainResolution `json:"resolution,omitempty"`
+}
+
 // VRGConditions represents the conditions of the resources deployed on a
 // managed cluster.
 type VRGConditions struct {
@@ -241,9 +354,44 @@ type DRPlacementControlStatus struct {
 	//+optional
 	LastGroupSyncBytes *int64 `json:"lastGroupSyncBytes,omitempty"`
 
+	// lastGroupSyncSlowestPVC is the namespaced name of the PVC whose most recent successful
+	// synchronization is the oldest, or took the longest, which the group sync waits on
+	//+optional
+	LastGroupSyncSlowestPVC string `json:"lastGroupSyncSlowestPVC,omitempty"`
+
+	// lastGroupSyncSlowestPVCError is the reason the most recent synchronization of the
+	// lastGroupSyncSlowestPVC failed or is stuck
+	//+optional
+	LastGroupSyncSlowestPVCError string `json:"lastGroupSyncSlowestPVCError,omitempty"`
+
+	// syncDeferredUntil is the time at which the next sync window opens, while the syncs of
+	// the PVCs are deferred to it
+	//+optional
+	SyncDeferredUntil *metav1.Time `json:"syncDeferredUntil,omitempty"`
+
+	// groupSyncProgress is the progress of the syncs in progress of the PVCs protected by
+	// VolSync, in total
+	//+optional
+	GroupSyncProgress *VolSyncSyncProgress `json:"groupSyncProgress,omitempty"`
+
+	// replicationTypes is the progress of the PVCs of the VRG by replication type, for a workload
+	// whose PVCs are replicated by more than one
+	//+optional
+	ReplicationTypes []ReplicationTypeStatus `json:"replicationTypes,omitempty"`
+
+	// lastVolSyncPSKRotationTime is the time at which the pre-shared key of the VolSync
+	// replication secret was last rotated, or created
+	//+optional
+	LastVolSyncPSKRotationTime *metav1.Time `json:"lastVolSyncPSKRotationTime,omitempty"`
+
 	// lastKubeObjectProtectionTime is the time of the most recent successful kube object protection
 	//+optional
 	LastKubeObjectProtectionTime *metav1.Time `json:"lastKubeObjectProtectionTime,omitempty"`
+
+	// splitBrain is the most recent split-brain of the workload, where more than one cluster acted as
+	// its primary, and its resolution
+	//+optional
+	SplitBrain *SplitBrainStatus `json:"splitBrain,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -49,6 +49,21 @@ type DRPolicySpec struct {
 	// +kubebuilder:validation:XValidation:rule="size(self) == 2", message="drClusters requires a list of 2 clusters"
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf", message="drClusters is immutable"
 	DRClusters []string `json:"drClusters"`
+
+	// StorageClassMappings relate the StorageClasses of the same storage that are named differently
+	// on the clusters of the policy. PVCs are restored on a cluster with the StorageClass that their
+	// StorageClass is mapped to. They override the mappings derived from the storage IDs of the
+	// StorageClasses, reported in the status.
+	//+optional
+	StorageClassMappings []StorageClassMapping `json:"storageClassMappings,omitempty"`
+}
+
+// StorageClassMapping relates StorageClasses of the same storage that are named differently on the
+// clusters of a policy
+type StorageClassMapping struct {
+	// StorageClassNames are the names of the StorageClass on the clusters, by DRCluster name
+	// +kubebuilder:validation:MinProperties=2
+	StorageClassNames map[string]string `json:"storageClassNames"`
 }
 
 // DRPolicyStatus defines the observed state of DRPolicy
@@ -64,6 +79,12 @@ type DRPolicyStatus struct {
 	// sync replication details between the clusters in the policy
 	//+optional
 	Sync Sync `json:"sync,omitempty"`
+
+	// StorageClassMappings relate the StorageClasses that are named differently on the clusters of
+	// the policy, and that have the same provisioner and the same value for the label
+	// "ramendr.openshift.io/storageid", where the match is unique
+	//+optional
+	StorageClassMappings []StorageClassMapping `json:"storageClassMappings,omitempty"`
 }
 
 // for RDR
@@ -128,6 +128,10 @@ type RamenConfig struct {
 	// Map of S3 store profiles
 	S3StoreProfiles []S3StoreProfile `json:"s3StoreProfiles,omitempty"`
 
+	// S3UploadConcurrency is the maximum number of PV and PVC cluster data objects of a VRG that are
+	// uploaded to, or downloaded from, an S3 store in parallel. Defaults to 10.
+	S3UploadConcurrency int `json:"s3UploadConcurrency,omitempty"`
+
 	// MaxConcurrentReconciles is the maximum number of concurrent Reconciles which can be run.
 	// Defaults to 1.
 	MaxConcurrentReconciles int `json:"maxConcurrentReconciles,omitempty"`
@@ -173,6 +177,19 @@ type RamenConfig struct {
 		// from source to destination. Should be Snapshot/Direct
 		// default: Snapshot
 		DestinationCopyMethod string `json:"destinationCopyMethod,omitempty"`
+
+		// pskRotationInterval is the interval at which the pre-shared keys of the VolSync
+		// replication secrets of the DRPCs are rotated.
+		// default: 2160h (90 days)
+		PSKRotationInterval metav1.Duration `json:"pskRotationInterval,omitempty"`
+
+		// pskRotationDisabled disables the rotation of the pre-shared keys. Defaults to false.
+		PSKRotationDisabled bool `json:"pskRotationDisabled,omitempty"`
+
+		// verificationImage is the image of the jobs checksumming the files of PVCs to verify
+		// their destination copies. It needs a shell, find, stat, cksum, wc and sha256sum.
+		// default: quay.io/backube/volsync:0.11.0
+		VerificationImage string `json:"verificationImage,omitempty"`
 	} `json:"volSync,omitempty"`
 
 	KubeObjectProtection struct {
@@ -180,6 +197,12 @@ type RamenConfig struct {
 		Disabled bool `json:"disabled,omitempty"`
 		// Velero namespace input
 		VeleroNamespaceName string `json:"veleroNamespaceName,omitempty"`
+		// Maximum number of pods an exec hook operation runs on in parallel.
+		// Defaults to 10.
+		ExecHookConcurrency int `json:"execHookConcurrency,omitempty"`
+		// Enables the validating admission webhook for Recipes. Requires the
+		// webhook service and serving certificate to be deployed.
+		RecipeValidationWebhookEnabled bool `json:"recipeValidationWebhookEnabled,omitempty"`
 	} `json:"kubeObjectProtection,omitempty"`
 
 	MultiNamespace struct {
@@ -191,6 +214,11 @@ type RamenConfig struct {
 	// Unprotect deleted or deselected PVCs
 	VolumeUnprotectionEnabled bool `json:"volumeUnprotectionEnabled,omitempty"`
 
+	// VolumeReplicationSplitBrainReasons are the reasons of the true Degraded condition of a VolumeReplication with
+	// which the storage driver reports its volume in split-brain, as the replication API does not define one.
+	// Defaults to SplitBrain.
+	VolumeReplicationSplitBrainReasons []string `json:"volumeReplicationSplitBrainReasons,omitempty"`
+
 	// RamenOpsNamespace is the namespace where resources for unmanaged apps are created
 	RamenOpsNamespace string `json:"ramenOpsNamespace,omitempty"`
 }
@@ -46,6 +46,9 @@ type ReplicationGroupDestinationStatus struct {
 	LatestImages map[string]*corev1.TypedLocalObjectReference `json:"latestImage,omitempty"`
 	// Created ReplicationDestinations by this ReplicationGroupDestination
 	ReplicationDestinations []*corev1.ObjectReference `json:"replicationDestinations,omitempty"`
+	// members are the statuses of the replication of each PVC of the group
+	//+optional
+	Members []ReplicationGroupMemberStatus `json:"members,omitempty"`
 }
 
 //+kubebuilder:object:root=true
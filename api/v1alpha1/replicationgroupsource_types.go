@@ -66,6 +66,35 @@ type ReplicationGroupSourceStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// Created ReplicationSources by this ReplicationGroupSource
 	ReplicationSources []*corev1.ObjectReference `json:"replicationSources,omitempty"`
+	// members are the statuses of the replication of each PVC of the group
+	//+optional
+	Members []ReplicationGroupMemberStatus `json:"members,omitempty"`
+}
+
+// ReplicationGroupMemberStatus is the status of the replication of a PVC of a replication group,
+// by its ReplicationSource or ReplicationDestination
+type ReplicationGroupMemberStatus struct {
+	// pvcName is the name of the application PVC
+	PVCName string `json:"pvcName"`
+	// restoredPVCName is the name of the PVC restored from the group snapshot, which the
+	// ReplicationSource replicates
+	//+optional
+	RestoredPVCName string `json:"restoredPVCName,omitempty"`
+	// name of the ReplicationSource or ReplicationDestination
+	Name string `json:"name"`
+	// lastSyncTime is the time of the most recent successful synchronization of the PVC
+	//+optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// lastSyncDuration is the time the most recent synchronization of the PVC took
+	//+optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+	// lastSyncBytes is the number of bytes sent by the source, or received by the destination,
+	// in the most recent synchronization of the PVC, as reported by the mover
+	//+optional
+	LastSyncBytes *int64 `json:"lastSyncBytes,omitempty"`
+	// error is the reason the most recent synchronization of the PVC failed or is stuck
+	//+optional
+	Error string `json:"error,omitempty"`
 }
 
 //+kubebuilder:object:root=true
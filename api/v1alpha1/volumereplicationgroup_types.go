@@ -7,6 +7,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -133,8 +134,123 @@ type VolSyncSpec struct {
 
 	//+optional
 	MoverConfig []MoverConfig `json:"moverConfig,omitempty"`
+
+	// mover is the VolSync data mover that replicates the PVCs. RsyncTLS, the default,
+	// replicates directly to the peer cluster. Restic and Rclone replicate through a
+	// repository in an object store, and need no network path between the clusters.
+	//+optional
+	Mover VolSyncMoverType `json:"mover,omitempty"`
+
+	// moverS3ProfileName is the S3 profile of the Restic or Rclone repository. Defaults
+	// to the first of the VRG S3 profiles.
+	//+optional
+	MoverS3ProfileName string `json:"moverS3ProfileName,omitempty"`
+
+	// bandwidthLimit caps the bandwidth, in bits per second, of the data mover of each PVC,
+	// for example 200M. It is enforced by the Rclone mover only, as the RsyncTLS and Restic
+	// movers have no such setting.
+	//+optional
+	BandwidthLimit *resource.Quantity `json:"bandwidthLimit,omitempty"`
+
+	// syncWindows are the daily time windows, in UTC, within which PVCs are synced on
+	// schedule. Scheduled syncs are deferred to the next window, while the final sync of a
+	// relocation is not. PVCs are synced at any time if there are no windows.
+	//+optional
+	SyncWindows []SyncWindow `json:"syncWindows,omitempty"`
+
+	// verification configures the periodic verification of the destination copies of the PVCs
+	// against their sources. PVCs are not verified if it is not set.
+	//+optional
+	Verification *VolSyncVerification `json:"verification,omitempty"`
+
+	// finalSyncSkips are the PVCs of consistency groups that are relocated without a final
+	// sync, for example as their final sync cannot complete
+	//+optional
+	FinalSyncSkips []VolSyncFinalSyncSkip `json:"finalSyncSkips,omitempty"`
+
+	// snapshotRetention retains past images of the destinations of the PVCs, as points to which
+	// they can be recovered. Only the latest image of a PVC is kept if it is not set.
+	//+optional
+	SnapshotRetention *VolSyncSnapshotRetention `json:"snapshotRetention,omitempty"`
+}
+
+// VolSyncSnapshotRetention is the policy by which the images of the destination of a PVC are
+// retained. An image is retained if any of the rules retains it, and the latest image always is.
+type VolSyncSnapshotRetention struct {
+	// last is the number of most recent images that are retained
+	// +kubebuilder:validation:Minimum=0
+	//+optional
+	Last int32 `json:"last,omitempty"`
+
+	// hourly is the number of most recent hours, of those with images, whose last image is retained
+	// +kubebuilder:validation:Minimum=0
+	//+optional
+	Hourly int32 `json:"hourly,omitempty"`
+
+	// daily is the number of most recent days, of those with images, whose last image is retained
+	// +kubebuilder:validation:Minimum=0
+	//+optional
+	Daily int32 `json:"daily,omitempty"`
+}
+
+// VolSyncFinalSyncSkip excludes a PVC of a consistency group from the final sync of a relocation.
+// The PVC is relocated with the data of its last scheduled sync, so its changes since are lost.
+type VolSyncFinalSyncSkip struct {
+	// name of the PVC
+	Name string `json:"name"`
+
+	// namespace of the PVC. Defaults to the namespace of the VRG.
+	//+optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// acknowledgeDataLoss must be true for the PVC to be skipped, to acknowledge that its
+	// changes since its last scheduled sync are lost
+	AcknowledgeDataLoss bool `json:"acknowledgeDataLoss"`
+}
+
+// SyncWindow is a daily time window, in whole hours, which ends past midnight if its end is
+// before its start, for example from 22:00 to 06:00. It is the whole day if both are equal.
+type SyncWindow struct {
+	// +kubebuilder:validation:Pattern=`^([01]\d|2[0-3]):00$`
+	Start string `json:"start"`
+
+	// +kubebuilder:validation:Pattern=`^([01]\d|2[0-3]):00$`
+	End string `json:"end"`
 }
 
+// VolSyncVerificationMode is the extent of a verification of the destination copy of a PVC
+// +kubebuilder:validation:Enum=Sample;Full
+type VolSyncVerificationMode string
+
+const (
+	VolSyncVerificationModeSample = VolSyncVerificationMode("Sample")
+	VolSyncVerificationModeFull   = VolSyncVerificationMode("Full")
+)
+
+// VolSyncVerification configures the comparison of the checksums of the files of a snapshot of
+// the source of a PVC, and of the latest image of its destination.
+type VolSyncVerification struct {
+	// interval between the verifications of a PVC, of the form <num><m,h,d>
+	// +kubebuilder:validation:Pattern=`^\d+[mhd]$`
+	Interval string `json:"interval"`
+
+	// mode is Sample, to compare a different sample of the files at each verification, or Full,
+	// to compare all of them
+	// +kubebuilder:default=Sample
+	//+optional
+	Mode VolSyncVerificationMode `json:"mode,omitempty"`
+}
+
+// VolSyncMoverType is the VolSync data mover used to replicate PVCs
+// +kubebuilder:validation:Enum=RsyncTLS;Restic;Rclone
+type VolSyncMoverType string
+
+const (
+	VolSyncMoverRsyncTLS = VolSyncMoverType("RsyncTLS")
+	VolSyncMoverRestic   = VolSyncMoverType("Restic")
+	VolSyncMoverRclone   = VolSyncMoverType("Rclone")
+)
+
 type MoverConfig struct {
 	// MoverSecurityContext allows specifying the PodSecurityContext that will
 	// be used by the data mover
@@ -262,6 +378,25 @@ type VolumeReplicationGroupSpec struct {
 	// You can use a recipe to filter and coordinate the order of the resources that are protected.
 	//+optional
 	ProtectedNamespaces *[]string `json:"protectedNamespaces,omitempty"`
+
+	// StorageClassMapping maps the names of the StorageClasses of the peer clusters to the names of the
+	// StorageClasses of the same storage on this cluster. PVs and PVCs, and the kube objects, of the
+	// peer clusters are restored with the mapped StorageClass.
+	//+optional
+	StorageClassMapping map[string]string `json:"storageClassMapping,omitempty"`
+
+	// NamespaceMapping maps the names of the protected namespaces of the peer clusters to the names of
+	// the same namespaces on this cluster. PVs, PVCs and the kube objects of the peer clusters are
+	// restored in the mapped namespace, and VolSync replicates to the namespace of a peer cluster.
+	//+optional
+	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
+
+	// ProtectOnly protects the PVCs without modifying them or their PVs. The VRG does not own the
+	// PVCs, add finalizers, annotations or consistency group labels to them, or retain their PVs, and
+	// keeps track of their protection in its status instead. The guarantees that are lost are
+	// reported in the ProtectOnly condition.
+	//+optional
+	ProtectOnly bool `json:"protectOnly,omitempty"`
 }
 
 type Identifier struct {
@@ -354,8 +489,226 @@ type ProtectedPVC struct {
 	// Bytes transferred per sync, if protected in async mode only
 	LastSyncBytes *int64 `json:"lastSyncBytes,omitempty"`
 
+	// Reason the most recent synchronization of the PVC failed or is stuck,
+	// if protected in the volsync mode in a consistency group
+	//+optional
+	LastSyncError string `json:"lastSyncError,omitempty"`
+
 	// VolumeMode describes how a volume is intended to be consumed, either Block or Filesystem.
 	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+
+	// Scheduling interval at which the PVC is replicated, if protected in the async or
+	// volsync mode. It is the VRG scheduling interval unless overridden for the PVC.
+	//+optional
+	SchedulingInterval string `json:"schedulingInterval,omitempty"`
+
+	// Time at which the next sync window opens, while the syncs of the PVC are deferred to it
+	//+optional
+	SyncDeferredUntil *metav1.Time `json:"syncDeferredUntil,omitempty"`
+
+	// Result of the most recent verification of the destination copy of the PVC
+	//+optional
+	Verification *VolSyncVerificationStatus `json:"verification,omitempty"`
+
+	// Progress of the sync of the PVC in progress, if protected in the volsync mode
+	//+optional
+	SyncProgress *VolSyncSyncProgress `json:"syncProgress,omitempty"`
+
+	// Identifies the version of the PV and PVC of the PVC last uploaded to the S3 stores, if protected
+	// in the protect only mode, in which the PVC and PV are not annotated with it
+	//+optional
+	ArchivedClusterData string `json:"archivedClusterData,omitempty"`
+
+	// State of the VolumeReplication, or VolumeGroupReplication, of the PVC as reported by the
+	// storage driver, if protected in the volrep mode
+	//+optional
+	VolumeReplicationState *VolumeReplicationState `json:"volumeReplicationState,omitempty"`
+}
+
+// VolumeReplicationState is the state of the VolumeReplication, or VolumeGroupReplication, of a PVC
+type VolumeReplicationState struct {
+	// State of the volume, as reported by the storage driver: Primary, Secondary or Unknown
+	//+optional
+	State string `json:"state,omitempty"`
+
+	// Message of the storage driver about the state, and about the degradation or resync of the
+	// replication of the volume if any
+	//+optional
+	Message string `json:"message,omitempty"`
+
+	// Degraded is true when the replication of the volume is degraded, while it is not resyncing
+	//+optional
+	Degraded bool `json:"degraded,omitempty"`
+
+	// Resyncing is true when the volume is resyncing from its peer
+	//+optional
+	Resyncing bool `json:"resyncing,omitempty"`
+
+	// SplitBrain is true when the storage driver reports the volume and its peer in split-brain,
+	// both having diverged as primaries
+	//+optional
+	SplitBrain bool `json:"splitBrain,omitempty"`
+
+	// Time of the most recent successful sync of the volume, as reported by the storage driver
+	//+optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// Number of resyncs of the volume observed since it was protected
+	//+optional
+	ResyncCount int32 `json:"resyncCount,omitempty"`
+
+	// Time at which the most recent resync was observed to start
+	//+optional
+	LastResyncStartTime *metav1.Time `json:"lastResyncStartTime,omitempty"`
+}
+
+// VolSyncSyncPhase is the phase of a sync of a PVC protected by VolSync
+type VolSyncSyncPhase string
+
+const (
+	// The image of the source of the sync is being taken, and its mover started
+	VolSyncSyncPhasePreparing = VolSyncSyncPhase("Preparing")
+
+	// The mover is transferring the data of the image to the destination
+	VolSyncSyncPhaseTransferring = VolSyncSyncPhase("Transferring")
+
+	// The transfer is complete, and the image and mover of the sync are being cleaned up
+	VolSyncSyncPhaseCleaningUp = VolSyncSyncPhase("CleaningUp")
+)
+
+// VolSyncSyncProgress is the progress of a sync in progress of PVCs protected by VolSync. The
+// transfer is reported by the Restic and Rclone movers only, the RsyncTLS mover only reports
+// its phase.
+type VolSyncSyncProgress struct {
+	// Phase of the sync
+	Phase VolSyncSyncPhase `json:"phase"`
+
+	// Time at which the sync started
+	//+optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Bytes transferred so far, if reported by the mover
+	//+optional
+	BytesTransferred *int64 `json:"bytesTransferred,omitempty"`
+
+	// Total bytes to transfer, if reported by the mover
+	//+optional
+	TotalBytes *int64 `json:"totalBytes,omitempty"`
+
+	// Estimated time at which the transfer completes, if reported by the mover
+	//+optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+}
+
+// VolSyncVerificationResult is the result of a verification of the destination copy of a PVC
+type VolSyncVerificationResult string
+
+const (
+	// The files compared have the same checksums in the source and the destination
+	VolSyncVerificationVerified = VolSyncVerificationResult("Verified")
+
+	// Files of the same size and modification time have different checksums in the source and
+	// the destination
+	VolSyncVerificationDiverged = VolSyncVerificationResult("Diverged")
+
+	// The source or the destination could not be checksummed
+	VolSyncVerificationFailed = VolSyncVerificationResult("Failed")
+)
+
+// ExcludedPVC is a PVC that is selected by the VRG, but deliberately excluded from protection
+type ExcludedPVC struct {
+	// Name of the namespace the PVC is in
+	Namespace string `json:"namespace"`
+
+	// Name of the PVC
+	Name string `json:"name"`
+
+	// reason is the reason the PVC is excluded, as given by its exclusion annotation
+	//+optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// ReplicationType is the mechanism by which the PVCs of a VRG are replicated
+// +kubebuilder:validation:Enum=VolRep;VolSync;Offloaded
+type ReplicationType string
+
+const (
+	ReplicationTypeVolRep    ReplicationType = "VolRep"
+	ReplicationTypeVolSync   ReplicationType = "VolSync"
+	ReplicationTypeOffloaded ReplicationType = "Offloaded"
+)
+
+// ReplicationTypeStatus is the progress of the PVCs of a VRG that are replicated by a replication type
+type ReplicationTypeStatus struct {
+	Type ReplicationType `json:"type"`
+
+	// pvcCount is the number of PVCs replicated by the replication type
+	PVCCount int `json:"pvcCount"`
+
+	// dataProtectedPVCCount is the number of those PVCs whose data is protected
+	//+optional
+	DataProtectedPVCCount int `json:"dataProtectedPVCCount,omitempty"`
+
+	// finalSyncPrepared is true when the PVCs are prepared for the final sync of a relocation
+	//+optional
+	FinalSyncPrepared bool `json:"finalSyncPrepared,omitempty"`
+
+	// finalSyncComplete is true when the final sync of the PVCs is complete. The final sync of
+	// the PVCs replicated by VolRep, or offloaded, is taken by their demotion to secondary, which
+	// they are ready for once they are no longer in use.
+	//+optional
+	FinalSyncComplete bool `json:"finalSyncComplete,omitempty"`
+
+	//+optional
+	Message string `json:"message,omitempty"`
+}
+
+// VolSyncRecoveryPoints are the retained images of the destination of a PVC
+type VolSyncRecoveryPoints struct {
+	// Name of the namespace the PVC is in
+	Namespace string `json:"namespace"`
+
+	// Name of the PVC
+	Name string `json:"name"`
+
+	// points are the retained images, from the most recent
+	//+optional
+	Points []VolSyncRecoveryPoint `json:"points,omitempty"`
+}
+
+// VolSyncRecoveryPoint is an image of the destination of a PVC to which it can be recovered
+type VolSyncRecoveryPoint struct {
+	// snapshotName is the name of the VolumeSnapshot of the image
+	SnapshotName string `json:"snapshotName"`
+
+	// time at which the image was taken
+	Time metav1.Time `json:"time"`
+}
+
+type VolSyncVerificationStatus struct {
+	// time at which the source was snapshotted for the most recent verification
+	//+optional
+	Time *metav1.Time `json:"time,omitempty"`
+
+	//+optional
+	Result VolSyncVerificationResult `json:"result,omitempty"`
+
+	// filesCompared is the number of files of the same size and modification time in the
+	// source and the destination, whose checksums were compared
+	//+optional
+	FilesCompared int `json:"filesCompared,omitempty"`
+
+	// divergedFiles are the paths of the first files whose checksums differ
+	//+optional
+	DivergedFiles []string `json:"divergedFiles,omitempty"`
+
+	//+optional
+	Message string `json:"message,omitempty"`
+
+	// inProgressSince is the time at which the source was snapshotted for an ongoing
+	// verification, whose destination is being checksummed
+	//+optional
+	InProgressSince *metav1.Time `json:"inProgressSince,omitempty"`
 }
 
 type KubeObjectsCaptureIdentifier struct {
@@ -392,6 +745,12 @@ type VolumeReplicationGroupStatus struct {
 
 	// All the protected pvcs
 	ProtectedPVCs []ProtectedPVC `json:"protectedPVCs,omitempty"`
+
+	// excludedPVCs are the PVCs selected by the VRG that are excluded from protection by their
+	// exclusion annotation, with its reason
+	//+optional
+	ExcludedPVCs []ExcludedPVC `json:"excludedPVCs,omitempty"`
+
 	// List of CGs that are protected by the VRG resource
 	//+optional
 	PVCGroups []Groups `json:"pvcgroups,omitempty"`
@@ -414,6 +773,11 @@ type VolumeReplicationGroupStatus struct {
 	PrepareForFinalSyncComplete bool `json:"prepareForFinalSyncComplete,omitempty"`
 	FinalSyncComplete           bool `json:"finalSyncComplete,omitempty"`
 
+	// replicationTypes is the progress of the PVCs by replication type, for a VRG that protects
+	// PVCs with more than one
+	//+optional
+	ReplicationTypes []ReplicationTypeStatus `json:"replicationTypes,omitempty"`
+
 	// lastGroupSyncTime is the time of the most recent successful synchronization of all PVCs
 	//+optional
 	LastGroupSyncTime *metav1.Time `json:"lastGroupSyncTime,omitempty"`
@@ -426,6 +790,26 @@ type VolumeReplicationGroupStatus struct {
 	// successful synchronization of all PVCs
 	//+optional
 	LastGroupSyncBytes *int64 `json:"lastGroupSyncBytes,omitempty"`
+
+	// syncDeferredUntil is the time at which the next sync window opens, while the syncs of
+	// the PVCs are deferred to it
+	//+optional
+	SyncDeferredUntil *metav1.Time `json:"syncDeferredUntil,omitempty"`
+
+	// groupSyncProgress is the progress of the syncs in progress of the PVCs protected by
+	// VolSync, in total
+	//+optional
+	GroupSyncProgress *VolSyncSyncProgress `json:"groupSyncProgress,omitempty"`
+
+	// volSyncPSKIdentities are the identities of the keys of the VolSync replication secret, in
+	// the order in which they are used
+	//+optional
+	VolSyncPSKIdentities []string `json:"volSyncPSKIdentities,omitempty"`
+
+	// volSyncRecoveryPoints are the images of the destinations of the PVCs protected by VolSync
+	// that are retained by the snapshot retention, by PVC
+	//+optional
+	VolSyncRecoveryPoints []VolSyncRecoveryPoints `json:"volSyncRecoveryPoints,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -399,6 +399,13 @@ func (in *DRPlacementControlSpec) DeepCopyInto(out *DRPlacementControlSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.NamespaceMappings != nil {
+		in, out := &in.NamespaceMappings, &out.NamespaceMappings
+		*out = make([]NamespaceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	out.DRPolicyRef = in.DRPolicyRef
 	in.PVCSelector.DeepCopyInto(&out.PVCSelector)
 	if in.KubeObjectProtection != nil {
@@ -462,10 +469,33 @@ func (in *DRPlacementControlStatus) DeepCopyInto(out *DRPlacementControlStatus)
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SyncDeferredUntil != nil {
+		in, out := &in.SyncDeferredUntil, &out.SyncDeferredUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.GroupSyncProgress != nil {
+		in, out := &in.GroupSyncProgress, &out.GroupSyncProgress
+		*out = new(VolSyncSyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicationTypes != nil {
+		in, out := &in.ReplicationTypes, &out.ReplicationTypes
+		*out = make([]ReplicationTypeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastVolSyncPSKRotationTime != nil {
+		in, out := &in.LastVolSyncPSKRotationTime, &out.LastVolSyncPSKRotationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.LastKubeObjectProtectionTime != nil {
 		in, out := &in.LastKubeObjectProtectionTime, &out.LastKubeObjectProtectionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.SplitBrain != nil {
+		in, out := &in.SplitBrain, &out.SplitBrain
+		*out = new(SplitBrainStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPlacementControlStatus.
@@ -548,6 +578,13 @@ func (in *DRPolicySpec) DeepCopyInto(out *DRPolicySpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StorageClassMappings != nil {
+		in, out := &in.StorageClassMappings, &out.StorageClassMappings
+		*out = make([]StorageClassMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicySpec.
@@ -572,6 +609,13 @@ func (in *DRPolicyStatus) DeepCopyInto(out *DRPolicyStatus) {
 	}
 	in.Async.DeepCopyInto(&out.Async)
 	in.Sync.DeepCopyInto(&out.Sync)
+	if in.StorageClassMappings != nil {
+		in, out := &in.StorageClassMappings, &out.StorageClassMappings
+		*out = make([]StorageClassMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DRPolicyStatus.
@@ -584,6 +628,21 @@ func (in *DRPolicyStatus) DeepCopy() *DRPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExcludedPVC) DeepCopyInto(out *ExcludedPVC) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExcludedPVC.
+func (in *ExcludedPVC) DeepCopy() *ExcludedPVC {
+	if in == nil {
+		return nil
+	}
+	out := new(ExcludedPVC)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Groups) DeepCopyInto(out *Groups) {
 	*out = *in
@@ -833,6 +892,28 @@ func (in *MoverConfig) DeepCopy() *MoverConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceMapping) DeepCopyInto(out *NamespaceMapping) {
+	*out = *in
+	if in.NamespaceNames != nil {
+		in, out := &in.NamespaceNames, &out.NamespaceNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMapping.
+func (in *NamespaceMapping) DeepCopy() *NamespaceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PeerClass) DeepCopyInto(out *PeerClass) {
 	*out = *in
@@ -928,6 +1009,25 @@ func (in *ProtectedPVC) DeepCopyInto(out *ProtectedPVC) {
 		*out = new(corev1.PersistentVolumeMode)
 		**out = **in
 	}
+	if in.SyncDeferredUntil != nil {
+		in, out := &in.SyncDeferredUntil, &out.SyncDeferredUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VolSyncVerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SyncProgress != nil {
+		in, out := &in.SyncProgress, &out.SyncProgress
+		*out = new(VolSyncSyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeReplicationState != nil {
+		in, out := &in.VolumeReplicationState, &out.VolumeReplicationState
+		*out = new(VolumeReplicationState)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtectedPVC.
@@ -1063,6 +1163,11 @@ func (in *RamenConfig) DeepCopyInto(out *RamenConfig) {
 	out.VolSync = in.VolSync
 	out.KubeObjectProtection = in.KubeObjectProtection
 	out.MultiNamespace = in.MultiNamespace
+	if in.VolumeReplicationSplitBrainReasons != nil {
+		in, out := &in.VolumeReplicationSplitBrainReasons, &out.VolumeReplicationSplitBrainReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RamenConfig.
@@ -1234,6 +1339,13 @@ func (in *ReplicationGroupDestinationStatus) DeepCopyInto(out *ReplicationGroupD
 			}
 		}
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ReplicationGroupMemberStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupDestinationStatus.
@@ -1246,6 +1358,35 @@ func (in *ReplicationGroupDestinationStatus) DeepCopy() *ReplicationGroupDestina
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationGroupMemberStatus) DeepCopyInto(out *ReplicationGroupMemberStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastSyncDuration != nil {
+		in, out := &in.LastSyncDuration, &out.LastSyncDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.LastSyncBytes != nil {
+		in, out := &in.LastSyncBytes, &out.LastSyncBytes
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupMemberStatus.
+func (in *ReplicationGroupMemberStatus) DeepCopy() *ReplicationGroupMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationGroupMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReplicationGroupSource) DeepCopyInto(out *ReplicationGroupSource) {
 	*out = *in
@@ -1375,6 +1516,13 @@ func (in *ReplicationGroupSourceStatus) DeepCopyInto(out *ReplicationGroupSource
 			}
 		}
 	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ReplicationGroupMemberStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationGroupSourceStatus.
@@ -1407,6 +1555,21 @@ func (in *ReplicationSourceTriggerSpec) DeepCopy() *ReplicationSourceTriggerSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationTypeStatus) DeepCopyInto(out *ReplicationTypeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationTypeStatus.
+func (in *ReplicationTypeStatus) DeepCopy() *ReplicationTypeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationTypeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RsyncTLSConfig) DeepCopyInto(out *RsyncTLSConfig) {
 	*out = *in
@@ -1453,6 +1616,101 @@ func (in *S3StoreProfile) DeepCopy() *S3StoreProfile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBrainCluster) DeepCopyInto(out *SplitBrainCluster) {
+	*out = *in
+	if in.SplitBrainPVCs != nil {
+		in, out := &in.SplitBrainPVCs, &out.SplitBrainPVCs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBrainCluster.
+func (in *SplitBrainCluster) DeepCopy() *SplitBrainCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBrainCluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBrainResolution) DeepCopyInto(out *SplitBrainResolution) {
+	*out = *in
+	if in.Losers != nil {
+		in, out := &in.Losers, &out.Losers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DecisionTime.DeepCopyInto(&out.DecisionTime)
+	if in.ResolvedTime != nil {
+		in, out := &in.ResolvedTime, &out.ResolvedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBrainResolution.
+func (in *SplitBrainResolution) DeepCopy() *SplitBrainResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBrainResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplitBrainStatus) DeepCopyInto(out *SplitBrainStatus) {
+	*out = *in
+	in.DetectedTime.DeepCopyInto(&out.DetectedTime)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]SplitBrainCluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(SplitBrainResolution)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplitBrainStatus.
+func (in *SplitBrainStatus) DeepCopy() *SplitBrainStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SplitBrainStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassMapping) DeepCopyInto(out *StorageClassMapping) {
+	*out = *in
+	if in.StorageClassNames != nil {
+		in, out := &in.StorageClassNames, &out.StorageClassNames
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassMapping.
+func (in *StorageClassMapping) DeepCopy() *StorageClassMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageIdentifiers) DeepCopyInto(out *StorageIdentifiers) {
 	*out = *in
@@ -1492,6 +1750,21 @@ func (in *Sync) DeepCopy() *Sync {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SyncWindow) DeepCopyInto(out *SyncWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SyncWindow.
+func (in *SyncWindow) DeepCopy() *SyncWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(SyncWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VRGAsyncSpec) DeepCopyInto(out *VRGAsyncSpec) {
 	*out = *in
@@ -1548,6 +1821,11 @@ func (in *VRGResourceMeta) DeepCopyInto(out *VRGResourceMeta) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExcludedPVCs != nil {
+		in, out := &in.ExcludedPVCs, &out.ExcludedPVCs
+		*out = make([]ExcludedPVC, len(*in))
+		copy(*out, *in)
+	}
 	if in.PVCGroups != nil {
 		in, out := &in.PVCGroups, &out.PVCGroups
 		*out = make([]Groups, len(*in))
@@ -1589,6 +1867,59 @@ func (in *VRGSyncSpec) DeepCopy() *VRGSyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncFinalSyncSkip) DeepCopyInto(out *VolSyncFinalSyncSkip) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncFinalSyncSkip.
+func (in *VolSyncFinalSyncSkip) DeepCopy() *VolSyncFinalSyncSkip {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncFinalSyncSkip)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncRecoveryPoint) DeepCopyInto(out *VolSyncRecoveryPoint) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncRecoveryPoint.
+func (in *VolSyncRecoveryPoint) DeepCopy() *VolSyncRecoveryPoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncRecoveryPoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncRecoveryPoints) DeepCopyInto(out *VolSyncRecoveryPoints) {
+	*out = *in
+	if in.Points != nil {
+		in, out := &in.Points, &out.Points
+		*out = make([]VolSyncRecoveryPoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncRecoveryPoints.
+func (in *VolSyncRecoveryPoints) DeepCopy() *VolSyncRecoveryPoints {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncRecoveryPoints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolSyncReplicationDestinationInfo) DeepCopyInto(out *VolSyncReplicationDestinationInfo) {
 	*out = *in
@@ -1657,6 +1988,21 @@ func (in *VolSyncReplicationSourceSpec) DeepCopy() *VolSyncReplicationSourceSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncSnapshotRetention) DeepCopyInto(out *VolSyncSnapshotRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSnapshotRetention.
+func (in *VolSyncSnapshotRetention) DeepCopy() *VolSyncSnapshotRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncSnapshotRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolSyncSpec) DeepCopyInto(out *VolSyncSpec) {
 	*out = *in
@@ -1681,6 +2027,31 @@ func (in *VolSyncSpec) DeepCopyInto(out *VolSyncSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.SyncWindows != nil {
+		in, out := &in.SyncWindows, &out.SyncWindows
+		*out = make([]SyncWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VolSyncVerification)
+		**out = **in
+	}
+	if in.FinalSyncSkips != nil {
+		in, out := &in.FinalSyncSkips, &out.FinalSyncSkips
+		*out = make([]VolSyncFinalSyncSkip, len(*in))
+		copy(*out, *in)
+	}
+	if in.SnapshotRetention != nil {
+		in, out := &in.SnapshotRetention, &out.SnapshotRetention
+		*out = new(VolSyncSnapshotRetention)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSpec.
@@ -1693,6 +2064,82 @@ func (in *VolSyncSpec) DeepCopy() *VolSyncSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncSyncProgress) DeepCopyInto(out *VolSyncSyncProgress) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.BytesTransferred != nil {
+		in, out := &in.BytesTransferred, &out.BytesTransferred
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TotalBytes != nil {
+		in, out := &in.TotalBytes, &out.TotalBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncSyncProgress.
+func (in *VolSyncSyncProgress) DeepCopy() *VolSyncSyncProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncSyncProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncVerification) DeepCopyInto(out *VolSyncVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncVerification.
+func (in *VolSyncVerification) DeepCopy() *VolSyncVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolSyncVerificationStatus) DeepCopyInto(out *VolSyncVerificationStatus) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	if in.DivergedFiles != nil {
+		in, out := &in.DivergedFiles, &out.DivergedFiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InProgressSince != nil {
+		in, out := &in.InProgressSince, &out.InProgressSince
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolSyncVerificationStatus.
+func (in *VolSyncVerificationStatus) DeepCopy() *VolSyncVerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolSyncVerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeReplicationGroup) DeepCopyInto(out *VolumeReplicationGroup) {
 	*out = *in
@@ -1786,6 +2233,20 @@ func (in *VolumeReplicationGroupSpec) DeepCopyInto(out *VolumeReplicationGroupSp
 			copy(*out, *in)
 		}
 	}
+	if in.StorageClassMapping != nil {
+		in, out := &in.StorageClassMapping, &out.StorageClassMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NamespaceMapping != nil {
+		in, out := &in.NamespaceMapping, &out.NamespaceMapping
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationGroupSpec.
@@ -1808,6 +2269,11 @@ func (in *VolumeReplicationGroupStatus) DeepCopyInto(out *VolumeReplicationGroup
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExcludedPVCs != nil {
+		in, out := &in.ExcludedPVCs, &out.ExcludedPVCs
+		*out = make([]ExcludedPVC, len(*in))
+		copy(*out, *in)
+	}
 	if in.PVCGroups != nil {
 		in, out := &in.PVCGroups, &out.PVCGroups
 		*out = make([]Groups, len(*in))
@@ -1831,6 +2297,11 @@ func (in *VolumeReplicationGroupStatus) DeepCopyInto(out *VolumeReplicationGroup
 	}
 	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	in.KubeObjectProtection.DeepCopyInto(&out.KubeObjectProtection)
+	if in.ReplicationTypes != nil {
+		in, out := &in.ReplicationTypes, &out.ReplicationTypes
+		*out = make([]ReplicationTypeStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.LastGroupSyncTime != nil {
 		in, out := &in.LastGroupSyncTime, &out.LastGroupSyncTime
 		*out = (*in).DeepCopy()
@@ -1845,6 +2316,27 @@ func (in *VolumeReplicationGroupStatus) DeepCopyInto(out *VolumeReplicationGroup
 		*out = new(int64)
 		**out = **in
 	}
+	if in.SyncDeferredUntil != nil {
+		in, out := &in.SyncDeferredUntil, &out.SyncDeferredUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.GroupSyncProgress != nil {
+		in, out := &in.GroupSyncProgress, &out.GroupSyncProgress
+		*out = new(VolSyncSyncProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolSyncPSKIdentities != nil {
+		in, out := &in.VolSyncPSKIdentities, &out.VolSyncPSKIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.VolSyncRecoveryPoints != nil {
+		in, out := &in.VolSyncRecoveryPoints, &out.VolSyncRecoveryPoints
+		*out = make([]VolSyncRecoveryPoints, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationGroupStatus.
@@ -1856,3 +2348,26 @@ func (in *VolumeReplicationGroupStatus) DeepCopy() *VolumeReplicationGroupStatus
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeReplicationState) DeepCopyInto(out *VolumeReplicationState) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastResyncStartTime != nil {
+		in, out := &in.LastResyncStartTime, &out.LastResyncStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeReplicationState.
+func (in *VolumeReplicationState) DeepCopy() *VolumeReplicationState {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeReplicationState)
+	in.DeepCopyInto(out)
+	return out
+}
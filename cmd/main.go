@@ -181,6 +181,16 @@ func setupReconcilersCluster(mgr ctrl.Manager, ramenConfig *ramendrv1alpha1.Rame
 		os.Exit(1)
 	}
 
+	if ramenConfig.KubeObjectProtection.RecipeValidationWebhookEnabled {
+		if err := (&controllers.RecipeValidator{
+			APIReader: mgr.GetAPIReader(),
+			Log:       ctrl.Log.WithName("recipe-webhook"),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Recipe")
+			os.Exit(1)
+		}
+	}
+
 	if err := (&controllers.DRClusterConfigReconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
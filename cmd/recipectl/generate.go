@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/recipegen"
+)
+
+// namespacesFlag collects namespaces given as NAMESPACE[,NAMESPACE...]
+type namespacesFlag []string
+
+func (n *namespacesFlag) String() string {
+	return strings.Join(*n, ",")
+}
+
+func (n *namespacesFlag) Set(value string) error {
+	*n = append(*n, strings.Split(value, ",")...)
+
+	return nil
+}
+
+func generate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	name := flags.String("name", "", "Name of the generated recipe. Defaults to the VRG name or the first namespace.")
+	recipeNamespace := flags.String("recipe-namespace", "",
+		"Namespace of the generated recipe. Defaults to the VRG namespace or the first namespace.")
+	vrgName := flags.String("vrg", "",
+		"VRG, as NAMESPACE/NAME, whose protected namespaces, or namespace, are inspected.")
+	scaleDownOnCapture := flags.Bool("scale-down-on-capture", false,
+		"Scale the workload down while capturing its objects, and up from the captured replicas on restore.")
+	namespaces := namespacesFlag{}
+	flags.Var(&namespaces, "namespace", "Namespace to inspect, NAMESPACE[,NAMESPACE...]. May be repeated.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s generate [flags]\n\n", os.Args[0])
+		fmt.Fprintf(flags.Output(), "Connects to the cluster of the current KUBECONFIG context.\n\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if (len(namespaces) == 0) == (*vrgName == "") {
+		flags.Usage()
+
+		return errors.New("exactly one of -namespace or -vrg should be given")
+	}
+
+	reader, err := newClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	options := recipegen.Options{
+		Name:               *name,
+		Namespace:          *recipeNamespace,
+		Namespaces:         namespaces,
+		ScaleDownOnCapture: *scaleDownOnCapture,
+	}
+
+	if *vrgName != "" {
+		if err := setVRGOptions(ctx, reader, *vrgName, &options); err != nil {
+			return err
+		}
+	}
+
+	if options.Namespace == "" {
+		options.Namespace = options.Namespaces[0]
+	}
+
+	if options.Name == "" {
+		options.Name = options.Namespaces[0]
+	}
+
+	recipe, err := recipegen.Generate(ctx, reader, options)
+	if err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(recipe)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recipe: %w", err)
+	}
+
+	_, err = os.Stdout.Write(out)
+
+	return err
+}
+
+func setVRGOptions(ctx context.Context, reader client.Reader, vrgName string, options *recipegen.Options) error {
+	namespace, name, ok := strings.Cut(vrgName, "/")
+	if !ok || namespace == "" || name == "" {
+		return fmt.Errorf("vrg %q is not of the form NAMESPACE/NAME", vrgName)
+	}
+
+	vrg := &ramendrv1alpha1.VolumeReplicationGroup{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, vrg); err != nil {
+		return fmt.Errorf("failed to get vrg %s: %w", vrgName, err)
+	}
+
+	options.Namespaces = recipegen.VRGNamespaces(vrg)
+
+	if options.Namespace == "" {
+		options.Namespace = vrg.Namespace
+	}
+
+	if options.Name == "" {
+		options.Name = vrg.Name
+	}
+
+	return nil
+}
+
+func newClient() (client.Client, error) {
+	config, err := ctrl.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kubeconfig: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		apiextensionsv1.AddToScheme,
+		ramendrv1alpha1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	return client.New(config, client.Options{Scheme: scheme})
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// recipectl is a command line tool for working with Recipes outside of a cluster,
+// for example to validate recipes in CI before they are deployed, or to generate a
+// starter recipe from a running workload.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(args []string) error
+}
+
+var commands = []command{
+	{name: "validate", usage: "validate recipes the same way a VRG validates them", run: validate},
+	{name: "generate", usage: "generate a starter recipe from a running workload", run: generate},
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags]\n\nCommands:\n", os.Args[0])
+
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.usage)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+
+		if err := cmd.run(os.Args[2:]); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %v\n", cmd.name, err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+// readRamenConfig reads the ramen config from the given file, or returns the default
+// config if no file is given.
+func readRamenConfig(configFile string) (ramendrv1alpha1.RamenConfig, error) {
+	ramenConfig := ramendrv1alpha1.RamenConfig{}
+	if configFile == "" {
+		return ramenConfig, nil
+	}
+
+	fileContents, err := os.ReadFile(configFile)
+	if err != nil {
+		return ramenConfig, fmt.Errorf("unable to load the config file %s: %w", configFile, err)
+	}
+
+	if err := yaml.Unmarshal(fileContents, &ramenConfig); err != nil {
+		return ramenConfig, fmt.Errorf("unable to unmarshal the config file %s: %w", configFile, err)
+	}
+
+	return ramenConfig, nil
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-logr/logr"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	controllers "github.com/ramendr/ramen/internal/controller"
+)
+
+const recipeKind = "Recipe"
+
+// parametersFlag collects recipe parameters given as KEY=VALUE[,VALUE...]
+type parametersFlag map[string][]string
+
+func (p parametersFlag) String() string {
+	return fmt.Sprint(map[string][]string(p))
+}
+
+func (p parametersFlag) Set(value string) error {
+	key, values, ok := strings.Cut(value, "=")
+	if !ok || key == "" {
+		return fmt.Errorf("parameter %q is not of the form KEY=VALUE[,VALUE...]", value)
+	}
+
+	p[key] = append(p[key], strings.Split(values, ",")...)
+
+	return nil
+}
+
+func validate(args []string) error {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	configFile := flags.String("config", "",
+		"Ramen config file. The multi-namespace settings in it are used to validate recipe namespaces.")
+	namespace := flags.String("namespace", "default", "Namespace of recipes that do not specify one.")
+	parameters := parametersFlag{}
+	flags.Var(parameters, "param",
+		"Recipe parameter, as the VRG recipeParameters, in the form KEY=VALUE[,VALUE...]. May be repeated.")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s validate [flags] FILE...\n\n", os.Args[0])
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		flags.Usage()
+
+		return errors.New("no recipe files given")
+	}
+
+	ramenConfig, err := readRamenConfig(*configFile)
+	if err != nil {
+		return err
+	}
+
+	// Without -param, report missing required parameters as warnings, as for a recipe that
+	// no VRG refers to yet.
+	var recipeParameters map[string][]string
+	if len(parameters) > 0 {
+		recipeParameters = parameters
+	}
+
+	invalidCount := 0
+
+	for _, fileName := range flags.Args() {
+		recipes, err := readRecipes(fileName)
+		if err != nil {
+			return err
+		}
+
+		for _, recipe := range recipes {
+			if recipe.Namespace == "" {
+				recipe.Namespace = *namespace
+			}
+
+			if !validateRecipe(fileName, recipe, recipeParameters, ramenConfig) {
+				invalidCount++
+			}
+		}
+	}
+
+	if invalidCount > 0 {
+		return fmt.Errorf("%d invalid recipes", invalidCount)
+	}
+
+	return nil
+}
+
+func validateRecipe(fileName string, recipe recipev1.Recipe, parameters map[string][]string,
+	ramenConfig ramendrv1alpha1.RamenConfig,
+) bool {
+	name := fmt.Sprintf("%s: recipe %s/%s", fileName, recipe.Namespace, recipe.Name)
+
+	warnings, err := controllers.RecipeValidate(context.Background(), recipe, parameters, ramenConfig,
+		logr.Discard())
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "%s: warning: %s\n", name, warning)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: invalid:\n%s\n", name, indent(err.Error()))
+
+		return false
+	}
+
+	fmt.Printf("%s: valid\n", name)
+
+	return true
+}
+
+// readRecipes returns the recipes in a YAML or JSON file. Other kinds of objects in the
+// file are ignored, so that a file with all the objects of an application can be given.
+func readRecipes(fileName string) ([]recipev1.Recipe, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	recipes := []recipev1.Recipe{}
+	decoder := utilyaml.NewYAMLOrJSONDecoder(file, 4096)
+
+	for {
+		recipe := recipev1.Recipe{}
+
+		if err := decoder.Decode(&recipe); err != nil {
+			if errors.Is(err, io.EOF) {
+				return recipes, nil
+			}
+
+			return nil, fmt.Errorf("%s: decode error: %w", fileName, err)
+		}
+
+		if recipe.Kind == recipeKind {
+			recipes = append(recipes, recipe)
+		}
+	}
+}
+
+func indent(s string) string {
+	return "  " + strings.ReplaceAll(s, "\n", "\n  ")
+}
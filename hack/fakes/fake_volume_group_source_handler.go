@@ -6,7 +6,7 @@ import (
 	"sync"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 	v1 "k8s.io/api/core/v1"
 	v1a "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -37,12 +37,12 @@ type FakeVolumeGroupSourceHandler struct {
 	cleanVolumeGroupSnapshotReturnsOnCall map[int]struct {
 		result1 error
 	}
-	CreateOrUpdateReplicationSourceForRestoredPVCsStub        func(context.Context, string, []cephfscg.RestoredPVC, v1a.Object, *ramendrv1alpha1.VolumeReplicationGroup, bool) ([]*v1.ObjectReference, bool, error)
+	CreateOrUpdateReplicationSourceForRestoredPVCsStub        func(context.Context, string, []volsynccg.RestoredPVC, v1a.Object, *ramendrv1alpha1.VolumeReplicationGroup, bool) ([]*v1.ObjectReference, bool, error)
 	createOrUpdateReplicationSourceForRestoredPVCsMutex       sync.RWMutex
 	createOrUpdateReplicationSourceForRestoredPVCsArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
-		arg3 []cephfscg.RestoredPVC
+		arg3 []volsynccg.RestoredPVC
 		arg4 v1a.Object
 	}
 	createOrUpdateReplicationSourceForRestoredPVCsReturns struct {
@@ -69,18 +69,18 @@ type FakeVolumeGroupSourceHandler struct {
 		result1 bool
 		result2 error
 	}
-	RestoreVolumesFromVolumeGroupSnapshotStub        func(context.Context, v1a.Object) ([]cephfscg.RestoredPVC, error)
+	RestoreVolumesFromVolumeGroupSnapshotStub        func(context.Context, v1a.Object) ([]volsynccg.RestoredPVC, error)
 	restoreVolumesFromVolumeGroupSnapshotMutex       sync.RWMutex
 	restoreVolumesFromVolumeGroupSnapshotArgsForCall []struct {
 		arg1 context.Context
 		arg2 v1a.Object
 	}
 	restoreVolumesFromVolumeGroupSnapshotReturns struct {
-		result1 []cephfscg.RestoredPVC
+		result1 []volsynccg.RestoredPVC
 		result2 error
 	}
 	restoreVolumesFromVolumeGroupSnapshotReturnsOnCall map[int]struct {
-		result1 []cephfscg.RestoredPVC
+		result1 []volsynccg.RestoredPVC
 		result2 error
 	}
 	WaitIfPVCTooNewStub        func(context.Context) (bool, error)
@@ -231,10 +231,10 @@ func (fake *FakeVolumeGroupSourceHandler) CleanVolumeGroupSnapshotReturnsOnCall(
 	}{result1}
 }
 
-func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCs(arg1 context.Context, arg2 string, arg3 []cephfscg.RestoredPVC, arg4 v1a.Object, arg5 *ramendrv1alpha1.VolumeReplicationGroup, arg6 bool) ([]*v1.ObjectReference, bool, error) {
-	var arg3Copy []cephfscg.RestoredPVC
+func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCs(arg1 context.Context, arg2 string, arg3 []volsynccg.RestoredPVC, arg4 v1a.Object, arg5 *ramendrv1alpha1.VolumeReplicationGroup, arg6 bool) ([]*v1.ObjectReference, bool, error) {
+	var arg3Copy []volsynccg.RestoredPVC
 	if arg3 != nil {
-		arg3Copy = make([]cephfscg.RestoredPVC, len(arg3))
+		arg3Copy = make([]volsynccg.RestoredPVC, len(arg3))
 		copy(arg3Copy, arg3)
 	}
 	fake.createOrUpdateReplicationSourceForRestoredPVCsMutex.Lock()
@@ -242,7 +242,7 @@ func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRest
 	fake.createOrUpdateReplicationSourceForRestoredPVCsArgsForCall = append(fake.createOrUpdateReplicationSourceForRestoredPVCsArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 []cephfscg.RestoredPVC
+		arg3 []volsynccg.RestoredPVC
 		arg4 v1a.Object
 	}{arg1, arg2, arg3Copy, arg4})
 	stub := fake.CreateOrUpdateReplicationSourceForRestoredPVCsStub
@@ -264,13 +264,13 @@ func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRest
 	return len(fake.createOrUpdateReplicationSourceForRestoredPVCsArgsForCall)
 }
 
-func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCsCalls(stub func(context.Context, string, []cephfscg.RestoredPVC, v1a.Object, *ramendrv1alpha1.VolumeReplicationGroup, bool) ([]*v1.ObjectReference, bool, error)) {
+func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCsCalls(stub func(context.Context, string, []volsynccg.RestoredPVC, v1a.Object, *ramendrv1alpha1.VolumeReplicationGroup, bool) ([]*v1.ObjectReference, bool, error)) {
 	fake.createOrUpdateReplicationSourceForRestoredPVCsMutex.Lock()
 	defer fake.createOrUpdateReplicationSourceForRestoredPVCsMutex.Unlock()
 	fake.CreateOrUpdateReplicationSourceForRestoredPVCsStub = stub
 }
 
-func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCsArgsForCall(i int) (context.Context, string, []cephfscg.RestoredPVC, v1a.Object) {
+func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateReplicationSourceForRestoredPVCsArgsForCall(i int) (context.Context, string, []volsynccg.RestoredPVC, v1a.Object) {
 	fake.createOrUpdateReplicationSourceForRestoredPVCsMutex.RLock()
 	defer fake.createOrUpdateReplicationSourceForRestoredPVCsMutex.RUnlock()
 	argsForCall := fake.createOrUpdateReplicationSourceForRestoredPVCsArgsForCall[i]
@@ -371,7 +371,7 @@ func (fake *FakeVolumeGroupSourceHandler) CreateOrUpdateVolumeGroupSnapshotRetur
 	}{result1, result2}
 }
 
-func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshot(arg1 context.Context, arg2 v1a.Object) ([]cephfscg.RestoredPVC, error) {
+func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshot(arg1 context.Context, arg2 v1a.Object) ([]volsynccg.RestoredPVC, error) {
 	fake.restoreVolumesFromVolumeGroupSnapshotMutex.Lock()
 	ret, specificReturn := fake.restoreVolumesFromVolumeGroupSnapshotReturnsOnCall[len(fake.restoreVolumesFromVolumeGroupSnapshotArgsForCall)]
 	fake.restoreVolumesFromVolumeGroupSnapshotArgsForCall = append(fake.restoreVolumesFromVolumeGroupSnapshotArgsForCall, struct {
@@ -397,7 +397,7 @@ func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotC
 	return len(fake.restoreVolumesFromVolumeGroupSnapshotArgsForCall)
 }
 
-func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotCalls(stub func(context.Context, v1a.Object) ([]cephfscg.RestoredPVC, error)) {
+func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotCalls(stub func(context.Context, v1a.Object) ([]volsynccg.RestoredPVC, error)) {
 	fake.restoreVolumesFromVolumeGroupSnapshotMutex.Lock()
 	defer fake.restoreVolumesFromVolumeGroupSnapshotMutex.Unlock()
 	fake.RestoreVolumesFromVolumeGroupSnapshotStub = stub
@@ -410,28 +410,28 @@ func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotA
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotReturns(result1 []cephfscg.RestoredPVC, result2 error) {
+func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotReturns(result1 []volsynccg.RestoredPVC, result2 error) {
 	fake.restoreVolumesFromVolumeGroupSnapshotMutex.Lock()
 	defer fake.restoreVolumesFromVolumeGroupSnapshotMutex.Unlock()
 	fake.RestoreVolumesFromVolumeGroupSnapshotStub = nil
 	fake.restoreVolumesFromVolumeGroupSnapshotReturns = struct {
-		result1 []cephfscg.RestoredPVC
+		result1 []volsynccg.RestoredPVC
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotReturnsOnCall(i int, result1 []cephfscg.RestoredPVC, result2 error) {
+func (fake *FakeVolumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshotReturnsOnCall(i int, result1 []volsynccg.RestoredPVC, result2 error) {
 	fake.restoreVolumesFromVolumeGroupSnapshotMutex.Lock()
 	defer fake.restoreVolumesFromVolumeGroupSnapshotMutex.Unlock()
 	fake.RestoreVolumesFromVolumeGroupSnapshotStub = nil
 	if fake.restoreVolumesFromVolumeGroupSnapshotReturnsOnCall == nil {
 		fake.restoreVolumesFromVolumeGroupSnapshotReturnsOnCall = make(map[int]struct {
-			result1 []cephfscg.RestoredPVC
+			result1 []volsynccg.RestoredPVC
 			result2 error
 		})
 	}
 	fake.restoreVolumesFromVolumeGroupSnapshotReturnsOnCall[i] = struct {
-		result1 []cephfscg.RestoredPVC
+		result1 []volsynccg.RestoredPVC
 		result2 error
 	}{result1, result2}
 }
@@ -522,4 +522,4 @@ func (fake *FakeVolumeGroupSourceHandler) recordInvocation(key string, args []in
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ cephfscg.VolumeGroupSourceHandler = new(FakeVolumeGroupSourceHandler)
+var _ volsynccg.VolumeGroupSourceHandler = new(FakeVolumeGroupSourceHandler)
@@ -65,6 +65,7 @@ type DRPCInstance struct {
 	drPolicy             *rmn.DRPolicy
 	drClusters           []rmn.DRCluster
 	mcvRequestInProgress bool
+	recipeParamsPending  bool
 	volSyncDisabled      bool
 	userPlacement        client.Object
 	vrgs                 map[string]*rmn.VolumeReplicationGroup
@@ -108,6 +109,16 @@ func (d *DRPCInstance) startProcessing() bool {
 func (d *DRPCInstance) processPlacement() (bool, error) {
 	d.log.Info("Process DRPC Placement", "DRAction", d.instance.Spec.Action)
 
+	if d.splitBrainReported() {
+		if splitBrain, err := d.reconcileSplitBrain(); splitBrain || err != nil {
+			return false, err
+		}
+	}
+
+	if err := d.validateRecipeParameters(); err != nil {
+		return false, err
+	}
+
 	switch d.instance.Spec.Action {
 	case rmn.ActionFailover:
 		return d.RunFailover()
@@ -943,6 +954,18 @@ func isDiscoveredApp(drpc *rmn.DRPlacementControl) bool {
 	return drpc.Spec.ProtectedNamespaces != nil && len(*drpc.Spec.ProtectedNamespaces) > 0
 }
 
+// clusterProtectedNamespaces returns the protected namespaces of the DRPC, as named on a cluster
+func (d *DRPCInstance) clusterProtectedNamespaces(clusterName string) *[]string {
+	mapping := rmnutil.DRPCNamespaceMapping(d.instance, clusterName)
+	if d.instance.Spec.ProtectedNamespaces == nil || mapping == nil {
+		return d.instance.Spec.ProtectedNamespaces
+	}
+
+	namespaces := rmnutil.MappedNamespaceNames(mapping, *d.instance.Spec.ProtectedNamespaces)
+
+	return &namespaces
+}
+
 func (d *DRPCInstance) ensureActionCompleted(srcCluster string) (bool, error) {
 	const done = true
 
@@ -1086,6 +1109,13 @@ func (d *DRPCInstance) prepareForFinalSync(homeCluster string) (bool, error) {
 		return !done, nil
 	}
 
+	// The workload is quiesced once the PVCs of every replication type of the VRG are prepared
+	if pending := finalSyncPreparePendingReplicationTypes(vrg); len(pending) != 0 {
+		d.setFinalSyncPendingCondition("Preparing for the final sync of", pending)
+
+		return !done, nil
+	}
+
 	d.log.Info("Preparing for final sync completed", "cluster", homeCluster)
 
 	return done, nil
@@ -1113,6 +1143,18 @@ func (d *DRPCInstance) runFinalSync(homeCluster string) (bool, error) {
 		// updated VRG to run final sync. Give it time...
 		d.log.Info(fmt.Sprintf("Giving it enough time to run final sync on cluster %s", homeCluster))
 
+		if pending := finalSyncPendingReplicationTypes(vrg); len(pending) != 0 {
+			d.setFinalSyncPendingCondition("Running the final sync of", pending)
+		}
+
+		return !done, nil
+	}
+
+	// The PVCs of the VRG are cleaned up, by moving it to secondary, once the final sync of every
+	// replication type is complete
+	if pending := finalSyncPendingReplicationTypes(vrg); len(pending) != 0 {
+		d.setFinalSyncPendingCondition("Running the final sync of", pending)
+
 		return !done, nil
 	}
 
@@ -1121,6 +1163,57 @@ func (d *DRPCInstance) runFinalSync(homeCluster string) (bool, error) {
 	return done, nil
 }
 
+// setFinalSyncPendingCondition reports the replication types of a VRG with mixed replication types that a step of
+// the final sync waits for
+func (d *DRPCInstance) setFinalSyncPendingCondition(step string, pending []string) {
+	msg := fmt.Sprintf("%s replication types %s", step, strings.Join(pending, ", "))
+
+	d.log.Info(msg)
+	addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionAvailable, d.instance.Generation,
+		d.getConditionStatusForTypeAvailable(), string(d.instance.Status.Phase), msg)
+}
+
+// finalSyncPreparePendingReplicationTypes returns the replication types of a VRG with mixed replication types that
+// are not prepared for the final sync
+func finalSyncPreparePendingReplicationTypes(vrg *rmn.VolumeReplicationGroup) []string {
+	return replicationTypesPending(vrg, func(replicationType *rmn.ReplicationTypeStatus) bool {
+		return replicationType.FinalSyncPrepared
+	})
+}
+
+// finalSyncPendingReplicationTypes returns the replication types of a VRG with mixed replication types whose
+// final sync is not complete
+func finalSyncPendingReplicationTypes(vrg *rmn.VolumeReplicationGroup) []string {
+	return replicationTypesPending(vrg, func(replicationType *rmn.ReplicationTypeStatus) bool {
+		return replicationType.FinalSyncComplete
+	})
+}
+
+// replicationTypesPending returns the replication types of a VRG with mixed replication types that have not
+// completed a step, with the reason for each when known
+func replicationTypesPending(vrg *rmn.VolumeReplicationGroup,
+	completed func(*rmn.ReplicationTypeStatus) bool,
+) []string {
+	var pending []string
+
+	for idx := range vrg.Status.ReplicationTypes {
+		replicationType := &vrg.Status.ReplicationTypes[idx]
+		if completed(replicationType) {
+			continue
+		}
+
+		if replicationType.Message == "" {
+			pending = append(pending, string(replicationType.Type))
+
+			continue
+		}
+
+		pending = append(pending, fmt.Sprintf("%s (%s)", replicationType.Type, replicationType.Message))
+	}
+
+	return pending
+}
+
 func (d *DRPCInstance) areMultipleVRGsPrimary() bool {
 	numOfPrimaries := 0
 
@@ -1678,10 +1771,13 @@ func hasPeerClass(vrgPeerClasses []rmn.PeerClass, scName string, clusterIDs []st
 }
 
 // updatePeers see updateVRGDRTypeSpec
+// The StorageClass names of the PVCs protected by the VRG are names on its cluster, which scMapping maps the
+// StorageClass names of the peerClasses to, see peerClassMatchesStorageClassName
 func updatePeers(
 	log logr.Logger,
 	vrgFromView *rmn.VolumeReplicationGroup,
 	vrgPeerClasses, policyPeerClasses []rmn.PeerClass,
+	scMapping map[string]string,
 	cgAnnotationExists bool,
 ) []rmn.PeerClass {
 	peerClasses := vrgPeerClasses
@@ -1693,21 +1789,23 @@ func updatePeers(
 		}
 
 		for policyPeerClassIdx := range policyPeerClasses {
-			if policyPeerClasses[policyPeerClassIdx].StorageClassName !=
-				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName {
+			if !peerClassMatchesStorageClassName(&policyPeerClasses[policyPeerClassIdx],
+				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName, scMapping) {
 				continue
 			}
 
+			scName := policyPeerClasses[policyPeerClassIdx].StorageClassName
+
 			if hasPeerClass(
 				vrgPeerClasses,
-				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName,
+				scName,
 				policyPeerClasses[policyPeerClassIdx].ClusterIDs,
 			) {
 				updatePeerClass(
 					log,
 					peerClasses,
 					policyPeerClasses[policyPeerClassIdx],
-					*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName,
+					scName,
 					cgAnnotationExists,
 				)
 
@@ -1716,7 +1814,7 @@ func updatePeers(
 
 			if hasPeerClass(
 				peerClasses,
-				*vrgFromView.Status.ProtectedPVCs[pvcIdx].StorageClassName,
+				scName,
 				policyPeerClasses[policyPeerClassIdx].ClusterIDs,
 			) {
 				break
@@ -1762,6 +1860,7 @@ func (d *DRPCInstance) updateVRGAsyncSpec(vrgFromView, vrg *rmn.VolumeReplicatio
 		vrgFromView,
 		vrg.Spec.Async.PeerClasses,
 		d.drPolicy.Status.Async.PeerClasses,
+		vrg.Spec.StorageClassMapping,
 		cgAnnotationExists,
 	)
 
@@ -1795,6 +1894,7 @@ func (d *DRPCInstance) updateVRGSyncSpec(vrgFromView, vrg *rmn.VolumeReplication
 
 	syncSpec.PeerClasses = updatePeers(d.log, vrgFromView, vrg.Spec.Sync.PeerClasses,
 		d.drPolicy.Status.Sync.PeerClasses,
+		vrg.Spec.StorageClassMapping,
 		false)
 
 	// TODO: prune peerClasses not in policy and not in use by VRG
@@ -1841,10 +1941,13 @@ func (d *DRPCInstance) updateVRGOptionalFields(vrg, vrgFromView *rmn.VolumeRepli
 		rmnutil.IsSubmarinerEnabledAnnotation: d.instance.GetAnnotations()[rmnutil.IsSubmarinerEnabledAnnotation],
 	}
 
-	vrg.Spec.ProtectedNamespaces = d.instance.Spec.ProtectedNamespaces
+	vrg.Spec.ProtectedNamespaces = d.clusterProtectedNamespaces(homeCluster)
+	vrg.Spec.NamespaceMapping = rmnutil.DRPCNamespaceMapping(d.instance, homeCluster)
 	vrg.Spec.S3Profiles = AvailableS3Profiles(d.drClusters)
 	vrg.Spec.KubeObjectProtection = d.instance.Spec.KubeObjectProtection
 	vrg.Spec.VolSync.Disabled = d.volSyncDisabled
+	vrg.Spec.StorageClassMapping = rmnutil.DRPolicyStorageClassMapping(d.drPolicy, homeCluster)
+	vrg.Spec.ProtectOnly = d.instance.Spec.ProtectOnly
 	d.setVRGAction(vrg)
 
 	// If vrgFromView nil, then vrg is newly generated, Sync/Async spec is updated unconditionally
@@ -1868,8 +1971,17 @@ func (d *DRPCInstance) updateVRGOptionalFields(vrg, vrgFromView *rmn.VolumeRepli
 	}
 }
 
-// Checks if MoverConfig exists in the spec
+// Copies the VolSync mover, its bandwidth limit, sync windows, verification, final sync skips
+// and snapshot retention, and the MoverConfig if it exists, from the spec
 func (d *DRPCInstance) updateMoverConfig(vrg *rmn.VolumeReplicationGroup) {
+	vrg.Spec.VolSync.Mover = d.instance.Spec.VolSyncSpec.Mover
+	vrg.Spec.VolSync.MoverS3ProfileName = d.instance.Spec.VolSyncSpec.MoverS3ProfileName
+	vrg.Spec.VolSync.BandwidthLimit = d.instance.Spec.VolSyncSpec.BandwidthLimit
+	vrg.Spec.VolSync.SyncWindows = d.instance.Spec.VolSyncSpec.SyncWindows
+	vrg.Spec.VolSync.Verification = d.instance.Spec.VolSyncSpec.Verification.DeepCopy()
+	vrg.Spec.VolSync.FinalSyncSkips = d.instance.Spec.VolSyncSpec.FinalSyncSkips
+	vrg.Spec.VolSync.SnapshotRetention = d.instance.Spec.VolSyncSpec.SnapshotRetention.DeepCopy()
+
 	if len(d.instance.Spec.VolSyncSpec.MoverConfig) == 0 {
 		return
 	}
@@ -2062,7 +2174,7 @@ func (d *DRPCInstance) createOrUpdateNamespaces(homeCluster string) error {
 func (d *DRPCInstance) createOrUpdateNSForDiscoveredApps(homeCluster string) error {
 	protectedNamespaces := make([]*corev1.Namespace, 0)
 
-	for _, ns := range *d.instance.Spec.ProtectedNamespaces {
+	for _, ns := range *d.clusterProtectedNamespaces(homeCluster) {
 		protectedNamespace, err := d.reconciler.MCVGetter.GetNSFromManagedCluster(homeCluster, ns)
 		if err != nil {
 			d.log.Error(err, fmt.Sprintf("error getting namespace %s from managed cluster %s using MCV", ns, homeCluster))
@@ -2081,7 +2193,10 @@ func (d *DRPCInstance) createOrUpdateNSForDiscoveredApps(homeCluster string) err
 				continue
 			}
 
-			if err := d.mwu.CreateOrUpdateNamespaceManifest(d.instance.Name, protectedNamespaceObj.Name, dstCluster,
+			nsName := rmnutil.MappedNamespaceName(rmnutil.DRPCNamespaceMapping(d.instance, dstCluster),
+				protectedNamespaceObj.Name)
+
+			if err := d.mwu.CreateOrUpdateNamespaceManifest(d.instance.Name, nsName, dstCluster,
 				annotations, protectedNamespaceObj.Labels); err != nil {
 				return err
 			}
@@ -2430,7 +2545,13 @@ func (d *DRPCInstance) updateVRGToRunFinalSync(clusterName string) error {
 			clusterName, err)
 	}
 
-	if vrg.Spec.RunFinalSync {
+	// PVCs may be skipped while the final sync runs, to complete the final sync of the others
+	var finalSyncSkips []rmn.VolSyncFinalSyncSkip
+	if d.instance.Spec.VolSyncSpec != nil {
+		finalSyncSkips = d.instance.Spec.VolSyncSpec.FinalSyncSkips
+	}
+
+	if vrg.Spec.RunFinalSync && reflect.DeepEqual(vrg.Spec.VolSync.FinalSyncSkips, finalSyncSkips) {
 		d.log.Info(fmt.Sprintf("VRG %s on cluster %s already has the final sync flag set",
 			vrg.Name, clusterName))
 
@@ -2439,6 +2560,7 @@ func (d *DRPCInstance) updateVRGToRunFinalSync(clusterName string) error {
 
 	vrg.Spec.RunFinalSync = true
 	vrg.Spec.PrepareForFinalSync = false
+	vrg.Spec.VolSync.FinalSyncSkips = finalSyncSkips
 
 	err = d.updateManifestWork(clusterName, vrg)
 	if err != nil {
@@ -2627,6 +2749,18 @@ func (d *DRPCInstance) shouldUpdateStatus() bool {
 		return true
 	}
 
+	if !reflect.DeepEqual(vrg.Status.GroupSyncProgress, d.instance.Status.GroupSyncProgress) {
+		return true
+	}
+
+	if !reflect.DeepEqual(vrg.Status.ExcludedPVCs, d.instance.Status.ResourceConditions.ResourceMeta.ExcludedPVCs) {
+		return true
+	}
+
+	if !reflect.DeepEqual(vrg.Status.ReplicationTypes, d.instance.Status.ReplicationTypes) {
+		return true
+	}
+
 	if vrg.Status.KubeObjectProtection.CaptureToRecoverFrom != nil {
 		vrgKubeObjectProtectionTime := vrg.Status.KubeObjectProtection.CaptureToRecoverFrom.EndTime
 		if !vrgKubeObjectProtectionTime.Equal(d.instance.Status.LastKubeObjectProtectionTime) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"reflect"
 	"slices"
 	"time"
@@ -194,6 +195,13 @@ func (r *DRPlacementControlReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	err = ensureDRPCValidVolSyncSpec(drpc)
+	if err != nil {
+		r.recordFailure(ctx, drpc, placementObj, "Error", err.Error(), logger)
+
+		return ctrl.Result{}, err
+	}
+
 	err = r.ensureNoConflictingDRPCs(ctx, drpc, ramenConfig, logger)
 	if err != nil {
 		r.recordFailure(ctx, drpc, placementObj, "Error", err.Error(), logger)
@@ -331,6 +339,49 @@ func (r *DRPlacementControlReconciler) setLastSyncBytesMetric(syncDataBytesMetri
 	syncDataBytesMetrics.LastSyncDataBytes.Set(float64(*b))
 }
 
+func (r *DRPlacementControlReconciler) setSyncProgressMetric(syncProgressMetrics *SyncProgressMetrics,
+	progress *rmn.VolSyncSyncProgress, log logr.Logger,
+) {
+	if syncProgressMetrics == nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("setting metrics: (%s, %s)", SyncProgressDataBytes, SyncEstimatedCompletionTimestampSeconds))
+
+	syncProgressMetrics.SyncProgressDataBytes.Set(0)
+	syncProgressMetrics.SyncEstimatedCompletion.Set(0)
+
+	if progress == nil {
+		return
+	}
+
+	if progress.BytesTransferred != nil {
+		syncProgressMetrics.SyncProgressDataBytes.Set(float64(*progress.BytesTransferred))
+	}
+
+	if progress.EstimatedCompletionTime != nil {
+		syncProgressMetrics.SyncEstimatedCompletion.Set(float64(progress.EstimatedCompletionTime.ProtoTime().Seconds))
+	}
+}
+
+func (r *DRPlacementControlReconciler) setSyncDeferredMetric(syncDeferredMetrics *SyncDeferredMetrics,
+	t *metav1.Time, log logr.Logger,
+) {
+	if syncDeferredMetrics == nil {
+		return
+	}
+
+	log.Info(fmt.Sprintf("setting metric: (%s)", SyncDeferredUntilTimestampSeconds))
+
+	if t == nil {
+		syncDeferredMetrics.SyncDeferredUntil.Set(0)
+
+		return
+	}
+
+	syncDeferredMetrics.SyncDeferredUntil.Set(float64(t.ProtoTime().Seconds))
+}
+
 // setWorkloadProtectionMetric sets the workload protection info metric, where 0 indicates not protected and
 // 1 indicates protected
 func (r *DRPlacementControlReconciler) setWorkloadProtectionMetric(workloadProtectionMetrics *WorkloadProtectionMetrics,
@@ -469,10 +520,16 @@ func (r *DRPlacementControlReconciler) createSyncMetricsInstance(
 	syncDataBytesLabels := SyncDataBytesMetricLabels(drPolicy, drpc)
 	syncDataMetrics := NewSyncDataBytesMetric(syncDataBytesLabels)
 
+	syncProgressMetrics := NewSyncProgressMetric(syncDataBytesLabels)
+
+	syncDeferredMetrics := NewSyncDeferredMetric(syncTimeMetricLabels)
+
 	return &SyncMetrics{
 		SyncTimeMetrics:      syncTimeMetrics,
 		SyncDurationMetrics:  syncDurationMetrics,
 		SyncDataBytesMetrics: syncDataMetrics,
+		SyncProgressMetrics:  syncProgressMetrics,
+		SyncDeferredMetrics:  syncDeferredMetrics,
 	}
 }
 
@@ -539,6 +596,12 @@ func (r *DRPlacementControlReconciler) reconcileDRPCInstance(d *DRPCInstance, lo
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	if d.recipeParamsPending {
+		log.Info(fmt.Sprintf("Requeing after %v to validate the recipe parameters", recipeParametersRequeueDelay))
+
+		return ctrl.Result{RequeueAfter: recipeParametersRequeueDelay}, nil
+	}
+
 	// Last status update time AFTER processing
 	var afterProcessing metav1.Time
 	if d.instance.Status.LastUpdateTime != nil {
@@ -739,12 +802,14 @@ func (r *DRPlacementControlReconciler) finalizeDRPC(ctx context.Context, drpc *r
 	// delete metrics if matching labels are found
 	syncTimeMetricLabels := SyncTimeMetricLabels(drPolicy, drpc)
 	DeleteSyncTimeMetric(syncTimeMetricLabels)
+	DeleteSyncDeferredMetric(syncTimeMetricLabels)
 
 	syncDurationMetricLabels := SyncDurationMetricLabels(drPolicy, drpc)
 	DeleteSyncDurationMetric(syncDurationMetricLabels)
 
 	syncDataBytesMetricLabels := SyncDataBytesMetricLabels(drPolicy, drpc)
 	DeleteSyncDataBytesMetric(syncDataBytesMetricLabels)
+	DeleteSyncProgressMetric(syncDataBytesMetricLabels)
 
 	workloadProtectionLabels := WorkloadProtectionStatusLabels(drpc)
 	DeleteWorkloadProtectionStatusMetric(workloadProtectionLabels)
@@ -851,6 +916,16 @@ func (r *DRPlacementControlReconciler) deleteAllManagedClusterViews(
 		if err != nil {
 			return fmt.Errorf("failed to delete namespace MCV %w", err)
 		}
+
+		// Delete MCV for the Recipe
+		if drpc.Spec.KubeObjectProtection != nil && drpc.Spec.KubeObjectProtection.RecipeRef != nil {
+			recipeRef := drpc.Spec.KubeObjectProtection.RecipeRef
+
+			err = r.MCVGetter.DeleteRecipeManagedClusterView(recipeRef.Name, recipeRef.Namespace, drClusterName)
+			if err != nil {
+				return fmt.Errorf("failed to delete recipe MCV %w", err)
+			}
+		}
 	}
 
 	return nil
@@ -1425,6 +1500,7 @@ func (r *DRPlacementControlReconciler) updateResourceCondition(
 		Generation:      vrg.Generation,
 		ResourceVersion: vrg.ResourceVersion,
 		ProtectedPVCs:   extractProtectedPVCNames(vrg),
+		ExcludedPVCs:    vrg.Status.ExcludedPVCs,
 	}
 
 	drpc.Status.ResourceConditions.Conditions = assignConditionsWithConflictCheck(
@@ -1438,13 +1514,57 @@ func (r *DRPlacementControlReconciler) updateResourceCondition(
 		drpc.Status.LastGroupSyncTime = vrg.Status.LastGroupSyncTime
 		drpc.Status.LastGroupSyncDuration = vrg.Status.LastGroupSyncDuration
 		drpc.Status.LastGroupSyncBytes = vrg.Status.LastGroupSyncBytes
+		drpc.Status.LastGroupSyncSlowestPVC, drpc.Status.LastGroupSyncSlowestPVCError = slowestProtectedPVC(vrg)
 	}
 
+	drpc.Status.SyncDeferredUntil = vrg.Status.SyncDeferredUntil
+	drpc.Status.GroupSyncProgress = vrg.Status.GroupSyncProgress
+	drpc.Status.ReplicationTypes = vrg.Status.ReplicationTypes
+
 	if vrg.Status.KubeObjectProtection.CaptureToRecoverFrom != nil {
 		drpc.Status.LastKubeObjectProtectionTime = &vrg.Status.KubeObjectProtection.CaptureToRecoverFrom.EndTime
 	}
 
 	updateDRPCProtectedCondition(drpc, vrg, clusterName)
+	updateDRPCRecipeParametersCondition(drpc, vrg, clusterName)
+}
+
+// slowestProtectedPVC returns the namespaced name of the PVC protected by VolSync of a VRG that
+// has not synced, or synced the least recently, or took the longest to sync among those, and the
+// reason its most recent sync failed or is stuck, or empty strings if the VRG protects no PVC by
+// VolSync. The PVCs protected by VolRep are synced together, by their VolumeReplications.
+func slowestProtectedPVC(vrg *rmn.VolumeReplicationGroup) (string, string) {
+	var slowest *rmn.ProtectedPVC
+
+	for idx := range vrg.Status.ProtectedPVCs {
+		protectedPVC := &vrg.Status.ProtectedPVCs[idx]
+		if !protectedPVC.ProtectedByVolSync {
+			continue
+		}
+
+		if slowest == nil || protectedPVCSlower(protectedPVC, slowest) {
+			slowest = protectedPVC
+		}
+	}
+
+	if slowest == nil {
+		return "", ""
+	}
+
+	return slowest.Namespace + "/" + slowest.Name, slowest.LastSyncError
+}
+
+func protectedPVCSlower(a, b *rmn.ProtectedPVC) bool {
+	switch {
+	case a.LastSyncTime == nil || b.LastSyncTime == nil:
+		return a.LastSyncTime == nil && b.LastSyncTime != nil
+	case !a.LastSyncTime.Equal(b.LastSyncTime):
+		return a.LastSyncTime.Before(b.LastSyncTime)
+	case a.LastSyncDuration == nil || b.LastSyncDuration == nil:
+		return a.LastSyncDuration != nil && b.LastSyncDuration == nil
+	default:
+		return a.LastSyncDuration.Duration > b.LastSyncDuration.Duration
+	}
 }
 
 // getVRG retrieves a VRG either from the provided map or fetches it from the managed cluster/S3 store.
@@ -1668,6 +1788,8 @@ func (r *DRPlacementControlReconciler) setDRPCMetrics(ctx context.Context,
 		r.setLastSyncTimeMetric(&syncMetrics.SyncTimeMetrics, drpc.Status.LastGroupSyncTime, log)
 		r.setLastSyncDurationMetric(&syncMetrics.SyncDurationMetrics, drpc.Status.LastGroupSyncDuration, log)
 		r.setLastSyncBytesMetric(&syncMetrics.SyncDataBytesMetrics, drpc.Status.LastGroupSyncBytes, log)
+		r.setSyncProgressMetric(&syncMetrics.SyncProgressMetrics, drpc.Status.GroupSyncProgress, log)
+		r.setSyncDeferredMetric(&syncMetrics.SyncDeferredMetrics, drpc.Status.SyncDeferredUntil, log)
 	}
 
 	return nil
@@ -2740,6 +2862,26 @@ func constructVRGFromView(viewVRG *rmn.VolumeReplicationGroup) *rmn.VolumeReplic
 	return vrg
 }
 
+// ensureDRPCValidVolSyncSpec rejects VolSync settings that the selected mover would ignore
+func ensureDRPCValidVolSyncSpec(drpc *rmn.DRPlacementControl) error {
+	volSyncSpec := drpc.Spec.VolSyncSpec
+	if volSyncSpec == nil {
+		return nil
+	}
+
+	if volSyncSpec.BandwidthLimit != nil && volSyncSpec.Mover != rmn.VolSyncMoverRclone {
+		mover := volSyncSpec.Mover
+		if mover == "" {
+			mover = rmn.VolSyncMoverRsyncTLS
+		}
+
+		return fmt.Errorf("drpc volSyncSpec bandwidthLimit is only enforced by the %s mover, not by the %s mover",
+			rmn.VolSyncMoverRclone, mover)
+	}
+
+	return nil
+}
+
 func ensureDRPCValidNamespace(drpc *rmn.DRPlacementControl, ramenConfig *rmn.RamenConfig) error {
 	if drpcInAdminNamespace(drpc, ramenConfig) {
 		if !ramenConfig.MultiNamespace.FeatureEnabled {
@@ -2751,13 +2893,17 @@ func ensureDRPCValidNamespace(drpc *rmn.DRPlacementControl, ramenConfig *rmn.Ram
 		}
 
 		adminNamespace := drpcAdminNamespaceName(*ramenConfig)
-		if slices.Contains(*drpc.Spec.ProtectedNamespaces, adminNamespace) {
+		if slices.Contains(drpcNamespaceNames(drpc), adminNamespace) {
 			return fmt.Errorf("admin namespace cannot be a protected namespace, admin namespace: %s", adminNamespace)
 		}
 
 		return nil
 	}
 
+	if len(drpc.Spec.NamespaceMappings) != 0 {
+		return fmt.Errorf("drpc in non-admin namespace(%v) cannot have namespace mappings", drpc.Namespace)
+	}
+
 	if isDiscoveredApp(drpc) {
 		adminNamespace := drpcAdminNamespaceName(*ramenConfig)
 
@@ -2768,6 +2914,29 @@ func ensureDRPCValidNamespace(drpc *rmn.DRPlacementControl, ramenConfig *rmn.Ram
 	return nil
 }
 
+// drpcNamespaceNames returns the names of the protected namespaces of a DRPC, and the names they are mapped to on
+// any of its clusters
+func drpcNamespaceNames(drpc *rmn.DRPlacementControl) []string {
+	namespaces := slices.Clone(*drpc.Spec.ProtectedNamespaces)
+
+	for _, nsMapping := range drpc.Spec.NamespaceMappings {
+		nsNames := slices.Sorted(maps.Values(nsMapping.NamespaceNames))
+		if !slices.ContainsFunc(nsNames, func(nsName string) bool {
+			return slices.Contains(*drpc.Spec.ProtectedNamespaces, nsName)
+		}) {
+			continue
+		}
+
+		for _, nsName := range nsNames {
+			if !slices.Contains(namespaces, nsName) {
+				namespaces = append(namespaces, nsName)
+			}
+		}
+	}
+
+	return namespaces
+}
+
 func drpcsProtectCommonNamespace(drpcProtectedNs []string, otherDRPCProtectedNs []string) bool {
 	for _, ns := range drpcProtectedNs {
 		if slices.Contains(otherDRPCProtectedNs, ns) {
@@ -2782,7 +2951,7 @@ func (r *DRPlacementControlReconciler) getProtectedNamespaces(drpc *rmn.DRPlacem
 	log logr.Logger,
 ) ([]string, error) {
 	if isDiscoveredApp(drpc) {
-		return *drpc.Spec.ProtectedNamespaces, nil
+		return drpcNamespaceNames(drpc), nil
 	}
 
 	placementObj, err := r.getPlacementForDRPC(context.TODO(), drpc, log)
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+func TestDRPCFinalSyncMixedReplication(t *testing.T) {
+	const homeCluster = "east"
+
+	complete := rmn.ReplicationTypeStatus{FinalSyncPrepared: true, FinalSyncComplete: true}
+
+	tests := []struct {
+		name             string
+		replicationTypes []rmn.ReplicationTypeStatus
+		prepared         bool
+		completed        bool
+		message          string
+	}{
+		{
+			name:             "VolSync not prepared for the final sync",
+			replicationTypes: []rmn.ReplicationTypeStatus{{FinalSyncPrepared: true}, {}},
+			message:          "Preparing for the final sync of replication types VolSync",
+		},
+		{
+			name: "VolRep final sync incomplete",
+			replicationTypes: []rmn.ReplicationTypeStatus{
+				{FinalSyncPrepared: true, Message: "waiting for 1 PVCs to be no longer in use"}, complete,
+			},
+			prepared: true,
+			message:  "Running the final sync of replication types VolRep (waiting for 1 PVCs to be no longer in use)",
+		},
+		{
+			name:             "final sync of every replication type complete",
+			replicationTypes: []rmn.ReplicationTypeStatus{complete, complete},
+			prepared:         true,
+			completed:        true,
+		},
+		{
+			name:      "single replication type",
+			prepared:  true,
+			completed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrg := &rmn.VolumeReplicationGroup{Status: rmn.VolumeReplicationGroupStatus{
+				PrepareForFinalSyncComplete: true,
+				FinalSyncComplete:           true,
+			}}
+
+			if tt.replicationTypes != nil {
+				tt.replicationTypes[0].Type = rmn.ReplicationTypeVolRep
+				tt.replicationTypes[1].Type = rmn.ReplicationTypeVolSync
+				vrg.Status.ReplicationTypes = tt.replicationTypes
+			}
+
+			d := &DRPCInstance{
+				log:      logr.Discard(),
+				instance: &rmn.DRPlacementControl{},
+				vrgs:     map[string]*rmn.VolumeReplicationGroup{homeCluster: vrg},
+			}
+
+			prepared, err := d.prepareForFinalSync(homeCluster)
+			require.NoError(t, err)
+			assert.Equal(t, tt.prepared, prepared)
+
+			if prepared {
+				completed, err := d.runFinalSync(homeCluster)
+				require.NoError(t, err)
+				assert.Equal(t, tt.completed, completed)
+			}
+
+			message := ""
+			if condition := rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionAvailable); condition != nil {
+				message = condition.Message
+			}
+
+			assert.Equal(t, tt.message, message)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/core"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// recipeParametersRequeueDelay is the delay before the recipe parameters are validated again when the recipe they
+// are validated against cannot be viewed yet.
+const recipeParametersRequeueDelay = time.Second * 10
+
+// recipeParametersTargetCluster returns the cluster where the VRG is deployed or changed by the current action of
+// the DRPC, and so where its recipe parameters are going to be used.
+func (d *DRPCInstance) recipeParametersTargetCluster() string {
+	switch d.instance.Spec.Action {
+	case rmn.ActionFailover:
+		return d.instance.Spec.FailoverCluster
+	case rmn.ActionRelocate:
+		return d.instance.Spec.PreferredCluster
+	}
+
+	homeCluster, _ := d.getHomeClusterForInitialDeploy()
+
+	return homeCluster
+}
+
+// validateRecipeParameters validates the recipe parameters of the DRPC against the parameters declared by its
+// recipe on the target cluster, and reports the result in the RecipeParametersValid condition. An error is only
+// returned for parameters the recipe does not accept, so that a VRG is not deployed or changed with them. When
+// the recipe cannot be viewed yet, the action proceeds with the parameters left to the VRG to validate, and the
+// validation is retried after recipeParametersRequeueDelay.
+func (d *DRPCInstance) validateRecipeParameters() error {
+	kubeObjectProtection := d.instance.Spec.KubeObjectProtection
+	if kubeObjectProtection == nil || kubeObjectProtection.RecipeRef == nil ||
+		kubeObjectProtection.RecipeRef.Name == core.VMRecipeName {
+		return nil
+	}
+
+	cluster := d.recipeParametersTargetCluster()
+	if cluster == "" {
+		return nil
+	}
+
+	recipeRef := kubeObjectProtection.RecipeRef
+	annotations := map[string]string{
+		DRPCNameAnnotation:      d.instance.GetName(),
+		DRPCNamespaceAnnotation: d.instance.GetNamespace(),
+	}
+
+	recipe, err := d.reconciler.MCVGetter.GetRecipeFromManagedCluster(recipeRef.Name, recipeRef.Namespace, cluster,
+		annotations)
+	if err != nil {
+		// The recipe may be deployed with the workload, and the view of it may not be ready yet. Either way, the
+		// parameters are validated again once the view reports the recipe.
+		d.recipeParamsPending = true
+
+		if k8serrors.IsNotFound(err) {
+			message := fmt.Sprintf("Recipe %s/%s is not found on cluster %s", recipeRef.Namespace, recipeRef.Name,
+				cluster)
+
+			d.log.Info("Recipe parameters not validated", "reason", message)
+			d.setRecipeParametersCondition(metav1.ConditionFalse, rmn.ReasonRecipeNotFound, message)
+
+			return nil
+		}
+
+		message := fmt.Sprintf("Recipe %s/%s on cluster %s is not available to validate parameters: %v",
+			recipeRef.Namespace, recipeRef.Name, cluster, err)
+
+		d.log.Info("Recipe parameters not validated", "reason", message)
+		d.setRecipeParametersCondition(metav1.ConditionUnknown, rmn.ReasonRecipeParametersUnknown, message)
+
+		return nil
+	}
+
+	if _, err := recipeParametersValidate(*recipe, kubeObjectProtection.RecipeParameters); err != nil {
+		if !errors.As(err, &RecipeParametersError{}) {
+			return err
+		}
+
+		message := fmt.Sprintf("Recipe %s/%s on cluster %s does not accept the parameters: %v",
+			recipeRef.Namespace, recipeRef.Name, cluster, err)
+		d.setRecipeParametersCondition(metav1.ConditionFalse, rmn.ReasonRecipeParametersInvalid, message)
+		rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeWarning,
+			rmnutil.EventReasonValidationFailed, message)
+
+		return errors.New(message)
+	}
+
+	d.setRecipeParametersCondition(metav1.ConditionTrue, rmn.ReasonRecipeParametersValid,
+		fmt.Sprintf("Recipe %s/%s on cluster %s accepts the parameters", recipeRef.Namespace, recipeRef.Name,
+			cluster))
+
+	return nil
+}
+
+func (d *DRPCInstance) setRecipeParametersCondition(status metav1.ConditionStatus, reason, message string) {
+	addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionRecipeParametersValid, d.instance.Generation,
+		status, reason, message)
+}
+
+// updateDRPCRecipeParametersCondition reports in the RecipeParametersValid condition a VRG that rejected the
+// recipe parameters, such as when the recipe changed on the cluster after the hub validated them.
+func updateDRPCRecipeParametersCondition(drpc *rmn.DRPlacementControl, vrg *rmn.VolumeReplicationGroup,
+	clusterName string,
+) {
+	dataReady := rmnutil.FindCondition(vrg.Status.Conditions, VRGConditionTypeDataReady)
+	if dataReady == nil || dataReady.Reason != VRGConditionReasonRecipeParametersInvalid ||
+		dataReady.ObservedGeneration != vrg.Generation {
+		return
+	}
+
+	addOrUpdateCondition(&drpc.Status.Conditions, rmn.ConditionRecipeParametersValid, drpc.Generation,
+		metav1.ConditionFalse, rmn.ReasonRecipeParametersInvalid,
+		fmt.Sprintf("VolumeReplicationGroup (%s/%s) on cluster %s rejected the recipe parameters: %s",
+			vrg.GetNamespace(), vrg.GetName(), clusterName, dataReady.Message))
+}
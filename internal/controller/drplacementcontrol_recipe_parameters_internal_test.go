@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// recipeMCVGetter views the recipe of a single cluster
+type recipeMCVGetter struct {
+	rmnutil.ManagedClusterViewGetter
+	cluster string
+	recipe  *recipev1.Recipe
+	err     error
+}
+
+func (g recipeMCVGetter) GetRecipeFromManagedCluster(resourceName, resourceNamespace, managedCluster string,
+	annotations map[string]string,
+) (*recipev1.Recipe, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	if managedCluster != g.cluster || g.recipe == nil {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{}, "requested resource not found in ManagedCluster")
+	}
+
+	return g.recipe, nil
+}
+
+var _ = Describe("DRPC recipe parameters", func() {
+	var recipe *recipev1.Recipe
+
+	newDRPCInstance := func(action rmn.DRAction, parameters map[string][]string, mcvGetter recipeMCVGetter,
+	) *DRPCInstance {
+		return &DRPCInstance{
+			ctx: context.TODO(),
+			log: ctrl.Log.WithName("test"),
+			reconciler: &DRPlacementControlReconciler{
+				MCVGetter:     mcvGetter,
+				eventRecorder: rmnutil.NewEventReporter(record.NewFakeRecorder(10)),
+			},
+			instance: &rmn.DRPlacementControl{
+				ObjectMeta: metav1.ObjectMeta{Name: "drpc", Namespace: "app", Generation: 1},
+				Spec: rmn.DRPlacementControlSpec{
+					Action:           action,
+					PreferredCluster: "east",
+					FailoverCluster:  "west",
+					KubeObjectProtection: &rmn.KubeObjectProtectionSpec{
+						RecipeRef:        &rmn.RecipeRef{Namespace: "app", Name: "recipe"},
+						RecipeParameters: parameters,
+					},
+				},
+			},
+		}
+	}
+
+	condition := func(d *DRPCInstance) *metav1.Condition {
+		return rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionRecipeParametersValid)
+	}
+
+	BeforeEach(func() {
+		recipe = &recipev1.Recipe{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "app",
+				Name:      "recipe",
+				Annotations: map[string]string{
+					RecipeParametersAnnotation: `[{"name": "REPLICAS", "type": "integer", "required": true}]`,
+				},
+			},
+		}
+	})
+
+	It("Should accept parameters matching the declaration of the recipe on the target cluster", func() {
+		d := newDRPCInstance("", map[string][]string{"REPLICAS": {"3"}},
+			recipeMCVGetter{cluster: "east", recipe: recipe})
+
+		Expect(d.validateRecipeParameters()).To(Succeed())
+		Expect(condition(d).Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition(d).Reason).To(Equal(rmn.ReasonRecipeParametersValid))
+		Expect(d.recipeParamsPending).To(BeFalse())
+	})
+
+	It("Should block the action when the recipe rejects the parameters", func() {
+		d := newDRPCInstance("", map[string][]string{"REPLICAS": {"three"}},
+			recipeMCVGetter{cluster: "east", recipe: recipe})
+
+		Expect(d.validateRecipeParameters()).To(MatchError(ContainSubstring("not an integer")))
+		Expect(condition(d).Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition(d).Reason).To(Equal(rmn.ReasonRecipeParametersInvalid))
+	})
+
+	It("Should block the action when a required parameter is not set", func() {
+		d := newDRPCInstance("", nil, recipeMCVGetter{cluster: "east", recipe: recipe})
+
+		Expect(d.validateRecipeParameters()).To(MatchError(ContainSubstring("REPLICAS")))
+		Expect(condition(d).Reason).To(Equal(rmn.ReasonRecipeParametersInvalid))
+	})
+
+	It("Should validate against the recipe on the failover cluster on failover", func() {
+		d := newDRPCInstance(rmn.ActionFailover, map[string][]string{"REPLICAS": {"three"}},
+			recipeMCVGetter{cluster: "west", recipe: recipe})
+
+		Expect(d.validateRecipeParameters()).NotTo(Succeed())
+		Expect(condition(d).Message).To(ContainSubstring("cluster west"))
+	})
+
+	It("Should not block the action and retry when the recipe is not found", func() {
+		d := newDRPCInstance(rmn.ActionRelocate, map[string][]string{"REPLICAS": {"three"}},
+			recipeMCVGetter{cluster: "west", recipe: recipe})
+
+		Expect(d.validateRecipeParameters()).To(Succeed())
+		Expect(condition(d).Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition(d).Reason).To(Equal(rmn.ReasonRecipeNotFound))
+		Expect(d.recipeParamsPending).To(BeTrue())
+	})
+
+	It("Should not block the action and retry when the view of the recipe is not ready", func() {
+		d := newDRPCInstance(rmn.ActionRelocate, map[string][]string{"REPLICAS": {"three"}},
+			recipeMCVGetter{cluster: "east", recipe: recipe, err: errors.New("ManagedClusterView is not ready")})
+
+		Expect(d.validateRecipeParameters()).To(Succeed())
+		Expect(condition(d).Status).To(Equal(metav1.ConditionUnknown))
+		Expect(condition(d).Reason).To(Equal(rmn.ReasonRecipeParametersUnknown))
+		Expect(d.recipeParamsPending).To(BeTrue())
+	})
+
+	It("Should not report the condition without a recipe", func() {
+		d := newDRPCInstance("", nil, recipeMCVGetter{})
+		d.instance.Spec.KubeObjectProtection.RecipeRef = nil
+
+		Expect(d.validateRecipeParameters()).To(Succeed())
+		Expect(condition(d)).To(BeNil())
+	})
+
+	It("Should report a VRG that rejected the parameters", func() {
+		drpc := &rmn.DRPlacementControl{ObjectMeta: metav1.ObjectMeta{Generation: 2}}
+		vrg := &rmn.VolumeReplicationGroup{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "drpc", Generation: 3}}
+
+		updateDRPCRecipeParametersCondition(drpc, vrg, "east")
+		Expect(rmnutil.FindCondition(drpc.Status.Conditions, rmn.ConditionRecipeParametersValid)).To(BeNil())
+
+		setVRGDataRecipeParametersInvalidCondition(&vrg.Status.Conditions, vrg.Generation, "not an integer")
+		updateDRPCRecipeParametersCondition(drpc, vrg, "east")
+
+		drpcCondition := rmnutil.FindCondition(drpc.Status.Conditions, rmn.ConditionRecipeParametersValid)
+		Expect(drpcCondition).NotTo(BeNil())
+		Expect(drpcCondition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(drpcCondition.Message).To(ContainSubstring("not an integer"))
+	})
+})
@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// splitBrainReported returns whether the DRPC reports a split-brain that is not resolved yet, or the VRGs of the
+// workload report one
+func (d *DRPCInstance) splitBrainReported() bool {
+	splitBrain := d.instance.Status.SplitBrain
+	if splitBrain != nil && splitBrain.Resolution != nil && splitBrain.Resolution.ResolvedTime == nil {
+		return true
+	}
+
+	condition := rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionSplitBrain)
+	if condition != nil && condition.Status == metav1.ConditionTrue {
+		return true
+	}
+
+	return d.vrgsReportSplitBrain()
+}
+
+// reconcileSplitBrain detects more than one cluster acting as the primary of the workload, and resolves it once the
+// operator picks a winner. It returns true while a split-brain is unresolved, during which the DR actions are blocked.
+func (d *DRPCInstance) reconcileSplitBrain() (bool, error) {
+	splitBrain := d.instance.Status.SplitBrain
+	if splitBrain != nil && splitBrain.Resolution != nil && splitBrain.Resolution.ResolvedTime == nil {
+		return d.resolveSplitBrain()
+	}
+
+	if !d.vrgsReportSplitBrain() {
+		d.clearSplitBrainCondition()
+
+		return false, nil
+	}
+
+	clusters := d.splitBrainClusters()
+
+	condition := rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionSplitBrain)
+	if splitBrain == nil || condition == nil || condition.Status != metav1.ConditionTrue {
+		splitBrain = &rmn.SplitBrainStatus{
+			DetectedTime:       metav1.Now(),
+			DetectedGeneration: d.instance.Generation,
+		}
+		d.instance.Status.SplitBrain = splitBrain
+
+		rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeWarning,
+			rmnutil.EventReasonSplitBrainDetected, splitBrainMessage(clusters))
+	}
+
+	splitBrain.Clusters = clusters
+
+	winner := d.instance.Spec.SplitBrainWinner
+	if winner == "" || d.instance.Generation <= splitBrain.DetectedGeneration {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, d.instance.Generation,
+			metav1.ConditionTrue, rmn.ReasonSplitBrainDetected,
+			splitBrainMessage(clusters)+", set spec.splitBrainWinner to the cluster whose data to keep")
+
+		return true, nil
+	}
+
+	if err := validateSplitBrainWinner(winner, clusters); err != nil {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, d.instance.Generation,
+			metav1.ConditionTrue, rmn.ReasonSplitBrainDetected, splitBrainMessage(clusters)+", "+err.Error())
+
+		return true, nil
+	}
+
+	resolution := &rmn.SplitBrainResolution{
+		Winner:       winner,
+		DecisionTime: metav1.Now(),
+	}
+
+	for idx := range clusters {
+		if clusters[idx].ClusterName != winner {
+			resolution.Losers = append(resolution.Losers, clusters[idx].ClusterName)
+		}
+	}
+
+	splitBrain.Resolution = resolution
+
+	d.log.Info("Resolving split-brain", "winner", resolution.Winner, "losers", resolution.Losers)
+	rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeNormal,
+		rmnutil.EventReasonSplitBrainResolving,
+		fmt.Sprintf("Keeping the data of cluster %s, and resyncing clusters %v from it",
+			resolution.Winner, resolution.Losers))
+
+	return d.resolveSplitBrain()
+}
+
+// vrgsReportSplitBrain returns whether more than one VRG of the workload reports that it acts as the primary, other
+// than while a failover is in progress, or whether any reports its PVCs in split-brain
+func (d *DRPCInstance) vrgsReportSplitBrain() bool {
+	primaries := 0
+
+	for _, vrg := range d.vrgs {
+		if vrgReportsSplitBrain(vrg) {
+			return true
+		}
+
+		if vrgActingPrimary(vrg) {
+			primaries++
+		}
+	}
+
+	// A failover promotes the VRG on the failover cluster before the VRG on the failed cluster is demoted
+	failoverInProgress := d.instance.Spec.Action == rmn.ActionFailover &&
+		d.getProgression() != rmn.ProgressionCompleted
+
+	return primaries > 1 && !failoverInProgress
+}
+
+// splitBrainClusters returns the states of the VRGs of the workload in a split-brain
+func (d *DRPCInstance) splitBrainClusters() []rmn.SplitBrainCluster {
+	lastKnownPrimary := GetLastKnownVRGPrimaryFromS3(d.ctx, d.reconciler.APIReader,
+		GetAvailableS3Profiles(d.ctx, d.reconciler.Client, d.instance, d.log),
+		d.instance.GetName(), d.vrgNamespace, d.reconciler.ObjStoreGetter, d.log)
+
+	clusters := []rmn.SplitBrainCluster{}
+
+	for _, clusterName := range slices.Sorted(maps.Keys(d.vrgs)) {
+		vrg := d.vrgs[clusterName]
+
+		clusters = append(clusters, rmn.SplitBrainCluster{
+			ClusterName:           clusterName,
+			State:                 vrg.Status.State,
+			VRGGeneration:         vrg.Generation,
+			VRGObservedGeneration: vrg.Status.ObservedGeneration,
+			LastKnownPrimary:      lastKnownPrimary != nil && lastKnownPrimary.UID == vrg.UID,
+			SplitBrainPVCs:        vrgSplitBrainPVCs(vrg),
+		})
+	}
+
+	return clusters
+}
+
+// vrgActingPrimary returns whether a VRG is primary, as processed for its current generation
+func vrgActingPrimary(vrg *rmn.VolumeReplicationGroup) bool {
+	return isVRGPrimary(vrg) &&
+		vrg.Status.State == rmn.PrimaryState &&
+		vrg.Status.ObservedGeneration == vrg.Generation
+}
+
+// vrgReportsSplitBrain returns whether a VRG reports the VolumeReplication of any of its PVCs in split-brain
+func vrgReportsSplitBrain(vrg *rmn.VolumeReplicationGroup) bool {
+	condition := rmnutil.FindCondition(vrg.Status.Conditions, VRGConditionTypeReplicationDegraded)
+
+	return condition != nil &&
+		condition.Status == metav1.ConditionTrue &&
+		condition.Reason == VRGConditionReasonSplitBrain
+}
+
+func vrgSplitBrainPVCs(vrg *rmn.VolumeReplicationGroup) []string {
+	var pvcs []string
+
+	for idx := range vrg.Status.ProtectedPVCs {
+		protectedPVC := &vrg.Status.ProtectedPVCs[idx]
+
+		if protectedPVC.VolumeReplicationState != nil && protectedPVC.VolumeReplicationState.SplitBrain {
+			pvcs = append(pvcs, rmnutil.ProtectedPVCNamespacedName(*protectedPVC).String())
+		}
+	}
+
+	return pvcs
+}
+
+func splitBrainMessage(clusters []rmn.SplitBrainCluster) string {
+	primaries := []string{}
+	storageReported := []string{}
+
+	for idx := range clusters {
+		if clusters[idx].State == rmn.PrimaryState {
+			primaries = append(primaries, clusters[idx].ClusterName)
+		}
+
+		if len(clusters[idx].SplitBrainPVCs) != 0 {
+			storageReported = append(storageReported, clusters[idx].ClusterName)
+		}
+	}
+
+	message := fmt.Sprintf("Split-brain detected, VRGs are primary on clusters %v", primaries)
+	if len(storageReported) != 0 {
+		message += fmt.Sprintf(", and PVCs are in split-brain on clusters %v", storageReported)
+	}
+
+	return message
+}
+
+// validateSplitBrainWinner returns an error unless the winner is a cluster of the split-brain. The winner need not be
+// the cluster the DRPC places the workload on, to which the DR action moves the workload once the split-brain is
+// resolved.
+func validateSplitBrainWinner(winner string, clusters []rmn.SplitBrainCluster) error {
+	if !slices.ContainsFunc(clusters, func(cluster rmn.SplitBrainCluster) bool {
+		return cluster.ClusterName == winner
+	}) {
+		return fmt.Errorf("split-brain winner %s is not a cluster of the split-brain", winner)
+	}
+
+	return nil
+}
+
+// resolveSplitBrain places the workload on the winner of a split-brain, and demotes the VRGs of the losers to
+// secondary with a resync from it. It returns true until the losers report secondary, and no split-brain remains.
+func (d *DRPCInstance) resolveSplitBrain() (bool, error) {
+	resolution := d.instance.Status.SplitBrain.Resolution
+
+	if _, err := d.updateVRGState(resolution.Winner, rmn.Primary); err != nil {
+		return true, err
+	}
+
+	if err := d.updateUserPlacementRule(resolution.Winner, resolution.Winner); err != nil {
+		return true, err
+	}
+
+	d.instance.Status.PreferredDecision = rmn.PlacementDecision{
+		ClusterName:      resolution.Winner,
+		ClusterNamespace: resolution.Winner,
+	}
+
+	pending := []string{}
+
+	for _, loser := range resolution.Losers {
+		if err := d.demoteSplitBrainLoser(loser); err != nil {
+			return true, err
+		}
+
+		vrg := d.vrgs[loser]
+		if vrg == nil || vrg.Status.State != rmn.SecondaryState || vrg.Status.ObservedGeneration != vrg.Generation {
+			pending = append(pending, loser)
+		}
+	}
+
+	storageSplitBrain := false
+
+	for _, vrg := range d.vrgs {
+		storageSplitBrain = storageSplitBrain || vrgReportsSplitBrain(vrg)
+	}
+
+	if len(pending) != 0 || storageSplitBrain {
+		addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, d.instance.Generation,
+			metav1.ConditionTrue, rmn.ReasonSplitBrainResolving,
+			fmt.Sprintf("Keeping the data of cluster %s, waiting for clusters %v to be secondary, and for the "+
+				"storage to resync them", resolution.Winner, resolution.Losers))
+
+		return true, nil
+	}
+
+	now := metav1.Now()
+	resolution.ResolvedTime = &now
+	message := fmt.Sprintf("Split-brain resolved, keeping the data of cluster %s", resolution.Winner)
+
+	addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, d.instance.Generation,
+		metav1.ConditionFalse, rmn.ReasonSplitBrainResolved, message)
+	rmnutil.ReportIfNotPresent(d.reconciler.eventRecorder, d.instance, corev1.EventTypeNormal,
+		rmnutil.EventReasonSplitBrainResolved, message)
+
+	return false, nil
+}
+
+// demoteSplitBrainLoser updates the VRG of a loser of a split-brain to secondary, with the failover action which
+// resyncs its volumes from the primary
+func (d *DRPCInstance) demoteSplitBrainLoser(clusterName string) error {
+	vrg, err := d.getVRGFromManifestWork(clusterName)
+	if err != nil {
+		return fmt.Errorf("failed to demote VRG of split-brain loser %s (%w)", clusterName, err)
+	}
+
+	if vrg.Spec.ReplicationState == rmn.Secondary && vrg.Spec.Action == rmn.VRGActionFailover {
+		return nil
+	}
+
+	vrg.Spec.ReplicationState = rmn.Secondary
+	vrg.Spec.Action = rmn.VRGActionFailover
+	vrg.Spec.PrepareForFinalSync = false
+	vrg.Spec.RunFinalSync = false
+
+	d.log.Info("Demoting VRG of split-brain loser", "cluster", clusterName)
+
+	return d.updateManifestWork(clusterName, vrg)
+}
+
+// clearSplitBrainCondition keeps the SplitBrain condition current once no split-brain is detected, by reporting a
+// detected split-brain that cleared without a resolution as resolved
+func (d *DRPCInstance) clearSplitBrainCondition() {
+	condition := rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionSplitBrain)
+	if condition == nil {
+		return
+	}
+
+	message := condition.Message
+	if condition.Status == metav1.ConditionTrue {
+		message = "Split-brain no longer detected"
+	}
+
+	addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, d.instance.Generation,
+		metav1.ConditionFalse, rmn.ReasonSplitBrainResolved, message)
+}
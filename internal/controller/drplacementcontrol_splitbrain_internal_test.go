@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	plrv1 "github.com/stolostron/multicloud-operators-placementrule/pkg/apis/apps/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+func splitBrainVRG(clusterName string, state rmn.ReplicationState) *rmn.VolumeReplicationGroup {
+	vrg := &rmn.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "drpc",
+			Namespace:  "app",
+			UID:        types.UID("vrg-" + clusterName),
+			Generation: 1,
+		},
+		Spec: rmn.VolumeReplicationGroupSpec{
+			ReplicationState: state,
+			S3Profiles:       []string{"s3"},
+		},
+		Status: rmn.VolumeReplicationGroupStatus{
+			State:              rmn.PrimaryState,
+			ObservedGeneration: 1,
+		},
+	}
+	if state == rmn.Secondary {
+		vrg.Status.State = rmn.SecondaryState
+	}
+
+	return vrg
+}
+
+func splitBrainReportedBy(vrg *rmn.VolumeReplicationGroup) *rmn.VolumeReplicationGroup {
+	vrg.Status.ProtectedPVCs = []rmn.ProtectedPVC{{
+		Name:                   "pvc",
+		Namespace:              "app",
+		VolumeReplicationState: &rmn.VolumeReplicationState{SplitBrain: true},
+	}}
+	rmnutil.SetStatusCondition(&vrg.Status.Conditions, metav1.Condition{
+		Type:   VRGConditionTypeReplicationDegraded,
+		Status: metav1.ConditionTrue,
+		Reason: VRGConditionReasonSplitBrain,
+	})
+
+	return vrg
+}
+
+// splitBrainDRPCInstance returns the DRPCInstance of a workload whose VRGs are primary on the east and west clusters,
+// and the S3 store of the VRGs
+func splitBrainDRPCInstance(t *testing.T) (*DRPCInstance, memoryObjectStorer) {
+	t.Helper()
+
+	drpc := &rmn.DRPlacementControl{
+		ObjectMeta: metav1.ObjectMeta{Name: "drpc", Namespace: "app", Generation: 1},
+		Spec: rmn.DRPlacementControlSpec{
+			DRPolicyRef:      corev1.ObjectReference{Name: "policy"},
+			PreferredCluster: "east",
+		},
+	}
+	placementRule := &plrv1.PlacementRule{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "app"}}
+
+	k8sClient := fakeClientBuilder(t).
+		WithStatusSubresource(&plrv1.PlacementRule{}).
+		WithObjects(
+			drpc,
+			placementRule,
+			&rmn.DRPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+				Spec:       rmn.DRPolicySpec{DRClusters: []string{"east", "west"}},
+			},
+			&rmn.DRCluster{ObjectMeta: metav1.ObjectMeta{Name: "east"}, Spec: rmn.DRClusterSpec{S3ProfileName: "s3"}},
+			&rmn.DRCluster{ObjectMeta: metav1.ObjectMeta{Name: "west"}, Spec: rmn.DRClusterSpec{S3ProfileName: "s3"}},
+		).Build()
+
+	objectStorer := memoryObjectStorer{}
+	d := &DRPCInstance{
+		reconciler: &DRPlacementControlReconciler{
+			Client:         k8sClient,
+			APIReader:      k8sClient,
+			Log:            logr.Discard(),
+			ObjStoreGetter: memoryObjectStoreGetter{objectStorer: objectStorer},
+			eventRecorder:  rmnutil.NewEventReporter(record.NewFakeRecorder(10)),
+		},
+		ctx:           context.TODO(),
+		log:           logr.Discard(),
+		instance:      getObject(t, k8sClient, drpc),
+		userPlacement: placementRule,
+		vrgNamespace:  "app",
+		vrgs: map[string]*rmn.VolumeReplicationGroup{
+			"east": splitBrainVRG("east", rmn.Primary),
+			"west": splitBrainVRG("west", rmn.Primary),
+		},
+		mwu: rmnutil.MWUtil{
+			Client:          k8sClient,
+			APIReader:       k8sClient,
+			Ctx:             context.TODO(),
+			Log:             logr.Discard(),
+			InstName:        "drpc",
+			TargetNamespace: "app",
+		},
+	}
+
+	for clusterName, vrg := range d.vrgs {
+		_, err := d.mwu.CreateOrUpdateVRGManifestWork("drpc", "app", clusterName, *vrg, nil)
+		require.NoError(t, err)
+	}
+
+	return d, objectStorer
+}
+
+// splitBrainDetect detects the split-brain of the VRGs, and sets the winner after it
+func splitBrainDetect(t *testing.T, d *DRPCInstance, winner string) {
+	t.Helper()
+
+	splitBrain, err := d.reconcileSplitBrain()
+	require.NoError(t, err)
+	require.True(t, splitBrain)
+
+	d.instance.Spec.SplitBrainWinner = winner
+	d.instance.Generation++
+}
+
+func splitBrainCondition(d *DRPCInstance) *metav1.Condition {
+	return rmnutil.FindCondition(d.instance.Status.Conditions, rmn.ConditionSplitBrain)
+}
+
+func TestSplitBrainReported(t *testing.T) {
+	tests := []struct {
+		name     string
+		prepare  func(d *DRPCInstance)
+		reported bool
+	}{
+		{
+			name: "one primary",
+			prepare: func(d *DRPCInstance) {
+				d.vrgs["west"] = splitBrainVRG("west", rmn.Secondary)
+			},
+		},
+		{
+			name: "one primary, and one still processing its promotion",
+			prepare: func(d *DRPCInstance) {
+				d.vrgs["west"].Generation = 2
+			},
+		},
+		{
+			name:     "primaries on both clusters",
+			prepare:  func(d *DRPCInstance) {},
+			reported: true,
+		},
+		{
+			name: "primaries on both clusters while failing over",
+			prepare: func(d *DRPCInstance) {
+				d.instance.Spec.Action = rmn.ActionFailover
+				d.instance.Status.Progression = rmn.ProgressionWaitForReadiness
+			},
+		},
+		{
+			name: "primaries on both clusters after failing over",
+			prepare: func(d *DRPCInstance) {
+				d.instance.Spec.Action = rmn.ActionFailover
+				d.instance.Status.Progression = rmn.ProgressionCompleted
+			},
+			reported: true,
+		},
+		{
+			name: "PVCs in split-brain while failing over",
+			prepare: func(d *DRPCInstance) {
+				d.instance.Spec.Action = rmn.ActionFailover
+				d.vrgs["west"] = splitBrainReportedBy(splitBrainVRG("west", rmn.Secondary))
+			},
+			reported: true,
+		},
+		{
+			name: "split-brain reported by the DRPC",
+			prepare: func(d *DRPCInstance) {
+				d.vrgs["west"] = splitBrainVRG("west", rmn.Secondary)
+				addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, 1,
+					metav1.ConditionTrue, rmn.ReasonSplitBrainDetected, "")
+			},
+			reported: true,
+		},
+		{
+			name: "split-brain resolution in progress",
+			prepare: func(d *DRPCInstance) {
+				d.vrgs["west"] = splitBrainVRG("west", rmn.Secondary)
+				d.instance.Status.SplitBrain = &rmn.SplitBrainStatus{
+					Resolution: &rmn.SplitBrainResolution{Winner: "east"},
+				}
+			},
+			reported: true,
+		},
+		{
+			name: "split-brain resolved",
+			prepare: func(d *DRPCInstance) {
+				d.vrgs["west"] = splitBrainVRG("west", rmn.Secondary)
+				now := metav1.Now()
+				d.instance.Status.SplitBrain = &rmn.SplitBrainStatus{
+					Resolution: &rmn.SplitBrainResolution{Winner: "east", ResolvedTime: &now},
+				}
+				addOrUpdateCondition(&d.instance.Status.Conditions, rmn.ConditionSplitBrain, 1,
+					metav1.ConditionFalse, rmn.ReasonSplitBrainResolved, "")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := splitBrainDRPCInstance(t)
+			tt.prepare(d)
+
+			assert.Equal(t, tt.reported, d.splitBrainReported())
+		})
+	}
+}
+
+func TestValidateSplitBrainWinner(t *testing.T) {
+	tests := []struct {
+		name   string
+		winner string
+		err    string
+	}{
+		{name: "cluster the DRPC places the workload on", winner: "east"},
+		{name: "other cluster", winner: "west"},
+		{
+			name:   "cluster outside the split-brain",
+			winner: "north",
+			err:    "split-brain winner north is not a cluster of the split-brain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSplitBrainWinner(tt.winner,
+				[]rmn.SplitBrainCluster{{ClusterName: "east"}, {ClusterName: "west"}})
+			if tt.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestSplitBrainDetection(t *testing.T) {
+	d, objectStorer := splitBrainDRPCInstance(t)
+	require.NoError(t, VrgObjectProtect(objectStorer, *d.vrgs["west"]))
+	d.vrgs["west"] = splitBrainReportedBy(d.vrgs["west"])
+
+	splitBrain, err := d.reconcileSplitBrain()
+	require.NoError(t, err)
+	assert.True(t, splitBrain)
+
+	condition := splitBrainCondition(d)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, rmn.ReasonSplitBrainDetected, condition.Reason)
+	assert.Equal(t, "Split-brain detected, VRGs are primary on clusters [east west], and PVCs are in split-brain "+
+		"on clusters [west], set spec.splitBrainWinner to the cluster whose data to keep", condition.Message)
+	assert.Equal(t, int64(1), d.instance.Status.SplitBrain.DetectedGeneration)
+	assert.Equal(t, []rmn.SplitBrainCluster{
+		{ClusterName: "east", State: rmn.PrimaryState, VRGGeneration: 1, VRGObservedGeneration: 1},
+		{
+			ClusterName: "west", State: rmn.PrimaryState, VRGGeneration: 1, VRGObservedGeneration: 1,
+			LastKnownPrimary: true, SplitBrainPVCs: []string{"app/pvc"},
+		},
+	}, d.instance.Status.SplitBrain.Clusters)
+}
+
+func TestSplitBrainWinnerUnresolved(t *testing.T) {
+	tests := []struct {
+		name          string
+		winnerEarlier bool
+		winner        string
+		messageSuffix string
+	}{
+		{name: "winner set before the split-brain is detected", winnerEarlier: true, winner: "east"},
+		{
+			name:          "winner outside the split-brain",
+			winner:        "north",
+			messageSuffix: ", split-brain winner north is not a cluster of the split-brain",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := splitBrainDRPCInstance(t)
+
+			if tt.winnerEarlier {
+				d.instance.Spec.SplitBrainWinner = tt.winner
+			}
+
+			splitBrainDetect(t, d, tt.winner)
+
+			if tt.winnerEarlier {
+				d.instance.Generation--
+			}
+
+			splitBrain, err := d.reconcileSplitBrain()
+			require.NoError(t, err)
+			assert.True(t, splitBrain)
+			assert.Nil(t, d.instance.Status.SplitBrain.Resolution)
+			assert.Equal(t, rmn.ReasonSplitBrainDetected, splitBrainCondition(d).Reason)
+			assert.True(t, strings.HasSuffix(splitBrainCondition(d).Message, tt.messageSuffix),
+				splitBrainCondition(d).Message)
+		})
+	}
+}
+
+func TestSplitBrainClearedWithoutResolution(t *testing.T) {
+	d, _ := splitBrainDRPCInstance(t)
+	splitBrainDetect(t, d, "")
+	d.vrgs["west"] = splitBrainVRG("west", rmn.Secondary)
+
+	splitBrain, err := d.reconcileSplitBrain()
+	require.NoError(t, err)
+	assert.False(t, splitBrain)
+	assert.Equal(t, metav1.ConditionFalse, splitBrainCondition(d).Status)
+	assert.Equal(t, "Split-brain no longer detected", splitBrainCondition(d).Message)
+}
+
+func TestSplitBrainResolution(t *testing.T) {
+	d, _ := splitBrainDRPCInstance(t)
+	splitBrainDetect(t, d, "west")
+
+	splitBrain, err := d.reconcileSplitBrain()
+	require.NoError(t, err)
+	assert.True(t, splitBrain)
+
+	resolution := d.instance.Status.SplitBrain.Resolution
+	assert.Equal(t, "west", resolution.Winner)
+	assert.Equal(t, []string{"east"}, resolution.Losers)
+	assert.Nil(t, resolution.ResolvedTime)
+	assert.Equal(t, "west", d.instance.Status.PreferredDecision.ClusterName)
+	assert.Equal(t, rmn.ReasonSplitBrainResolving, splitBrainCondition(d).Reason)
+
+	vrg := func(clusterName string) *rmn.VolumeReplicationGroup {
+		vrg, err := d.getVRGFromManifestWork(clusterName)
+		require.NoError(t, err)
+
+		return vrg
+	}
+
+	assert.Equal(t, rmn.Primary, vrg("west").Spec.ReplicationState)
+	assert.Equal(t, rmn.Secondary, vrg("east").Spec.ReplicationState)
+	assert.Equal(t, rmn.VRGActionFailover, vrg("east").Spec.Action)
+
+	placementRule := &plrv1.PlacementRule{}
+	require.NoError(t, d.reconciler.Get(context.TODO(), client.ObjectKeyFromObject(d.userPlacement), placementRule))
+	assert.Equal(t, []plrv1.PlacementDecision{{ClusterName: "west", ClusterNamespace: "west"}},
+		placementRule.Status.Decisions)
+}
+
+func TestSplitBrainResolved(t *testing.T) {
+	tests := []struct {
+		name     string
+		loser    *rmn.VolumeReplicationGroup
+		resolved bool
+	}{
+		{
+			name:  "loser secondary with PVCs in split-brain",
+			loser: splitBrainReportedBy(splitBrainVRG("east", rmn.Secondary)),
+		},
+		{
+			name:     "loser secondary",
+			loser:    splitBrainVRG("east", rmn.Secondary),
+			resolved: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, _ := splitBrainDRPCInstance(t)
+			splitBrainDetect(t, d, "west")
+			_, err := d.reconcileSplitBrain()
+			require.NoError(t, err)
+
+			d.vrgs["east"] = tt.loser
+
+			splitBrain, err := d.reconcileSplitBrain()
+			require.NoError(t, err)
+			assert.Equal(t, !tt.resolved, splitBrain)
+
+			if !tt.resolved {
+				assert.Nil(t, d.instance.Status.SplitBrain.Resolution.ResolvedTime)
+				assert.Equal(t, rmn.ReasonSplitBrainResolving, splitBrainCondition(d).Reason)
+
+				return
+			}
+
+			assert.NotNil(t, d.instance.Status.SplitBrain.Resolution.ResolvedTime)
+			assert.Equal(t, metav1.ConditionFalse, splitBrainCondition(d).Status)
+			assert.Equal(t, rmn.ReasonSplitBrainResolved, splitBrainCondition(d).Reason)
+			assert.Equal(t, "Split-brain resolved, keeping the data of cluster west", splitBrainCondition(d).Message)
+			assert.False(t, d.splitBrainReported())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+var _ = Describe("DRPC slowest protected PVC", func() {
+	now := time.Now()
+
+	protectedPVC := func(name string, volSync bool, lastSync time.Duration, duration time.Duration,
+		lastSyncError string,
+	) rmn.ProtectedPVC {
+		return rmn.ProtectedPVC{
+			Namespace:          "app",
+			Name:               name,
+			ProtectedByVolSync: volSync,
+			LastSyncTime:       &metav1.Time{Time: now.Add(-lastSync)},
+			LastSyncDuration:   &metav1.Duration{Duration: duration},
+			LastSyncError:      lastSyncError,
+		}
+	}
+
+	slowest := func(protectedPVCs ...rmn.ProtectedPVC) []string {
+		vrg := &rmn.VolumeReplicationGroup{Status: rmn.VolumeReplicationGroupStatus{ProtectedPVCs: protectedPVCs}}
+		name, err := slowestProtectedPVC(vrg)
+
+		return []string{name, err}
+	}
+
+	It("Should report the PVC protected by VolSync that synced the least recently, with its error", func() {
+		Expect(slowest(
+			protectedPVC("recent", true, time.Minute, time.Second, ""),
+			protectedPVC("stuck", true, time.Hour, time.Second, "mover failed: connection refused"),
+		)).To(Equal([]string{"app/stuck", "mover failed: connection refused"}))
+	})
+
+	It("Should report the PVC that took the longest to sync among those synced at the same time", func() {
+		Expect(slowest(
+			protectedPVC("fast", true, time.Minute, time.Second, ""),
+			protectedPVC("slow", true, time.Minute, time.Minute, ""),
+		)).To(Equal([]string{"app/slow", ""}))
+	})
+
+	It("Should not report PVCs protected by VolRep", func() {
+		Expect(slowest(
+			protectedPVC("volsync", true, time.Minute, time.Second, ""),
+			protectedPVC("volrep", false, time.Hour, time.Hour, ""),
+		)).To(Equal([]string{"app/volsync", ""}))
+
+		Expect(slowest(protectedPVC("volrep", false, time.Hour, time.Hour, ""))).To(Equal([]string{"", ""}))
+	})
+})
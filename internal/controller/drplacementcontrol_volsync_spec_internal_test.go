@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+func TestEnsureDRPCValidVolSyncSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		volSyncSpec *rmn.VolSyncSpec
+		err         string
+	}{
+		{
+			name: "no VolSync spec",
+		},
+		{
+			name:        "no bandwidth limit",
+			volSyncSpec: &rmn.VolSyncSpec{Mover: rmn.VolSyncMoverRestic},
+		},
+		{
+			name: "bandwidth limit of the Rclone mover",
+			volSyncSpec: &rmn.VolSyncSpec{
+				Mover:          rmn.VolSyncMoverRclone,
+				BandwidthLimit: ptr.To(resource.MustParse("200M")),
+			},
+		},
+		{
+			name:        "bandwidth limit of the default mover",
+			volSyncSpec: &rmn.VolSyncSpec{BandwidthLimit: ptr.To(resource.MustParse("200M"))},
+			err:         "not by the RsyncTLS mover",
+		},
+		{
+			name: "bandwidth limit of the Restic mover",
+			volSyncSpec: &rmn.VolSyncSpec{
+				Mover:          rmn.VolSyncMoverRestic,
+				BandwidthLimit: ptr.To(resource.MustParse("200M")),
+			},
+			err: "not by the Restic mover",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ensureDRPCValidVolSyncSpec(&rmn.DRPlacementControl{
+				Spec: rmn.DRPlacementControlSpec{VolSyncSpec: tt.volSyncSpec},
+			})
+			if tt.err == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package controllers
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -95,17 +96,26 @@ func (d *DRPCInstance) updateSourceVSRG(
 	// Clear any existing RDSpec in the source VRG
 	srcVSRG.Spec.VolSync.RDSpec = nil
 
+	// The ReplicationDestinations are in the namespaces of the destination cluster, mapped to those of the source
+	namespaceMapping := rmnutil.DRPCNamespaceMapping(d.instance, clusterName)
+
 	for _, rdInfo := range dstVSRG.Status.RDInfo {
 		pskSecretNameCluster := volsync.GetVolSyncPSKSecretNameFromVRGName(d.instance.GetName())
 
 		rsSpec := rmn.VolSyncReplicationSourceSpec{
 			ProtectedPVC: rdInfo.ProtectedPVC,
-			RsyncTLS: &rmn.RsyncTLSConfig{
+		}
+		rsSpec.ProtectedPVC.Namespace = rmnutil.MappedNamespaceName(namespaceMapping, rsSpec.ProtectedPVC.Namespace)
+
+		// RDs of the Restic and Rclone movers have no address, as the source replicates to an
+		// object store repository
+		if rdInfo.RsyncTLS != nil {
+			rsSpec.RsyncTLS = &rmn.RsyncTLSConfig{
 				Address: rdInfo.RsyncTLS.Address,
 				TLSSecretRef: &corev1.LocalObjectReference{
 					Name: pskSecretNameCluster,
 				},
-			},
+			}
 		}
 
 		srcVSRG.Spec.VolSync.RSSpec = d.AppendOrUpdate(srcVSRG.Spec.VolSync.RSSpec, rsSpec)
@@ -225,6 +235,12 @@ func (d *DRPCInstance) EnsureVolSyncReplicationSetup(srcCluster string) error {
 		return fmt.Errorf("%w", err)
 	}
 
+	if err := d.rotateVolSyncReplicationSecret(pskSecretHub, srcCluster); err != nil {
+		d.log.Error(err, "Unable to rotate psk secret on hub for VolSync")
+
+		return err
+	}
+
 	// Propagate the secret to all clusters
 	// Note that VRG spec will not contain the psk secret name, we're going to name based on the VRG name itself
 	pskSecretNameCluster := volsync.GetVolSyncPSKSecretNameFromVRGName(d.instance.GetName()) // VRG name == DRPC name
@@ -251,6 +267,44 @@ func (d *DRPCInstance) EnsureVolSyncReplicationSetup(srcCluster string) error {
 	return nil
 }
 
+// rotateVolSyncReplicationSecret rotates the pre-shared key of the hub secret at the configured
+// interval, pacing the rotation steps by the keys the VRGs report and by the syncs of the primary.
+// The key of the Restic mover is not rotated, as it encrypts the repositories.
+func (d *DRPCInstance) rotateVolSyncReplicationSecret(pskSecretHub *corev1.Secret, srcCluster string) error {
+	if d.instance.Spec.VolSyncSpec != nil && d.instance.Spec.VolSyncSpec.Mover == rmn.VolSyncMoverRestic {
+		return nil
+	}
+
+	rotation := volsync.PSKRotation{
+		Interval: volSyncPSKRotationIntervalOrDefault(d.ramenConfig),
+		Now:      time.Now(),
+	}
+
+	for _, drCluster := range d.drClusters {
+		var identities []string
+
+		if vrg := d.vrgs[drCluster.Name]; vrg != nil {
+			identities = vrg.Status.VolSyncPSKIdentities
+		}
+
+		rotation.ClusterIdentities = append(rotation.ClusterIdentities, identities)
+	}
+
+	if vrg := d.vrgs[srcCluster]; vrg != nil {
+		rotation.LastSyncTime = vrg.Status.LastGroupSyncTime
+	}
+
+	rotationTime, err := volsync.RotateVolSyncReplicationSecret(d.ctx, d.reconciler.Client, pskSecretHub,
+		rotation, d.log)
+	if err != nil {
+		return fmt.Errorf("%w", err)
+	}
+
+	d.instance.Status.LastVolSyncPSKRotationTime = &rotationTime
+
+	return nil
+}
+
 func (d *DRPCInstance) IsVolSyncReplicationRequired(homeCluster string) (bool, error) {
 	if d.volSyncDisabled {
 		d.log.Info("VolSync is disabled")
@@ -381,6 +435,10 @@ func (d *DRPCInstance) resetRDSpec(srcVRG, dstVRG *rmn.VolumeReplicationGroup,
 		protectedPVC.LastSyncTime = nil
 		protectedPVC.LastSyncDuration = nil
 		protectedPVC.Conditions = nil
+		protectedPVC.SyncDeferredUntil = nil
+		protectedPVC.Verification = nil
+		protectedPVC.SyncProgress = nil
+		protectedPVC.Namespace = rmnutil.MappedNamespaceName(dstVRG.Spec.NamespaceMapping, protectedPVC.Namespace)
 
 		rdSpec := rmn.VolSyncReplicationDestinationSpec{
 			ProtectedPVC: protectedPVC,
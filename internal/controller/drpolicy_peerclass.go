@@ -21,12 +21,17 @@ import (
 // classLists contains [storage|snapshot|replication]classes from ManagedClusters with the required ramen storageID or,
 // replicationID labels
 type classLists struct {
-	clusterID  string
-	sClasses   []*storagev1.StorageClass
-	vsClasses  []*snapv1.VolumeSnapshotClass
-	vrClasses  []*volrep.VolumeReplicationClass
-	vgrClasses []*volrep.VolumeGroupReplicationClass
-	vgsClasses []*groupsnapv1beta1.VolumeGroupSnapshotClass
+	clusterID   string
+	clusterName string
+	sClasses    []*storagev1.StorageClass
+	vsClasses   []*snapv1.VolumeSnapshotClass
+	vrClasses   []*volrep.VolumeReplicationClass
+	vgrClasses  []*volrep.VolumeGroupReplicationClass
+	vgsClasses  []*groupsnapv1beta1.VolumeGroupSnapshotClass
+
+	// scMapping maps the names of StorageClasses on the other clusters to the names of StorageClasses of the same
+	// storage on this cluster, see util.DRPolicyStorageClassMapping
+	scMapping map[string]string
 }
 
 // peerInfo contains a single peer relationship between a PAIR of clusters for a common storageClassName across
@@ -50,7 +55,8 @@ type peerInfo struct {
 	// a common replicationID or due to required VolumeSnapshotClasses on each cluster
 	storageIDs []string
 
-	// storageClassName is the name of a StorageClass that is common across the peers
+	// storageClassName is the name of a StorageClass that is common across the peers, or its name on the first peer
+	// if it is mapped to a differently named StorageClass on the second peer
 	storageClassName string
 
 	// clusterIDs is a list of 2 IDs that denote the IDs for the clusters in this peer relationship
@@ -156,6 +162,12 @@ func updatePeerClassStatus(u *drpolicyUpdater, syncPeers, asyncPeers []peerInfo)
 	return u.statusUpdate()
 }
 
+// peerStorageClassName returns the name of the StorageClass in the passed in classLists that the StorageClass named
+// scName on a peer cluster is mapped to, or scName if it is not mapped
+func peerStorageClassName(cl classLists, scName string) string {
+	return *util.MappedStorageClassName(cl.scMapping, &scName)
+}
+
 // provisionerMatchesSC inspects StorageClass named scName in the passed in classLists and returns true if its
 // provisioner value matches the driver
 func provisionerMatchesSC(scName string, cl classLists, driver string) bool {
@@ -211,17 +223,20 @@ func hasVGSClassMatchingSID(scName string, cl classLists, sID string) bool {
 }
 
 // isAsyncVSClassPeer inspects provided pair of classLists for a matching VolumeSnapshotClass, that is linked to the
-// StorageClass whose storageID is respectively sIDA or sIDB
+// StorageClass whose storageID is respectively sIDA or sIDB. scName is the name of the StorageClass in clA.
 func isAsyncVSClassPeer(scName string, clA, clB classLists, sIDA, sIDB string) bool {
 	// No provisioner match as we can do cross provisioner VSC based protection
-	return hasVSClassMatchingSID(scName, clA, sIDA) && hasVSClassMatchingSID(scName, clB, sIDB)
+	return hasVSClassMatchingSID(scName, clA, sIDA) &&
+		hasVSClassMatchingSID(peerStorageClassName(clB, scName), clB, sIDB)
 }
 
 // isAsyncVGSClassPeer inspects provided pair of classLists for a matching VolumeGroupSnapshotClass,
-// that is linked to the StorageClass whose storageID is respectively sIDA or sIDB
+// that is linked to the StorageClass whose storageID is respectively sIDA or sIDB. scName is the name of the
+// StorageClass in clA.
 func isAsyncVGSClassPeer(scName string, clA, clB classLists, sIDA, sIDB string) bool {
 	// No provisioner match as we can do cross provisioner VGSC based protection
-	return hasVGSClassMatchingSID(scName, clA, sIDA) && hasVGSClassMatchingSID(scName, clB, sIDB)
+	return hasVGSClassMatchingSID(scName, clA, sIDA) &&
+		hasVGSClassMatchingSID(peerStorageClassName(clB, scName), clB, sIDB)
 }
 
 // getVRID inspects VolumeReplicationClass in the passed in classLists at the specified index, and returns,
@@ -269,8 +284,11 @@ func getVGRID(scName string, cl classLists, vgrcIdx int, inSID string, schedule
 }
 
 // getAsyncVRClassPeer inspects if there is a common replicationID among the vrClasses in the passed in classLists,
-// that relate to the corresponding storageIDs and schedule, and returns the replicationID or "" if there was no match
+// that relate to the corresponding storageIDs and schedule, and returns the replicationID or "" if there was no match.
+// scName is the name of the StorageClass in clA.
 func getAsyncVRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
+	scNameB := peerStorageClassName(clB, scName)
+
 	for vrcAidx := range clA.vrClasses {
 		ridA := getVRID(scName, clA, vrcAidx, sIDA, schedule)
 		if ridA == "" {
@@ -278,7 +296,7 @@ func getAsyncVRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 		}
 
 		for vrcBidx := range clB.vrClasses {
-			ridB := getVRID(scName, clB, vrcBidx, sIDB, schedule)
+			ridB := getVRID(scNameB, clB, vrcBidx, sIDB, schedule)
 			if ridB == "" {
 				continue
 			}
@@ -296,8 +314,10 @@ func getAsyncVRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 
 // getAsyncVGRClassPeer inspects if there is a common GroupReplicationID among the vgrClasses in the passed
 // in classLists, that relate to the corresponding storageIDs and schedule, and returns the GroupReplicationID or ""
-// if there is no match.
+// if there is no match. scName is the name of the StorageClass in clA.
 func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string, schedule string) string {
+	scNameB := peerStorageClassName(clB, scName)
+
 	for vgrcAidx := range clA.vgrClasses {
 		grIDA := getVGRID(scName, clA, vgrcAidx, sIDA, schedule)
 
@@ -306,7 +326,7 @@ func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 		}
 
 		for vgrcBidx := range clB.vgrClasses {
-			grIDB := getVGRID(scName, clB, vgrcBidx, sIDB, schedule)
+			grIDB := getVGRID(scNameB, clB, vgrcBidx, sIDB, schedule)
 			if grIDB == "" {
 				continue
 			}
@@ -324,7 +344,8 @@ func getAsyncVGRClassPeer(scName string, clA, clB classLists, sIDA, sIDB string,
 
 // getAsyncPeers determines if scName in the first classList has asynchronous peers in the remaining classLists.
 // The clusterID and sID are the corresponding IDs for the first cluster in the classList, and the schedule is
-// the desired asynchronous schedule that requires to be matched.
+// the desired asynchronous schedule that requires to be matched. The StorageClass that scName is mapped to in each
+// of the remaining classLists is paired with it, see peerStorageClassName.
 // Grouping logic:
 //   - Offloaded storage: Uses VGRC only, grouping = true when VGRC with ReplicationID exists
 //   - Non-offloaded storage: Uses VRC and VGRC, grouping = true when both exist with ReplicationID,
@@ -341,7 +362,7 @@ func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLis
 
 			var rID, grID string
 
-			if cl.sClasses[scIdx].GetName() != scName {
+			if cl.sClasses[scIdx].GetName() != peerStorageClassName(cl, scName) {
 				continue
 			}
 
@@ -396,13 +417,14 @@ func getAsyncPeers(scName, clusterID, sID string, offloaded bool, cls []classLis
 }
 
 // getSyncPeers determines if scName passed has asynchronous peers in the passed in classLists.
-// The clusterID and sID are the corresponding IDs for the passed in scName to find a match
+// The clusterID and sID are the corresponding IDs for the passed in scName to find a match. The StorageClass that
+// scName is mapped to in each classLists is paired with it, see peerStorageClassName.
 func getSyncPeers(scName string, clusterID string, sID string, cls []classLists) []peerInfo {
 	peers := []peerInfo{}
 
 	for _, cl := range cls {
 		for idx := range cl.sClasses {
-			if cl.sClasses[idx].GetName() != scName {
+			if cl.sClasses[idx].GetName() != peerStorageClassName(cl, scName) {
 				continue
 			}
 
@@ -505,6 +527,69 @@ func findAllPeers(cls []classLists, schedule string) ([]peerInfo, []peerInfo) {
 	return syncPeers, asyncPeers
 }
 
+// hasStorageClass returns true if a StorageClass named scName is in the passed in classLists
+func hasStorageClass(cl classLists, scName string) bool {
+	return slices.ContainsFunc(cl.sClasses, func(sc *storagev1.StorageClass) bool {
+		return sc.GetName() == scName
+	})
+}
+
+// storageClassesOfSameStorage returns the StorageClasses in the passed in classLists that are named differently
+// than the passed in StorageClass, and not present with their name in its classLists, but have its provisioner and
+// its storageID
+func storageClassesOfSameStorage(sc *storagev1.StorageClass, scCl, cl classLists) []*storagev1.StorageClass {
+	sameStorage := []*storagev1.StorageClass{}
+
+	for _, peerSC := range cl.sClasses {
+		if peerSC.GetName() == sc.GetName() || hasStorageClass(scCl, peerSC.GetName()) {
+			continue
+		}
+
+		if peerSC.Provisioner == sc.Provisioner &&
+			peerSC.GetLabels()[StorageIDLabel] == sc.GetLabels()[StorageIDLabel] {
+			sameStorage = append(sameStorage, peerSC)
+		}
+	}
+
+	return sameStorage
+}
+
+// findStorageClassMappings finds the StorageClasses of the same storage that are named differently across PAIRs of
+// clusters in the passed in classLists. StorageClasses are mapped if they have the same storageID and provisioner,
+// and only if the match is unique, both ways.
+func findStorageClassMappings(cls []classLists) []ramen.StorageClassMapping {
+	mappings := []ramen.StorageClassMapping{}
+
+	for clsIdx := range cls {
+		for peerClsIdx := clsIdx + 1; peerClsIdx < len(cls); peerClsIdx++ {
+			for _, sc := range cls[clsIdx].sClasses {
+				if !util.HasLabel(sc, StorageIDLabel) || hasStorageClass(cls[peerClsIdx], sc.GetName()) {
+					continue
+				}
+
+				peerSCs := storageClassesOfSameStorage(sc, cls[clsIdx], cls[peerClsIdx])
+				if len(peerSCs) != 1 ||
+					len(storageClassesOfSameStorage(peerSCs[0], cls[peerClsIdx], cls[clsIdx])) != 1 {
+					continue
+				}
+
+				mappings = append(mappings, ramen.StorageClassMapping{
+					StorageClassNames: map[string]string{
+						cls[clsIdx].clusterName:     sc.GetName(),
+						cls[peerClsIdx].clusterName: peerSCs[0].GetName(),
+					},
+				})
+			}
+		}
+	}
+
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	return mappings
+}
+
 // pruneClassViews prunes existing views in mcvs, for classes that are not found in survivorClassNames
 func pruneClassViews(
 	m util.ManagedClusterViewGetter,
@@ -792,12 +877,13 @@ func getClusterClasses(
 	}
 
 	return classLists{
-		clusterID:  clID,
-		sClasses:   sClasses,
-		vrClasses:  vrClasses,
-		vsClasses:  vsClasses,
-		vgrClasses: vgrClasses,
-		vgsClasses: vgsClasses,
+		clusterID:   clID,
+		clusterName: cluster,
+		sClasses:    sClasses,
+		vrClasses:   vrClasses,
+		vsClasses:   vsClasses,
+		vgrClasses:  vgrClasses,
+		vgsClasses:  vgsClasses,
 	}, nil
 }
 
@@ -844,6 +930,12 @@ func updatePeerClasses(u *drpolicyUpdater, m util.ManagedClusterViewGetter) erro
 		cls = append(cls, clusterClasses)
 	}
 
+	u.object.Status.StorageClassMappings = findStorageClassMappings(cls)
+
+	for idx := range cls {
+		cls[idx].scMapping = util.DRPolicyStorageClassMapping(u.object, cls[idx].clusterName)
+	}
+
 	syncPeers, asyncPeers := findAllPeers(cls, u.object.Spec.SchedulingInterval)
 
 	return updatePeerClassStatus(u, syncPeers, asyncPeers)
@@ -11,6 +11,8 @@ import (
 	groupsnapv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
 )
 
 // nolint:dupl
@@ -2497,5 +2499,341 @@ var _ = Describe("updatePeerClassesInternal", func() {
 				},
 			},
 		),
+		Entry("Single async peer, having related VRClasses for differently named StorageClasses mapped to each other",
+			[]classLists{
+				{
+					clusterID:   "cl-1",
+					clusterName: "cluster-1",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-1-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vrClasses: []*volrep.VolumeReplicationClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vrc1",
+								Labels: map[string]string{
+									StorageIDLabel:     "cl-1-sID",
+									ReplicationIDLabel: "cl-1-2-rID",
+								},
+							},
+							Spec: volrep.VolumeReplicationClassSpec{
+								Provisioner: "sample.csi.com",
+								Parameters: map[string]string{
+									ReplicationClassScheduleKey: "1m",
+								},
+							},
+						},
+					},
+					scMapping: map[string]string{"sc2": "sc1"},
+				},
+				{
+					clusterID:   "cl-2",
+					clusterName: "cluster-2",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc2",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-2-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vrClasses: []*volrep.VolumeReplicationClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vrc1",
+								Labels: map[string]string{
+									StorageIDLabel:     "cl-2-sID",
+									ReplicationIDLabel: "cl-1-2-rID",
+								},
+							},
+							Spec: volrep.VolumeReplicationClassSpec{
+								Provisioner: "sample.csi.com",
+								Parameters: map[string]string{
+									ReplicationClassScheduleKey: "1m",
+								},
+							},
+						},
+					},
+					scMapping: map[string]string{"sc1": "sc2"},
+				},
+			},
+			"1m",
+			[]peerInfo{},
+			[]peerInfo{
+				{
+					replicationID:    "cl-1-2-rID",
+					storageIDs:       []string{"cl-1-sID", "cl-2-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-1", "cl-2"},
+					offloaded:        false,
+				},
+			},
+		),
+		Entry("Single async peer, having related VSClasses for differently named StorageClasses mapped to each other",
+			[]classLists{
+				{
+					clusterID:   "cl-1",
+					clusterName: "cluster-1",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-1-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vsc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-1-sID",
+								},
+							},
+							Driver: "sample.csi.com",
+						},
+					},
+					scMapping: map[string]string{"sc2": "sc1"},
+				},
+				{
+					clusterID:   "cl-2",
+					clusterName: "cluster-2",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc2",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-2-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vsc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-2-sID",
+								},
+							},
+							Driver: "sample.csi.com",
+						},
+					},
+					scMapping: map[string]string{"sc1": "sc2"},
+				},
+			},
+			"1m",
+			[]peerInfo{},
+			[]peerInfo{
+				{
+					replicationID:    "",
+					storageIDs:       []string{"cl-1-sID", "cl-2-sID"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-1", "cl-2"},
+					offloaded:        false,
+				},
+			},
+		),
+		Entry("Single sync peer, for differently named StorageClasses mapped to each other",
+			[]classLists{
+				{
+					clusterID:   "cl-1",
+					clusterName: "cluster-1",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc1",
+								Labels: map[string]string{
+									StorageIDLabel: "identical",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					scMapping: map[string]string{"sc2": "sc1"},
+				},
+				{
+					clusterID:   "cl-2",
+					clusterName: "cluster-2",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc2",
+								Labels: map[string]string{
+									StorageIDLabel: "identical",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					scMapping: map[string]string{"sc1": "sc2"},
+				},
+			},
+			"1m",
+			[]peerInfo{
+				{
+					replicationID:    "",
+					storageIDs:       []string{"identical"},
+					storageClassName: "sc1",
+					clusterIDs:       []string{"cl-1", "cl-2"},
+					offloaded:        false,
+				},
+			},
+			[]peerInfo{},
+		),
+		Entry("No async peer, for differently named StorageClasses not mapped to each other",
+			[]classLists{
+				{
+					clusterID:   "cl-1",
+					clusterName: "cluster-1",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-1-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vsc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-1-sID",
+								},
+							},
+							Driver: "sample.csi.com",
+						},
+					},
+				},
+				{
+					clusterID:   "cl-2",
+					clusterName: "cluster-2",
+					sClasses: []*storagev1.StorageClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "sc2",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-2-sID",
+								},
+							},
+							Provisioner: "sample.csi.com",
+						},
+					},
+					vsClasses: []*snapv1.VolumeSnapshotClass{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: "vsc1",
+								Labels: map[string]string{
+									StorageIDLabel: "cl-2-sID",
+								},
+							},
+							Driver: "sample.csi.com",
+						},
+					},
+				},
+			},
+			"1m",
+			[]peerInfo{},
+			[]peerInfo{},
+		),
+	)
+
+	sc := func(name, storageID string) *storagev1.StorageClass {
+		return &storagev1.StorageClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					StorageIDLabel: storageID,
+				},
+			},
+			Provisioner: "sample.csi.com",
+		}
+	}
+
+	DescribeTable("findStorageClassMappings",
+		func(cls []classLists, mappings []ramen.StorageClassMapping) {
+			Expect(findStorageClassMappings(cls)).Should(Equal(mappings))
+		},
+		Entry("Empty classLists", []classLists{}, nil),
+		Entry("Same StorageClass names",
+			[]classLists{
+				{clusterName: "cl-1", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1")}},
+				{clusterName: "cl-2", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1")}},
+			},
+			nil,
+		),
+		Entry("Different StorageClass names of the same storage",
+			[]classLists{
+				{clusterName: "cl-1", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1"), sc("sc2", "sID2")}},
+				{clusterName: "cl-2", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1"), sc("sc3", "sID2")}},
+			},
+			[]ramen.StorageClassMapping{
+				{StorageClassNames: map[string]string{"cl-1": "sc2", "cl-2": "sc3"}},
+			},
+		),
+		Entry("Different StorageClass names of different storage",
+			[]classLists{
+				{clusterName: "cl-1", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1")}},
+				{clusterName: "cl-2", sClasses: []*storagev1.StorageClass{sc("sc2", "sID2")}},
+			},
+			nil,
+		),
+		Entry("Ambiguous StorageClass names of the same storage",
+			[]classLists{
+				{clusterName: "cl-1", sClasses: []*storagev1.StorageClass{sc("sc1", "sID1")}},
+				{clusterName: "cl-2", sClasses: []*storagev1.StorageClass{sc("sc2", "sID1"), sc("sc3", "sID1")}},
+			},
+			nil,
+		),
+	)
+
+	DescribeTable("peerClassMatchesStorageClassName",
+		func(scName string, scMapping map[string]string, matches bool) {
+			peerClass := &ramen.PeerClass{StorageClassName: "sc1"}
+			Expect(peerClassMatchesStorageClassName(peerClass, scName, scMapping)).To(Equal(matches))
+		},
+		Entry("Same StorageClass name", "sc1", nil, true),
+		Entry("Different StorageClass name", "sc2", nil, false),
+		Entry("StorageClass name mapped to", "sc2", map[string]string{"sc1": "sc2"}, true),
+		Entry("StorageClass name of the peerClass mapped to another", "sc1", map[string]string{"sc1": "sc2"}, false),
 	)
+
+	It("updatePeers adds the peerClass of a PVC whose StorageClass the peerClass is mapped to", func() {
+		policyPeerClass := ramen.PeerClass{
+			StorageClassName: "sc1",
+			StorageID:        []string{"cl-1-sID", "cl-2-sID"},
+			ClusterIDs:       []string{"cl-1", "cl-2"},
+			ReplicationID:    "cl-1-2-rID",
+		}
+		scName := "sc2"
+		vrgFromView := &ramen.VolumeReplicationGroup{
+			Status: ramen.VolumeReplicationGroupStatus{
+				ProtectedPVCs: []ramen.ProtectedPVC{{Name: "pvc", StorageClassName: &scName}},
+			},
+		}
+
+		Expect(updatePeers(GinkgoLogr, vrgFromView, nil, []ramen.PeerClass{policyPeerClass}, nil, false)).
+			To(BeEmpty())
+		Expect(updatePeers(GinkgoLogr, vrgFromView, nil, []ramen.PeerClass{policyPeerClass},
+			map[string]string{"sc1": "sc2"}, false)).To(HaveExactElements(policyPeerClass))
+	})
 })
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	plrv1 "github.com/stolostron/multicloud-operators-placementrule/pkg/apis/apps/v1"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ocmworkv1 "open-cluster-management.io/api/work/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// fakeClientBuilder returns a builder of a fake client with the scheme of the types that the unit tests, which do
+// not need envtest, use
+func fakeClientBuilder(t *testing.T) *fake.ClientBuilder {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, ramendrv1alpha1.AddToScheme(scheme))
+	require.NoError(t, volrep.AddToScheme(scheme))
+	require.NoError(t, ocmworkv1.Install(scheme))
+	require.NoError(t, plrv1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().WithScheme(scheme)
+}
+
+// newFakeClient returns a fake client with the given objects
+func newFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	return fakeClientBuilder(t).WithObjects(objects...).Build()
+}
+
+// getObject gets the latest version of an object from a client
+func getObject[T client.Object](t *testing.T, k8sClient client.Client, object T) T {
+	t.Helper()
+
+	require.NoError(t, k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(object), object))
+
+	return object
+}
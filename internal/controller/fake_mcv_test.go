@@ -12,10 +12,12 @@ import (
 	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
 	groupsnapv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	ocmworkv1 "open-cluster-management.io/api/work/v1"
 	viewv1beta1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/view/v1beta1"
@@ -47,6 +49,16 @@ func (f FakeMCVGetter) GetVSClassFromManagedCluster(resourceName, managedCluster
 	return nil, nil
 }
 
+func (f FakeMCVGetter) GetRecipeFromManagedCluster(resourceName, resourceNamespace, managedCluster string,
+	annotations map[string]string,
+) (*recipev1.Recipe, error) {
+	return nil, k8serrors.NewNotFound(schema.GroupResource{}, "requested resource not found in ManagedCluster")
+}
+
+func (f FakeMCVGetter) DeleteRecipeManagedClusterView(resourceName, resourceNamespace, clusterName string) error {
+	return nil
+}
+
 func (f FakeMCVGetter) ListVSClassMCVs(managedCluster string) (*viewv1beta1.ManagedClusterViewList, error) {
 	return &viewv1beta1.ManagedClusterViewList{}, nil
 }
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -34,6 +36,9 @@ type ExecHook struct {
 	Reader         client.Reader
 	Scheme         *runtime.Scheme
 	RecipeElements util.RecipeElements
+	// Concurrency is the maximum number of pods the command is executed on in
+	// parallel. Defaults to defaultExecConcurrency when not set.
+	Concurrency int
 }
 
 type ExecPodSpec struct {
@@ -53,9 +58,9 @@ func (e ExecHook) Execute(log logr.Logger) error {
 	execPods := e.GetPodsToExecuteCommands(log)
 	inverseOp := e.Hook.Op.InverseOp
 
-	_, err := e.executeCommands(execPods, log)
+	succeededPods, _, err := e.executeCommands(execPods, log)
 	if shouldInverseOpBeExecuted(inverseOp, e.Hook, err) {
-		e.executeInverseOp(inverseOp, log)
+		e.executeInverseOp(inverseOp, succeededPods, log)
 
 		return err
 	}
@@ -63,37 +68,64 @@ func (e ExecHook) Execute(log logr.Logger) error {
 	return nil
 }
 
-func (e ExecHook) executeInverseOp(inverseOp string, log logr.Logger) {
-	var err error
-
-	var inverseExecPod ExecPodSpec
-
+// executeInverseOp executes the inverse operation only on the pods where the operation
+// succeeded, so that the application is not left partially in the state the operation
+// put it in (e.g. some pods quiesced and others not).
+func (e ExecHook) executeInverseOp(inverseOp string, succeededPods []ExecPodSpec, log logr.Logger) {
 	hookSpecForInvHook := e.getHookSpecForInverseOp(inverseOp)
-	if hookSpecForInvHook != nil {
-		log.Info("executing inverse operation", "inverseOp", inverseOp, "namespace", hookSpecForInvHook.Namespace)
+	if hookSpecForInvHook == nil {
+		log.Error(fmt.Errorf("inverse operation %s not found", inverseOp),
+			"inverse operation not found in recipe", "inverseOp", inverseOp)
 
-		tempE := ExecHook{
-			Hook:           hookSpecForInvHook,
-			Reader:         e.Reader,
-			Scheme:         e.Scheme,
-			RecipeElements: e.RecipeElements,
-		}
+		return
+	}
+
+	log.Info("executing inverse operation", "inverseOp", inverseOp, "namespace", hookSpecForInvHook.Namespace,
+		"podCount", len(succeededPods))
 
-		execPods := tempE.GetPodsToExecuteCommands(log)
-		inverseExecPod, err = tempE.executeCommands(execPods, log)
+	tempE := ExecHook{
+		Hook:           hookSpecForInvHook,
+		Reader:         e.Reader,
+		Scheme:         e.Scheme,
+		RecipeElements: e.RecipeElements,
+		Concurrency:    e.Concurrency,
 	}
 
-	if hookSpecForInvHook == nil {
-		log.Error(err, "inverse operation not found in recipe", "inverseOp", inverseOp)
+	execPods := FilterExecPods(tempE.GetPodsToExecuteCommands(log), succeededPods)
+	if len(execPods) == 0 {
+		log.Info("no pods to execute inverse operation on", "inverseOp", inverseOp)
+
+		return
 	}
 
+	_, inverseExecPod, err := tempE.executeCommands(execPods, log)
 	if err != nil {
 		log.Error(err, "error executing inverse operation", "inverseOp", inverseOp, "pod", inverseExecPod.PodName,
 			"namespace", inverseExecPod.Namespace, "command", inverseExecPod.Command)
+
+		return
 	}
 
-	log.Info("executed inverse operation successfully", "inverseOp", inverseOp, "pod", inverseExecPod.PodName,
-		"namespace", inverseExecPod.Namespace, "command", inverseExecPod.Command)
+	log.Info("executed inverse operation successfully", "inverseOp", inverseOp, "podCount", len(execPods))
+}
+
+// FilterExecPods returns the execPods that run in one of the given pods, matched by
+// pod namespace and name.
+func FilterExecPods(execPods, pods []ExecPodSpec) []ExecPodSpec {
+	podKeys := make(map[client.ObjectKey]struct{}, len(pods))
+	for _, pod := range pods {
+		podKeys[client.ObjectKey{Namespace: pod.Namespace, Name: pod.PodName}] = struct{}{}
+	}
+
+	filtered := make([]ExecPodSpec, 0, len(execPods))
+
+	for _, execPod := range execPods {
+		if _, ok := podKeys[client.ObjectKey{Namespace: execPod.Namespace, Name: execPod.PodName}]; ok {
+			filtered = append(filtered, execPod)
+		}
+	}
+
+	return filtered
 }
 
 func shouldInverseOpBeExecuted(inverseOp string, hookSpec *kubeobjects.HookSpec, err error) bool {
@@ -157,28 +189,109 @@ func getMatchingHook(hooks []*recipev1.Hook, hookName string) *recipev1.Hook {
 	return nil
 }
 
-func (e ExecHook) executeCommands(execPods []ExecPodSpec, log logr.Logger) (ExecPodSpec, error) {
+// executeCommands executes the command on the execPods, running at most e.Concurrency
+// commands in parallel. It returns the pods where the command succeeded and, if the hook
+// is set to fail on error, the first pod where the command failed along with the error.
+func (e ExecHook) executeCommands(execPods []ExecPodSpec, log logr.Logger) ([]ExecPodSpec, ExecPodSpec, error) {
 	restCfg, err := config.GetConfig()
 	if err != nil {
-		return ExecPodSpec{}, fmt.Errorf("error getting kubeconfig: %w", err)
+		return nil, ExecPodSpec{}, fmt.Errorf("error getting kubeconfig: %w", err)
 	}
 
 	coreClient, err := kubernetes.NewForConfig(restCfg)
 	if err != nil {
-		return ExecPodSpec{}, fmt.Errorf("error creating kubernetes client: %w", err)
+		return nil, ExecPodSpec{}, fmt.Errorf("error creating kubernetes client: %w", err)
 	}
 
+	failOnError := getOpHookOnError(e.Hook) == defaultOnErrorValue
+
+	succeededPods, failedPod, err := ExecuteOnPods(execPods, e.Concurrency, failOnError,
+		func(execPod *ExecPodSpec) error {
+			return executeCommand(coreClient, restCfg, execPod, e.Hook, e.Scheme, log)
+		})
+	if err != nil {
+		log.Error(err, "error executing command on pod", "pod", failedPod.PodName,
+			"namespace", failedPod.Namespace, "command", failedPod.Command)
+
+		return succeededPods, failedPod, fmt.Errorf("error executing exec hook: %w", err)
+	}
+
+	return succeededPods, ExecPodSpec{}, nil
+}
+
+type execPodResult struct {
+	execPod ExecPodSpec
+	err     error
+}
+
+// ExecuteOnPods calls execute for each of the execPods, with at most concurrency calls in
+// flight at a time. If failOnError is set, no new calls are started once a call fails, and
+// the first failed pod and its error are returned. The pods where execute succeeded are
+// always returned, so that the caller can revert the effect on them.
+func ExecuteOnPods(execPods []ExecPodSpec, concurrency int, failOnError bool,
+	execute func(*ExecPodSpec) error,
+) ([]ExecPodSpec, ExecPodSpec, error) {
+	if concurrency <= 0 {
+		concurrency = defaultExecConcurrency
+	}
+
+	var (
+		wg     sync.WaitGroup
+		failed atomic.Bool
+	)
+
+	sem := make(chan struct{}, concurrency)
+	results := make(chan execPodResult, len(execPods))
+
 	for _, execPod := range execPods {
-		err := executeCommand(coreClient, restCfg, &execPod, e.Hook, e.Scheme, log)
-		if err != nil && getOpHookOnError(e.Hook) == defaultOnErrorValue {
-			log.Error(err, "error executing command on pod", "pod", execPod.PodName,
-				"namespace", execPod.Namespace, "command", execPod.Command)
+		sem <- struct{}{}
+
+		if failOnError && failed.Load() {
+			<-sem
+
+			break
+		}
+
+		wg.Add(1)
+
+		go func(execPod ExecPodSpec) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
 
-			return execPod, fmt.Errorf("error executing exec hook: %w", err)
+			err := execute(&execPod)
+			if err != nil {
+				failed.Store(true)
+			}
+
+			results <- execPodResult{execPod: execPod, err: err}
+		}(execPod)
+	}
+
+	wg.Wait()
+	close(results)
+
+	succeededPods := make([]ExecPodSpec, 0, len(execPods))
+
+	var (
+		failedPod ExecPodSpec
+		firstErr  error
+	)
+
+	for result := range results {
+		if result.err == nil {
+			succeededPods = append(succeededPods, result.execPod)
+
+			continue
+		}
+
+		if failOnError && firstErr == nil {
+			failedPod, firstErr = result.execPod, result.err
 		}
 	}
 
-	return ExecPodSpec{}, nil
+	return succeededPods, failedPod, firstErr
 }
 
 func executeCommand(coreClient *kubernetes.Clientset, restCfg *rest.Config, execPod *ExecPodSpec,
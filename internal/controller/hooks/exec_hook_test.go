@@ -2,7 +2,11 @@ package hooks_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	appsv1 "k8s.io/api/apps/v1"
@@ -238,3 +242,87 @@ func TestIsRSOwnedByDeployment(t *testing.T) {
 	}
 	assert.True(t, hooks.IsRSOwnedByDeployment(rs, "test-deployment"))
 }
+
+func getExecPods(count int) []hooks.ExecPodSpec {
+	execPods := make([]hooks.ExecPodSpec, 0, count)
+
+	for i := range count {
+		execPods = append(execPods, hooks.ExecPodSpec{
+			PodName:   fmt.Sprintf("test-pod-%d", i),
+			Namespace: "test-ns",
+			Command:   []string{"echo", "hello"},
+			Container: "test-container",
+		})
+	}
+
+	return execPods
+}
+
+func TestExecuteOnPodsRespectsConcurrency(t *testing.T) {
+	var running, maxRunning atomic.Int32
+
+	succeeded, _, err := hooks.ExecuteOnPods(getExecPods(20), 3, true, func(*hooks.ExecPodSpec) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+
+		for {
+			prev := maxRunning.Load()
+			if current <= prev || maxRunning.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 20, len(succeeded))
+	assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+}
+
+func TestExecuteOnPodsStopsOnError(t *testing.T) {
+	var executed atomic.Int32
+
+	succeeded, failedPod, err := hooks.ExecuteOnPods(getExecPods(20), 1, true, func(execPod *hooks.ExecPodSpec) error {
+		executed.Add(1)
+
+		if execPod.PodName == "test-pod-4" {
+			return errors.New("command failed")
+		}
+
+		return nil
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, "test-pod-4", failedPod.PodName)
+	assert.Equal(t, 4, len(succeeded))
+	assert.Equal(t, int32(5), executed.Load())
+}
+
+func TestExecuteOnPodsContinueOnError(t *testing.T) {
+	succeeded, _, err := hooks.ExecuteOnPods(getExecPods(5), 2, false, func(execPod *hooks.ExecPodSpec) error {
+		if execPod.PodName == "test-pod-1" {
+			return errors.New("command failed")
+		}
+
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(succeeded))
+}
+
+func TestFilterExecPods(t *testing.T) {
+	execPods := getExecPods(4)
+	succeeded := []hooks.ExecPodSpec{execPods[0], execPods[2]}
+
+	inverseExecPods := getExecPods(4)
+	for i := range inverseExecPods {
+		inverseExecPods[i].Command = []string{"echo", "bye"}
+	}
+
+	filtered := hooks.FilterExecPods(inverseExecPods, succeeded)
+	assert.Equal(t, []hooks.ExecPodSpec{inverseExecPods[0], inverseExecPods[2]}, filtered)
+}
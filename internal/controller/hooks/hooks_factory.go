@@ -20,6 +20,8 @@ type HookContext struct {
 	Reader         client.Reader
 	Scheme         *runtime.Scheme
 	RecipeElements util.RecipeElements
+	// ExecConcurrency is the maximum number of pods an exec hook runs its command on in parallel
+	ExecConcurrency int
 }
 
 // Hook interface will help in executing the hooks based on the types.
@@ -44,6 +46,7 @@ func GetHookExecutor(ctx HookContext) (HookExecutor, error) {
 			Reader:         ctx.Reader,
 			Scheme:         ctx.Scheme,
 			RecipeElements: ctx.RecipeElements,
+			Concurrency:    ctx.ExecConcurrency,
 		}, nil
 
 	case "scale":
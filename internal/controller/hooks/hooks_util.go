@@ -15,8 +15,9 @@ import (
 )
 
 const (
-	defaultTimeoutValue = 300
-	defaultOnErrorValue = "fail"
+	defaultTimeoutValue    = 300
+	defaultOnErrorValue    = "fail"
+	defaultExecConcurrency = 10
 )
 
 type NameSelectorType string
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hooks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// ValidateHookSpec checks a hook spec, as resolved from a recipe workflow step, for the
+// errors that would otherwise be found only when the hook is executed. The recipe is used
+// to resolve the inverse operation of an exec hook. No cluster access is needed.
+func ValidateHookSpec(hook *kubeobjects.HookSpec, recipe *recipev1.Recipe) error {
+	if err := validateHookSelectors(hook); err != nil {
+		return err
+	}
+
+	switch hook.Type {
+	case "exec":
+		return validateExecHookSpec(hook, recipe)
+	case "check":
+		return validateCheckHookSpec(hook)
+	case "scale":
+		return validateScaleHookSpec(hook)
+	default:
+		return fmt.Errorf("unsupported hook type: %s", hook.Type)
+	}
+}
+
+func validateHookSelectors(hook *kubeobjects.HookSpec) error {
+	if hook.LabelSelector == nil && hook.NameSelector == "" {
+		return fmt.Errorf("hook %s: either nameSelector or labelSelector should be provided to get resources",
+			hook.Name)
+	}
+
+	if hook.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(hook.LabelSelector); err != nil {
+			return fmt.Errorf("hook %s: invalid labelSelector: %w", hook.Name, err)
+		}
+	}
+
+	if hook.NameSelector != "" && !isValidK8sName(hook.NameSelector) && !isValidRegex(hook.NameSelector) {
+		return fmt.Errorf("hook %s: nameSelector %s is neither distinct name nor regex", hook.Name,
+			hook.NameSelector)
+	}
+
+	return nil
+}
+
+func validateExecHookSpec(hook *kubeobjects.HookSpec, recipe *recipev1.Recipe) error {
+	if !slices.Contains([]string{"", podType, deploymentType, statefulsetType}, hook.SelectResource) {
+		return fmt.Errorf("hook %s: unsupported selectResource %s, supported values are %s, %s and %s",
+			hook.Name, hook.SelectResource, podType, deploymentType, statefulsetType)
+	}
+
+	if strings.TrimSpace(hook.Op.Command) == "" {
+		return fmt.Errorf("hook %s: operation %s has no command", hook.Name, hook.Op.Name)
+	}
+
+	if _, err := covertCommandToStringArray(hook.Op.Command); err != nil {
+		return fmt.Errorf("hook %s: operation %s command %s is invalid: %w", hook.Name, hook.Op.Name,
+			hook.Op.Command, err)
+	}
+
+	if hook.Op.InverseOp == "" {
+		return nil
+	}
+
+	e := ExecHook{
+		Hook:           hook,
+		RecipeElements: util.RecipeElements{RecipeWithParams: recipe},
+	}
+
+	if e.getHookSpecForInverseOp(hook.Op.InverseOp) == nil {
+		return fmt.Errorf("hook %s: inverse operation %s of operation %s not found in recipe", hook.Name,
+			hook.Op.InverseOp, hook.Op.Name)
+	}
+
+	return nil
+}
+
+func validateCheckHookSpec(hook *kubeobjects.HookSpec) error {
+	const three = 3
+
+	if parts := strings.Split(hook.SelectResource, "/"); hook.SelectResource == "" ||
+		(len(parts) != 1 && len(parts) != three) {
+		return fmt.Errorf("hook %s: invalid selectResource %s, supported resource types are "+
+			"pod/deployment/statefulset, plural resource names for core or custom resource in the format "+
+			"<apiGroup>/<apiVersion>/<resourceName>", hook.Name, hook.SelectResource)
+	}
+
+	if err := validateBooleanExpression(hook.Chk.Condition); err != nil {
+		return fmt.Errorf("hook %s: check %s condition is invalid: %w", hook.Name, hook.Chk.Name, err)
+	}
+
+	return nil
+}
+
+func validateScaleHookSpec(hook *kubeobjects.HookSpec) error {
+	if _, ok := parseScaleSelectResource(hook.SelectResource); !ok &&
+		!slices.Contains([]string{deploymentType, statefulsetType, virtualMachineType}, hook.SelectResource) {
+		return fmt.Errorf("hook %s: unsupported selectResource %s for scale hook, supported values are %s, %s, "+
+			"%s and resources with a scale subresource in the format <apiGroup>/<apiVersion>/<resourceName>",
+			hook.Name, hook.SelectResource, deploymentType, statefulsetType, virtualMachineType)
+	}
+
+	if !slices.Contains([]string{ScaleUp, ScaleDown, ScaleSync}, hook.Scale.Operation) {
+		return fmt.Errorf("hook %s: unsupported scale operation %s, supported operations are %s, %s and %s",
+			hook.Name, hook.Scale.Operation, ScaleUp, ScaleDown, ScaleSync)
+	}
+
+	return nil
+}
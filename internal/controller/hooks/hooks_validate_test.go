@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hooks_test
+
+import (
+	"testing"
+
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ramendr/ramen/internal/controller/hooks"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+func getValidationRecipe() *recipev1.Recipe {
+	return &recipev1.Recipe{
+		Spec: recipev1.RecipeSpec{
+			Hooks: []*recipev1.Hook{
+				{
+					Name: "test",
+					Type: "exec",
+					Ops: []*recipev1.Operation{
+						{Name: "exec-hook", Command: "echo hello", InverseOp: "undo"},
+						{Name: "undo", Command: "echo bye"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateHookSpecExec(t *testing.T) {
+	recipe := getValidationRecipe()
+
+	hookSpec := getOpHookSpec()
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, recipe), "no selector")
+
+	hookSpec.NameSelector = "test-pod"
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, recipe))
+
+	hookSpec.Op.InverseOp = "undo"
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, recipe))
+
+	hookSpec.Op.InverseOp = "test/missing"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, recipe), "inverse op not in recipe")
+
+	hookSpec.Op.InverseOp = ""
+	hookSpec.SelectResource = "daemonset"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, recipe), "unsupported selectResource")
+
+	hookSpec.SelectResource = "deployment"
+	hookSpec.Op.Command = ""
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, recipe), "no command")
+}
+
+func TestValidateHookSpecCheck(t *testing.T) {
+	hookSpec := &kubeobjects.HookSpec{
+		Name:           "test",
+		Type:           "check",
+		SelectResource: "deployment",
+		LabelSelector:  &metav1.LabelSelector{MatchLabels: map[string]string{"app": "test"}},
+		Chk: kubeobjects.Check{
+			Name:      "ready",
+			Condition: "({$.spec.replicas} == {$.status.readyReplicas}) && ({$.status.replicas} > {0})",
+		},
+	}
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, nil))
+
+	hookSpec.Chk.Condition = "{$.spec.replicas}"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil), "no operator")
+
+	hookSpec.Chk.Condition = "{$.spec.replicas} == {$.status.readyReplicas}"
+	hookSpec.SelectResource = "apps/deployments"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil), "invalid selectResource format")
+}
+
+func TestValidateHookSpecScale(t *testing.T) {
+	hookSpec := &kubeobjects.HookSpec{
+		Name:           "test",
+		Type:           "scale",
+		SelectResource: "statefulset",
+		NameSelector:   "db-.*",
+		Scale:          kubeobjects.ScaleSpec{Operation: hooks.ScaleDown},
+	}
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, nil))
+
+	hookSpec.Scale.Operation = "sideways"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil), "unsupported operation")
+
+	hookSpec.Scale.Operation = hooks.ScaleUp
+	hookSpec.SelectResource = "argoproj.io/v1alpha1/rollouts"
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, nil))
+
+	hookSpec.SelectResource = "virtualmachine"
+	assert.NoError(t, hooks.ValidateHookSpec(hookSpec, nil))
+
+	hookSpec.SelectResource = "rollouts"
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil), "unsupported selectResource")
+
+	hookSpec.SelectResource = "statefulset"
+	hookSpec.NameSelector = "db-("
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil), "invalid nameSelector")
+}
+
+func TestValidateHookSpecUnsupportedType(t *testing.T) {
+	hookSpec := &kubeobjects.HookSpec{Name: "test", Type: "shell", NameSelector: "test"}
+	assert.Error(t, hooks.ValidateHookSpec(hookSpec, nil))
+}
@@ -83,6 +83,32 @@ func evaluateBooleanExpression(expression string, jsonData interface{}) (bool, e
 	return compare(operands[0], operands[1], op)
 }
 
+// validateBooleanExpression checks the syntax of a check hook condition the same way
+// evaluateBooleanExpression parses it, without evaluating the JSONPaths.
+func validateBooleanExpression(expression string) error {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return fmt.Errorf("empty boolean expression")
+	}
+
+	if isFullyEnclosed(expression) {
+		return validateBooleanExpression(expression[1 : len(expression)-1])
+	}
+
+	left, operator, right := splitOutsideBrackets(expression)
+	if operator != "" {
+		if err := validateBooleanExpression(left); err != nil {
+			return err
+		}
+
+		return validateBooleanExpression(right)
+	}
+
+	_, _, err := parseBooleanExpression(expression)
+
+	return err
+}
+
 func splitOutsideBrackets(expression string) (string, string, string) {
 	braces, parens := 0, 0
 
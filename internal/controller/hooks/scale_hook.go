@@ -6,11 +6,14 @@ import (
 	"math"
 	"reflect"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/ramendr/ramen/internal/controller/kubeobjects"
@@ -128,13 +131,18 @@ func (s ScaleHook) processResources(resources []client.Object, scaleOp string, l
 	var lastErr error
 
 	for _, obj := range resources {
-		var err error
+		var (
+			resource Resource
+			err      error
+		)
 
 		switch res := obj.(type) {
 		case *appsv1.Deployment:
-			err = s.scaleResource(DeploymentResource{res}, scaleOp, log)
+			resource = DeploymentResource{res}
 		case *appsv1.StatefulSet:
-			err = s.scaleResource(StatefulSetResource{res}, scaleOp, log)
+			resource = StatefulSetResource{res}
+		case *unstructured.Unstructured:
+			resource, err = s.unstructuredResource(context.Background(), res)
 		default:
 			log.Info("Unsupported resource type for scaling",
 				"hook", s.Hook.Name,
@@ -146,6 +154,10 @@ func (s ScaleHook) processResources(resources []client.Object, scaleOp string, l
 			continue
 		}
 
+		if err == nil {
+			err = s.scaleResource(resource, scaleOp, log)
+		}
+
 		if err != nil {
 			if s.Hook.OnError == "continue" {
 				lastErr = err
@@ -164,6 +176,16 @@ func (s ScaleHook) processResources(resources []client.Object, scaleOp string, l
 	return nil
 }
 
+// unstructuredResource returns a VirtualMachine as a resource that is stopped and started,
+// and any other resource as one that is scaled through its scale subresource.
+func (s ScaleHook) unstructuredResource(ctx context.Context, obj *unstructured.Unstructured) (Resource, error) {
+	if isVirtualMachine(obj) {
+		return VirtualMachineResource{obj}, nil
+	}
+
+	return newScaleSubresourceResource(ctx, s.Client, obj)
+}
+
 func (s ScaleHook) getResourcesToScale() ([]client.Object, error) {
 	objList, err := s.getResourceListForType()
 	if err != nil {
@@ -331,7 +353,7 @@ func (s ScaleHook) SyncResource(resource Resource, log logr.Logger) error {
 				name, targetReplicas, timeout)
 
 		case <-ticker.C:
-			refreshed, err := s.refreshResource(s.Reader, resource)
+			refreshed, err := s.refreshResource(ctx, s.Reader, resource)
 			if err != nil {
 				log.Info("Error refreshing resource during sync",
 					"hook", s.Hook.Name,
@@ -414,7 +436,15 @@ func (s ScaleHook) getResourceListForType() (client.ObjectList, error) {
 		return &appsv1.DeploymentList{}, nil
 	case statefulsetType:
 		return &appsv1.StatefulSetList{}, nil
-	default:
+	case virtualMachineType:
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(virtualMachineGVK.GroupVersion().WithKind(virtualMachineGVK.Kind + "List"))
+
+		return list, nil
+	}
+
+	gvr, ok := parseScaleSelectResource(s.Hook.SelectResource)
+	if !ok {
 		return nil, fmt.Errorf(
 			"Unsupported resource type for scale hook: hook=%s, namespace=%s, operation=%s, selectResource=%s",
 			s.Hook.Name,
@@ -423,17 +453,41 @@ func (s ScaleHook) getResourceListForType() (client.ObjectList, error) {
 			s.Hook.SelectResource,
 		)
 	}
+
+	gvk, err := s.Client.RESTMapper().KindFor(gvr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kind of resource %s for scale hook %s: %w", s.Hook.SelectResource,
+			s.Hook.Name, err)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+	return list, nil
+}
+
+// parseScaleSelectResource parses a scale hook selectResource in the format
+// <apiGroup>/<apiVersion>/<resourceName>, where the apiGroup is empty for core resources.
+func parseScaleSelectResource(selectResource string) (schema.GroupVersionResource, bool) {
+	const three = 3
+
+	parts := strings.Split(selectResource, "/")
+	if len(parts) != three || parts[1] == "" || parts[2] == "" {
+		return schema.GroupVersionResource{}, false
+	}
+
+	return schema.GroupVersionResource{Group: parts[0], Version: parts[1], Resource: parts[2]}, true
 }
 
-func (s ScaleHook) refreshResource(reader client.Reader, resource Resource) (Resource, error) {
+func (s ScaleHook) refreshResource(ctx context.Context, reader client.Reader, resource Resource) (Resource, error) {
 	namespace := resource.GetObjectMeta().GetNamespace()
 	name := resource.GetObjectMeta().GetName()
 
-	switch resource.(type) {
+	switch r := resource.(type) {
 	case DeploymentResource:
 		deployment := &appsv1.Deployment{}
 
-		err := reader.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, deployment)
+		err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, deployment)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"failed to get Deployment resource %s/%s for hook %s: %w",
@@ -445,7 +499,7 @@ func (s ScaleHook) refreshResource(reader client.Reader, resource Resource) (Res
 	case StatefulSetResource:
 		statefulset := &appsv1.StatefulSet{}
 
-		err := reader.Get(context.Background(), client.ObjectKey{Namespace: namespace, Name: name}, statefulset)
+		err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, statefulset)
 		if err != nil {
 			return nil, fmt.Errorf(
 				"failed to get StatefulSet resource %s/%s for hook %s: %w",
@@ -454,12 +508,48 @@ func (s ScaleHook) refreshResource(reader client.Reader, resource Resource) (Res
 
 		return StatefulSetResource{statefulset}, nil
 
+	case ScaleSubresourceResource:
+		refreshed, err := s.refreshUnstructuredResource(ctx, reader, r.GroupVersionKind(), namespace, name)
+		if err != nil {
+			return nil, err
+		}
+
+		scaleSubresourceResource, ok := refreshed.(ScaleSubresourceResource)
+		if !ok {
+			return refreshed, nil
+		}
+
+		if err := scaleSubresourceResource.getReadyReplicas(ctx, reader); err != nil {
+			return nil, fmt.Errorf("failed to get ready replicas for hook %s: %w", s.Hook.Name, err)
+		}
+
+		return scaleSubresourceResource, nil
+
+	case VirtualMachineResource:
+		return s.refreshUnstructuredResource(ctx, reader, r.GroupVersionKind(), namespace, name)
+
 	default:
 		return nil, fmt.Errorf("unsupported resource type for hook %s when fetching resource %s/%s",
 			s.Hook.Name, namespace, name)
 	}
 }
 
+func (s ScaleHook) refreshUnstructuredResource(ctx context.Context, reader client.Reader, gvk schema.GroupVersionKind,
+	namespace, name string,
+) (Resource, error) {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+
+	err := reader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"failed to get %s resource %s/%s for hook %s: %w",
+			gvk.Kind, namespace, name, s.Hook.Name, err)
+	}
+
+	return s.unstructuredResource(ctx, obj)
+}
+
 func getHookTimeoutValue(hook *kubeobjects.HookSpec) int {
 	if hook.Timeout != 0 {
 		return hook.Timeout
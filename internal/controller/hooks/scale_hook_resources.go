@@ -0,0 +1,253 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	virtualMachineType    = "virtualmachine"
+	runStrategyAnnotation = "ramendr.io/scale-hook-run-strategy"
+	runStrategyHalted     = "Halted"
+	runStrategyAlways     = "Always"
+	scaleSubresource      = "scale"
+)
+
+var virtualMachineGVK = schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"}
+
+// ScaleSubresourceResource scales any resource that implements the scale subresource, such as
+// an Argo Rollout or the custom resource of an operator, through the subresource.
+type ScaleSubresourceResource struct {
+	*unstructured.Unstructured
+	Scale *autoscalingv1.Scale
+
+	original         *unstructured.Unstructured
+	originalReplicas int32
+	readyReplicas    int32
+}
+
+func newScaleSubresourceResource(ctx context.Context, c client.Client, obj *unstructured.Unstructured,
+) (ScaleSubresourceResource, error) {
+	scale := &autoscalingv1.Scale{}
+	if err := c.SubResource(scaleSubresource).Get(ctx, obj, scale); err != nil {
+		return ScaleSubresourceResource{}, fmt.Errorf("failed to get scale subresource of %s %s/%s: %w",
+			obj.GetKind(), obj.GetNamespace(), obj.GetName(), err)
+	}
+
+	return ScaleSubresourceResource{
+		Unstructured:     obj,
+		Scale:            scale,
+		original:         obj.DeepCopy(),
+		originalReplicas: scale.Spec.Replicas,
+	}, nil
+}
+
+func (r ScaleSubresourceResource) GetReplicasFromSpec() *int32 {
+	return &r.Scale.Spec.Replicas
+}
+
+func (r ScaleSubresourceResource) SetReplicas(replicas *int32) {
+	if replicas != nil {
+		r.Scale.Spec.Replicas = *replicas
+	}
+}
+
+// GetReplicasFromStatus returns the ready replicas of the resource as last read by
+// getReadyReplicas, zero until then.
+func (r ScaleSubresourceResource) GetReplicasFromStatus() *int32 {
+	return &r.readyReplicas
+}
+
+// getReadyReplicas reads the ready replicas of the resource, so that a sync waits for its pods
+// to be Ready rather than only created: the readyReplicas in its status, as reported by Argo
+// Rollouts and most operators, or else the number of Ready pods matching the selector of its
+// scale subresource. A resource that reports neither has no ready replicas.
+func (r *ScaleSubresourceResource) getReadyReplicas(ctx context.Context, reader client.Reader) error {
+	r.readyReplicas = 0
+
+	if value, found, err := unstructured.NestedInt64(r.Object, "status", "readyReplicas"); found && err == nil {
+		r.readyReplicas = int32(value) //nolint:gosec
+
+		return nil
+	}
+
+	if r.Scale.Status.Selector == "" {
+		return nil
+	}
+
+	selector, err := labels.Parse(r.Scale.Status.Selector)
+	if err != nil {
+		return fmt.Errorf("failed to parse selector %q of scale subresource of %s %s/%s: %w",
+			r.Scale.Status.Selector, r.GetKind(), r.GetNamespace(), r.GetName(), err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := reader.List(ctx, pods, client.InNamespace(r.GetNamespace()),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list pods of %s %s/%s: %w", r.GetKind(), r.GetNamespace(), r.GetName(), err)
+	}
+
+	for idx := range pods.Items {
+		pod := &pods.Items[idx]
+		if pod.GetDeletionTimestamp().IsZero() && util.IsPodReady(pod.Status.Conditions) {
+			r.readyReplicas++
+		}
+	}
+
+	return nil
+}
+
+func (r ScaleSubresourceResource) GetObjectMeta() metav1.Object {
+	return r.Unstructured
+}
+
+// Update patches the annotations and updates the scale subresource. The replica count
+// annotation is written before scaling down and removed after scaling up, so that it is
+// not lost if the other update fails.
+func (r ScaleSubresourceResource) Update(ctx context.Context, c client.Client) error {
+	if r.Scale.Spec.Replicas > r.originalReplicas {
+		if err := r.updateScale(ctx, c); err != nil {
+			return err
+		}
+
+		return r.patchAnnotations(ctx, c)
+	}
+
+	if err := r.patchAnnotations(ctx, c); err != nil {
+		return err
+	}
+
+	return r.updateScale(ctx, c)
+}
+
+func (r ScaleSubresourceResource) patchAnnotations(ctx context.Context, c client.Client) error {
+	if maps.Equal(r.GetAnnotations(), r.original.GetAnnotations()) {
+		return nil
+	}
+
+	return c.Patch(ctx, r.Unstructured, client.MergeFrom(r.original))
+}
+
+func (r ScaleSubresourceResource) updateScale(ctx context.Context, c client.Client) error {
+	if r.Scale.Spec.Replicas == r.originalReplicas {
+		return nil
+	}
+
+	// The resource version of the scale is that of the resource, which the annotations
+	// patch may have changed.
+	r.Scale.ResourceVersion = ""
+
+	return c.SubResource(scaleSubresource).Update(ctx, r.Unstructured, client.WithSubResourceBody(r.Scale))
+}
+
+// VirtualMachineResource stops and starts a KubeVirt VirtualMachine, as a resource with one
+// replica while it runs. A VM with a run strategy is stopped with the Halted run strategy,
+// and its original run strategy is recorded in an annotation while it is stopped. A VM with
+// the legacy running field is stopped and started with it.
+type VirtualMachineResource struct {
+	*unstructured.Unstructured
+}
+
+func (v VirtualMachineResource) GetReplicasFromSpec() *int32 {
+	replicas := int32(0)
+
+	if runStrategy, found, _ := unstructured.NestedString(v.Object, "spec", "runStrategy"); found {
+		if runStrategy != runStrategyHalted {
+			replicas = 1
+		}
+
+		return &replicas
+	}
+
+	if running, _, _ := unstructured.NestedBool(v.Object, "spec", "running"); running {
+		replicas = 1
+	}
+
+	return &replicas
+}
+
+func (v VirtualMachineResource) SetReplicas(replicas *int32) {
+	if replicas == nil {
+		return
+	}
+
+	run := *replicas > 0
+
+	if _, found, _ := unstructured.NestedBool(v.Object, "spec", "running"); found {
+		_ = unstructured.SetNestedField(v.Object, run, "spec", "running")
+
+		return
+	}
+
+	annotations := v.Unstructured.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+
+	if !run {
+		runStrategy, _, _ := unstructured.NestedString(v.Object, "spec", "runStrategy")
+		if runStrategy != "" && runStrategy != runStrategyHalted {
+			annotations[runStrategyAnnotation] = runStrategy
+			v.Unstructured.SetAnnotations(annotations)
+		}
+
+		_ = unstructured.SetNestedField(v.Object, runStrategyHalted, "spec", "runStrategy")
+
+		return
+	}
+
+	runStrategy, ok := annotations[runStrategyAnnotation]
+	if !ok {
+		runStrategy = runStrategyAlways
+	}
+
+	_ = unstructured.SetNestedField(v.Object, runStrategy, "spec", "runStrategy")
+}
+
+func (v VirtualMachineResource) GetReplicasFromStatus() *int32 {
+	replicas := int32(0)
+
+	if ready, _, _ := unstructured.NestedBool(v.Object, "status", "ready"); ready {
+		replicas = 1
+	}
+
+	return &replicas
+}
+
+// SetAnnotations sets the annotations, and drops the recorded run strategy along with the
+// replica count once the VM is scaled up.
+func (v VirtualMachineResource) SetAnnotations(annotations map[string]string) {
+	if _, ok := annotations[replicasCountAnnotation]; !ok {
+		delete(annotations, runStrategyAnnotation)
+	}
+
+	v.Unstructured.SetAnnotations(annotations)
+}
+
+func (v VirtualMachineResource) GetObjectMeta() metav1.Object {
+	return v.Unstructured
+}
+
+func (v VirtualMachineResource) Update(ctx context.Context, c client.Client) error {
+	return c.Update(ctx, v.Unstructured)
+}
+
+func isVirtualMachine(obj *unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+
+	return gvk.Group == virtualMachineGVK.Group && gvk.Kind == virtualMachineGVK.Kind
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package hooks_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	"github.com/ramendr/ramen/internal/controller/hooks"
+	"github.com/ramendr/ramen/internal/controller/kubeobjects"
+)
+
+var (
+	rolloutGVK        = schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}
+	virtualMachineGVK = schema.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "VirtualMachine"}
+)
+
+// setupFakeClientScaleSubresource returns a client that serves the scale subresource of
+// unstructured objects from their spec.replicas and status.replicas, as the API server does
+// for custom resources.
+func setupFakeClientScaleSubresource(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+
+	for _, gvk := range []schema.GroupVersionKind{rolloutGVK, virtualMachineGVK} {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+		mapper.Add(gvk.GroupVersion().WithKind(gvk.Kind+"List"), meta.RESTScopeNamespace)
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(mapper).
+		WithObjects(objects...).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceGet: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+				subResource client.Object, _ ...client.SubResourceGetOption,
+			) error {
+				require.Equal(t, "scale", subResourceName)
+
+				u := obj.(*unstructured.Unstructured) //nolint:forcetypeassert
+				if err := c.Get(ctx, client.ObjectKeyFromObject(u), u); err != nil {
+					return err
+				}
+
+				replicas, _, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+				statusReplicas, _, _ := unstructured.NestedInt64(u.Object, "status", "replicas")
+				selector, _, _ := unstructured.NestedString(u.Object, "status", "selector")
+				scale := subResource.(*autoscalingv1.Scale)   //nolint:forcetypeassert
+				scale.Spec.Replicas = int32(replicas)         //nolint:gosec
+				scale.Status.Replicas = int32(statusReplicas) //nolint:gosec
+				scale.Status.Selector = selector
+
+				return nil
+			},
+			SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object,
+				opts ...client.SubResourceUpdateOption,
+			) error {
+				require.Equal(t, "scale", subResourceName)
+
+				updateOptions := client.SubResourceUpdateOptions{}
+				updateOptions.ApplyOptions(opts)
+				scale := updateOptions.SubResourceBody.(*autoscalingv1.Scale) //nolint:forcetypeassert
+
+				u := &unstructured.Unstructured{}
+				u.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+
+				if err := c.Get(ctx, client.ObjectKeyFromObject(obj), u); err != nil {
+					return err
+				}
+
+				if err := unstructured.SetNestedField(u.Object, int64(scale.Spec.Replicas), "spec", "replicas"); err != nil {
+					return err
+				}
+
+				return c.Update(ctx, u)
+			},
+		}).
+		Build()
+}
+
+func newUnstructured(gvk schema.GroupVersionKind, name string, spec map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"spec": spec}}
+	u.SetGroupVersionKind(gvk)
+	u.SetNamespace("test-ns")
+	u.SetName(name)
+
+	return u
+}
+
+func executeScaleHook(t *testing.T, c client.Client, selectResource, operation string) {
+	t.Helper()
+
+	require.NoError(t, executeScaleHookWithTimeout(c, selectResource, operation, 0))
+}
+
+func executeScaleHookWithTimeout(c client.Client, selectResource, operation string, timeout int) error {
+	scaleHook := hooks.ScaleHook{
+		Hook: &kubeobjects.HookSpec{
+			Name:           "test-hook",
+			Namespace:      "test-ns",
+			Type:           "scale",
+			SelectResource: selectResource,
+			NameSelector:   "test-.*",
+			Timeout:        timeout,
+			Scale:          kubeobjects.ScaleSpec{Operation: operation},
+		},
+		Reader: c,
+		Client: c,
+	}
+
+	return scaleHook.Execute(zap.New(zap.UseDevMode(true)))
+}
+
+func newPod(name string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: name, Labels: map[string]string{"app": "test"}},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}},
+		},
+	}
+}
+
+func getUnstructured(t *testing.T, c client.Client, gvk schema.GroupVersionKind, name string,
+) *unstructured.Unstructured {
+	t.Helper()
+
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	require.NoError(t, c.Get(context.Background(), client.ObjectKey{Namespace: "test-ns", Name: name}, u))
+
+	return u
+}
+
+func TestScaleHookScaleSubresource(t *testing.T) {
+	c := setupFakeClientScaleSubresource(t,
+		newUnstructured(rolloutGVK, "test-rollout", map[string]interface{}{"replicas": int64(3)}))
+
+	executeScaleHook(t, c, "argoproj.io/v1alpha1/rollouts", hooks.ScaleDown)
+
+	rollout := getUnstructured(t, c, rolloutGVK, "test-rollout")
+	replicas, _, _ := unstructured.NestedInt64(rollout.Object, "spec", "replicas")
+	assert.Equal(t, int64(0), replicas)
+	assert.Equal(t, "3", rollout.GetAnnotations()["ramendr.io/scale-hook-replicas-count"])
+
+	executeScaleHook(t, c, "argoproj.io/v1alpha1/rollouts", hooks.ScaleUp)
+
+	rollout = getUnstructured(t, c, rolloutGVK, "test-rollout")
+	replicas, _, _ = unstructured.NestedInt64(rollout.Object, "spec", "replicas")
+	assert.Equal(t, int64(3), replicas)
+	assert.NotContains(t, rollout.GetAnnotations(), "ramendr.io/scale-hook-replicas-count")
+}
+
+func TestScaleHookScaleSubresourceSyncWaitsForReadyReplicas(t *testing.T) {
+	rollout := newUnstructured(rolloutGVK, "test-rollout", map[string]interface{}{"replicas": int64(2)})
+	rollout.Object["status"] = map[string]interface{}{"replicas": int64(2), "readyReplicas": int64(1)}
+
+	c := setupFakeClientScaleSubresource(t, rollout)
+
+	err := executeScaleHookWithTimeout(c, "argoproj.io/v1alpha1/rollouts", hooks.ScaleSync, 6)
+	assert.ErrorContains(t, err, "did not reach 2")
+}
+
+func TestScaleHookScaleSubresourceSyncCountsReadyPods(t *testing.T) {
+	rollout := newUnstructured(rolloutGVK, "test-rollout", map[string]interface{}{"replicas": int64(2)})
+	rollout.Object["status"] = map[string]interface{}{"replicas": int64(3), "selector": "app=test"}
+
+	c := setupFakeClientScaleSubresource(t, rollout,
+		newPod("test-pod-1", corev1.ConditionTrue),
+		newPod("test-pod-2", corev1.ConditionTrue),
+		newPod("test-pod-3", corev1.ConditionFalse),
+	)
+
+	assert.NoError(t, executeScaleHookWithTimeout(c, "argoproj.io/v1alpha1/rollouts", hooks.ScaleSync, 6))
+}
+
+func TestScaleHookScaleSubresourceSyncFailsWithInvalidSelector(t *testing.T) {
+	rollout := newUnstructured(rolloutGVK, "test-rollout", map[string]interface{}{"replicas": int64(2)})
+	rollout.Object["status"] = map[string]interface{}{"replicas": int64(2), "selector": "app in ("}
+
+	c := setupFakeClientScaleSubresource(t, rollout)
+
+	err := executeScaleHookWithTimeout(c, "argoproj.io/v1alpha1/rollouts", hooks.ScaleSync, 6)
+	assert.ErrorContains(t, err, "failed to parse selector")
+}
+
+func TestScaleHookVirtualMachineRunStrategy(t *testing.T) {
+	c := setupFakeClientScaleSubresource(t,
+		newUnstructured(virtualMachineGVK, "test-vm", map[string]interface{}{"runStrategy": "RerunOnFailure"}))
+
+	executeScaleHook(t, c, "virtualmachine", hooks.ScaleDown)
+
+	vm := getUnstructured(t, c, virtualMachineGVK, "test-vm")
+	runStrategy, _, _ := unstructured.NestedString(vm.Object, "spec", "runStrategy")
+	assert.Equal(t, "Halted", runStrategy)
+	assert.Equal(t, "1", vm.GetAnnotations()["ramendr.io/scale-hook-replicas-count"])
+	assert.Equal(t, "RerunOnFailure", vm.GetAnnotations()["ramendr.io/scale-hook-run-strategy"])
+
+	executeScaleHook(t, c, "kubevirt.io/v1/virtualmachines", hooks.ScaleUp)
+
+	vm = getUnstructured(t, c, virtualMachineGVK, "test-vm")
+	runStrategy, _, _ = unstructured.NestedString(vm.Object, "spec", "runStrategy")
+	assert.Equal(t, "RerunOnFailure", runStrategy)
+	assert.Empty(t, vm.GetAnnotations())
+}
+
+func TestScaleHookVirtualMachineRunning(t *testing.T) {
+	c := setupFakeClientScaleSubresource(t,
+		newUnstructured(virtualMachineGVK, "test-vm", map[string]interface{}{"running": true}))
+
+	executeScaleHook(t, c, "virtualmachine", hooks.ScaleDown)
+
+	vm := getUnstructured(t, c, virtualMachineGVK, "test-vm")
+	running, _, _ := unstructured.NestedBool(vm.Object, "spec", "running")
+	assert.False(t, running)
+
+	executeScaleHook(t, c, "virtualmachine", hooks.ScaleUp)
+
+	vm = getUnstructured(t, c, virtualMachineGVK, "test-vm")
+	running, _, _ = unstructured.NestedBool(vm.Object, "spec", "running")
+	assert.True(t, running)
+	assert.Empty(t, vm.GetAnnotations())
+}
@@ -58,6 +58,8 @@ type RecoverSpec struct {
 	//+optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 	//+optional
+	StorageClassMapping map[string]string `json:"storageClassMapping,omitempty"`
+	//+optional
 	RestoreStatus *velero.RestoreStatusSpec `json:"restoreStatus,omitempty"`
 	//+optional
 	ExistingResourcePolicy velero.PolicyType `json:"existingResourcePolicy,omitempty"`
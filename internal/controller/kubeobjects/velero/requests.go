@@ -7,6 +7,7 @@
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=create;delete;deletecollection;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=create;delete;deletecollection;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=velero.io,resources=restores/status,verbs=get
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;delete;deletecollection
 
 package velero
 
@@ -14,6 +15,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/go-logr/logr"
 	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -22,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	"github.com/ramendr/ramen/internal/controller/kubeobjects"
 	"github.com/ramendr/ramen/internal/controller/util"
@@ -168,6 +172,13 @@ func (r RequestsManager) RecoverRequestsDelete(
 		return fmt.Errorf("restore requests delete: %w", err)
 	}
 
+	if err := writer.DeleteAllOf(ctx, &corev1.ConfigMap{},
+		client.InNamespace(requestNamespaceName),
+		client.MatchingLabels(labels),
+	); err != nil {
+		return fmt.Errorf("restore resource modifiers delete: %w", err)
+	}
+
 	return r.ProtectRequestsDelete(ctx, writer, requestNamespaceName, labels)
 }
 
@@ -307,6 +318,24 @@ func backupRestore(
 	labels map[string]string,
 ) (*velero.Restore, error) {
 	restore := restore(backup.Namespace, restoreName, recoverSpec, backup.Name, labels)
+
+	if len(recoverSpec.StorageClassMapping) > 0 {
+		resourceModifiers, err := storageClassResourceModifiers(backup.Namespace, restoreName,
+			recoverSpec.StorageClassMapping, labels)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := w.objectCreate(resourceModifiers); err != nil {
+			return nil, err
+		}
+
+		restore.Spec.ResourceModifier = &corev1.TypedLocalObjectReference{
+			Kind: resourceModifiersKind,
+			Name: resourceModifiers.Name,
+		}
+	}
+
 	if err := w.objectCreate(restore); err != nil {
 		return nil, err
 	}
@@ -314,6 +343,74 @@ func backupRestore(
 	return restore, nil
 }
 
+// resourceModifiersKind is the kind of the reference of a restore to its resource modifiers
+const resourceModifiersKind = "configmap"
+
+// resourceModifiers is the format of the resource modifier rules of a restore, as parsed by velero
+type resourceModifiers struct {
+	Version               string                 `json:"version"`
+	ResourceModifierRules []resourceModifierRule `json:"resourceModifierRules"`
+}
+
+type resourceModifierRule struct {
+	Conditions resourceModifierConditions `json:"conditions"`
+	Patches    []resourceModifierPatch    `json:"patches"`
+}
+
+type resourceModifierConditions struct {
+	GroupResource string                  `json:"groupResource"`
+	Matches       []resourceModifierMatch `json:"matches,omitempty"`
+}
+
+type resourceModifierMatch struct {
+	Path  string `json:"path"`
+	Value string `json:"value"`
+}
+
+type resourceModifierPatch struct {
+	Operation string `json:"operation"`
+	Path      string `json:"path"`
+	Value     string `json:"value"`
+}
+
+// storageClassResourceModifiers returns a ConfigMap of the resource modifier rules of a restore
+// that replace the StorageClass of each restored PV and PVC of a peer cluster with the mapped one
+func storageClassResourceModifiers(
+	namespaceName, name string,
+	storageClassMapping map[string]string,
+	labels map[string]string,
+) (*corev1.ConfigMap, error) {
+	modifiers := resourceModifiers{Version: "v1"}
+
+	for _, groupResource := range []string{"persistentvolumeclaims", "persistentvolumes"} {
+		for _, from := range slices.Sorted(maps.Keys(storageClassMapping)) {
+			to := storageClassMapping[from]
+			modifiers.ResourceModifierRules = append(modifiers.ResourceModifierRules, resourceModifierRule{
+				Conditions: resourceModifierConditions{
+					GroupResource: groupResource,
+					Matches:       []resourceModifierMatch{{Path: "/spec/storageClassName", Value: from}},
+				},
+				Patches: []resourceModifierPatch{{Operation: "replace", Path: "/spec/storageClassName", Value: to}},
+			})
+		}
+	}
+
+	data, err := yaml.Marshal(modifiers)
+	if err != nil {
+		return nil, fmt.Errorf("resource modifiers marshal: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespaceName,
+			Name:      name,
+			Labels:    labels,
+		},
+		Data: map[string]string{"resource-modifiers.yaml": string(data)},
+	}, nil
+}
+
 func restoreStatusProcess(
 	restore *velero.Restore,
 	log logr.Logger,
@@ -572,6 +669,15 @@ func (w objectWriter) restoreObjectsDelete(
 		return err
 	}
 
+	if restore.Spec.ResourceModifier != nil {
+		if err := w.objectDelete(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Namespace: restore.Namespace,
+			Name:      restore.Spec.ResourceModifier.Name,
+		}}); err != nil {
+			return err
+		}
+	}
+
 	return w.backupObjectsDelete(backupLocation, backup)
 }
 
@@ -22,8 +22,12 @@ const (
 	LastSyncTimestampSeconds = "last_sync_timestamp_seconds"
 	LastSyncDurationSeconds  = "last_sync_duration_seconds"
 	LastSyncDataBytes        = "last_sync_data_bytes"
+	SyncProgressDataBytes    = "sync_progress_data_bytes"
 	WorkloadProtectionStatus = "workload_protection_status"
 	CGEnabled                = "unsupported_consistency_grouping_enabled"
+
+	SyncDeferredUntilTimestampSeconds       = "sync_deferred_until_timestamp_seconds"
+	SyncEstimatedCompletionTimestampSeconds = "sync_estimated_completion_timestamp_seconds"
 )
 
 type SyncTimeMetrics struct {
@@ -42,6 +46,15 @@ type SyncDataBytesMetrics struct {
 	LastSyncDataBytes prometheus.Gauge
 }
 
+type SyncProgressMetrics struct {
+	SyncProgressDataBytes   prometheus.Gauge
+	SyncEstimatedCompletion prometheus.Gauge
+}
+
+type SyncDeferredMetrics struct {
+	SyncDeferredUntil prometheus.Gauge
+}
+
 type WorkloadProtectionMetrics struct {
 	WorkloadProtectionStatus prometheus.Gauge
 }
@@ -53,6 +66,8 @@ type SyncMetrics struct {
 	SyncTimeMetrics
 	SyncDurationMetrics
 	SyncDataBytesMetrics
+	SyncProgressMetrics
+	SyncDeferredMetrics
 }
 
 const (
@@ -140,6 +155,24 @@ var (
 		syncDataBytesMetricLabels,
 	)
 
+	syncProgressDataBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      SyncProgressDataBytes,
+			Namespace: metricNamespace,
+			Help:      "Data transferred in bytes so far by the syncs in progress",
+		},
+		syncDataBytesMetricLabels,
+	)
+
+	syncEstimatedCompletion = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      SyncEstimatedCompletionTimestampSeconds,
+			Namespace: metricNamespace,
+			Help:      "Estimated time at which the syncs in progress complete",
+		},
+		syncDataBytesMetricLabels,
+	)
+
 	workloadProtectionStatus = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      WorkloadProtectionStatus,
@@ -149,6 +182,15 @@ var (
 		workloadProtectionStatusLabels,
 	)
 
+	syncDeferredUntil = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name:      SyncDeferredUntilTimestampSeconds,
+			Namespace: metricNamespace,
+			Help:      "Time at which the next sync window opens, while syncs are deferred to it",
+		},
+		syncTimeMetricLabelNames,
+	)
+
 	cgEnabled = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name:      CGEnabled,
@@ -235,6 +277,34 @@ func DeleteSyncDataBytesMetric(labels prometheus.Labels) bool {
 	return lastSyncDataBytes.Delete(labels)
 }
 
+// syncProgressDataBytes and syncEstimatedCompletion Metrics report values from groupSyncProgress
+// taken from DRPC status, with the labels of the lastSyncDataBytes metric
+func NewSyncProgressMetric(labels prometheus.Labels) SyncProgressMetrics {
+	return SyncProgressMetrics{
+		SyncProgressDataBytes:   syncProgressDataBytes.With(labels),
+		SyncEstimatedCompletion: syncEstimatedCompletion.With(labels),
+	}
+}
+
+func DeleteSyncProgressMetric(labels prometheus.Labels) bool {
+	deletedBytes := syncProgressDataBytes.Delete(labels)
+	deletedCompletion := syncEstimatedCompletion.Delete(labels)
+
+	return deletedBytes && deletedCompletion
+}
+
+// syncDeferredUntil Metric reports value from syncDeferredUntil taken from DRPC status, with the
+// labels of the lastSyncTime metric
+func NewSyncDeferredMetric(labels prometheus.Labels) SyncDeferredMetrics {
+	return SyncDeferredMetrics{
+		SyncDeferredUntil: syncDeferredUntil.With(labels),
+	}
+}
+
+func DeleteSyncDeferredMetric(labels prometheus.Labels) bool {
+	return syncDeferredUntil.Delete(labels)
+}
+
 // workloadProtectionStatus Metric reports information regarding workload protection condition from DRPC
 func WorkloadProtectionStatusLabels(drpc *rmn.DRPlacementControl) prometheus.Labels {
 	return prometheus.Labels{
@@ -279,6 +349,9 @@ func init() {
 	metrics.Registry.MustRegister(lastSyncTime)
 	metrics.Registry.MustRegister(lastSyncDuration)
 	metrics.Registry.MustRegister(lastSyncDataBytes)
+	metrics.Registry.MustRegister(syncProgressDataBytes)
+	metrics.Registry.MustRegister(syncEstimatedCompletion)
+	metrics.Registry.MustRegister(syncDeferredUntil)
 	metrics.Registry.MustRegister(workloadProtectionStatus)
 	metrics.Registry.MustRegister(cgEnabled)
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -18,6 +19,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
 )
 
 const (
@@ -41,6 +43,7 @@ const (
 	DefaultCephFSCSIDriverName                        = "openshift-storage.cephfs.csi.ceph.com"
 	VeleroNamespaceNameDefault                        = "velero"
 	DefaultVolSyncCopyMethod                          = "Snapshot"
+	defaultS3UploadConcurrency                        = 10
 )
 
 // FIXME
@@ -338,3 +341,31 @@ func volSyncDestinationCopyMethodOrDefault(ramenConfig *ramendrv1alpha1.RamenCon
 
 	return ramenConfig.VolSync.DestinationCopyMethod
 }
+
+func volSyncPSKRotationIntervalOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) time.Duration {
+	if ramenConfig.VolSync.PSKRotationDisabled {
+		return 0
+	}
+
+	if ramenConfig.VolSync.PSKRotationInterval.Duration <= 0 {
+		return volsync.DefaultPSKRotationInterval
+	}
+
+	return ramenConfig.VolSync.PSKRotationInterval.Duration
+}
+
+func volSyncVerificationImageOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) string {
+	if ramenConfig.VolSync.VerificationImage == "" {
+		return volsync.DefaultVerificationImage
+	}
+
+	return ramenConfig.VolSync.VerificationImage
+}
+
+func s3UploadConcurrencyOrDefault(ramenConfig *ramendrv1alpha1.RamenConfig) int {
+	if ramenConfig.S3UploadConcurrency <= 0 {
+		return defaultS3UploadConcurrency
+	}
+
+	return ramenConfig.S3UploadConcurrency
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// RecipeValidator is a validating admission webhook for Recipes. It rejects recipes that
+// would fail the checks run when a VRG captures or recovers using them.
+type RecipeValidator struct {
+	APIReader client.Reader
+	Log       logr.Logger
+}
+
+var _ admission.CustomValidator = &RecipeValidator{}
+
+//nolint:lll
+// +kubebuilder:webhook:path=/validate-ramendr-openshift-io-v1alpha1-recipe,mutating=false,failurePolicy=fail,sideEffects=None,groups=ramendr.openshift.io,resources=recipes,verbs=create;update,versions=v1alpha1,name=vrecipe.ramendr.openshift.io,admissionReviewVersions=v1
+
+func (r *RecipeValidator) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&recipev1.Recipe{}).
+		WithValidator(r).
+		Complete()
+}
+
+func (r *RecipeValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return r.validate(ctx, obj)
+}
+
+func (r *RecipeValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return r.validate(ctx, newObj)
+}
+
+func (r *RecipeValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate validates the recipe with the parameters of every VRG that refers to it, or
+// without parameters if no VRG refers to it yet.
+func (r *RecipeValidator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	recipe, ok := obj.(*recipev1.Recipe)
+	if !ok {
+		return nil, fmt.Errorf("expected a Recipe but got a %T", obj)
+	}
+
+	log := r.Log.WithValues("recipe", client.ObjectKeyFromObject(recipe).String())
+
+	_, ramenConfig, err := ConfigMapGet(ctx, r.APIReader)
+	if err != nil {
+		return nil, fmt.Errorf("config map get error: %w", err)
+	}
+
+	vrgList := ramen.VolumeReplicationGroupList{}
+	if err := r.APIReader.List(ctx, &vrgList); err != nil {
+		return nil, fmt.Errorf("vrg list retrieval error: %w", err)
+	}
+
+	var warnings admission.Warnings
+
+	errs := []error{}
+	vrgCount := 0
+
+	for i := range vrgList.Items {
+		vrg := &vrgList.Items[i]
+		if !vrgRefersToRecipe(vrg, recipe) {
+			continue
+		}
+
+		vrgCount++
+
+		vrgWarnings, err := RecipeValidate(ctx, *recipe, vrg.Spec.KubeObjectProtection.RecipeParameters,
+			*ramenConfig, log)
+		for _, warning := range vrgWarnings {
+			warnings = append(warnings, fmt.Sprintf("vrg %s/%s: %s", vrg.Namespace, vrg.Name, warning))
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("recipe is invalid for vrg %s/%s: %w", vrg.Namespace, vrg.Name, err))
+		}
+	}
+
+	if vrgCount == 0 {
+		recipeWarnings, err := RecipeValidate(ctx, *recipe, nil, *ramenConfig, log)
+
+		return recipeWarnings, err
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+func vrgRefersToRecipe(vrg *ramen.VolumeReplicationGroup, recipe client.Object) bool {
+	return vrg.Spec.KubeObjectProtection != nil &&
+		vrg.Spec.KubeObjectProtection.RecipeRef != nil &&
+		vrg.Spec.KubeObjectProtection.RecipeRef.Namespace == recipe.GetNamespace() &&
+		vrg.Spec.KubeObjectProtection.RecipeRef.Name == recipe.GetName()
+}
@@ -0,0 +1,401 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package recipegen generates a starter Recipe from the workload running in a set of
+// namespaces. The generated recipe is meant to be reviewed and refined by the application
+// owner before it is used to protect the application.
+package recipegen
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+const (
+	recipeKind = "Recipe"
+
+	backupWorkflowName  = "backup"
+	restoreWorkflowName = "restore"
+
+	resourceGroupType = "resource"
+	volumeGroupType   = "volume"
+	scaleHookType     = "scale"
+
+	crdsGroupName        = "crds"
+	crdsRestoreGroupName = "crds-restore"
+	configGroupName      = "config"
+	workloadsGroupName   = "workloads"
+	volumesGroupName     = "volumes"
+
+	// The operations of a scale hook are implied by the hook type, workflow steps refer
+	// to them as <hook>/<operation>.
+	scaleUp   = "up"
+	scaleDown = "down"
+	scaleSync = "sync"
+
+	// defaultHookTimeout is the timeout in seconds for the workload to scale.
+	defaultHookTimeout = 300
+)
+
+// configResourceTypes are restored before the workloads that consume them.
+var configResourceTypes = []string{"secrets", "configmaps"}
+
+// workloadExcludedResourceTypes are either captured by other groups, protected as volumes,
+// or recreated by their owners on restore.
+var workloadExcludedResourceTypes = []string{
+	"events",
+	"events.events.k8s.io",
+	"persistentvolumes",
+	"persistentvolumeclaims",
+	"pods",
+	"replicasets",
+	"secrets",
+	"configmaps",
+}
+
+// Options selects the workload to generate a recipe for.
+type Options struct {
+	// Name and Namespace of the generated recipe.
+	Name      string
+	Namespace string
+
+	// Namespaces to inspect. Defaults to the recipe namespace.
+	Namespaces []string
+
+	// ScaleDownOnCapture scales the workload down while kube objects are captured, so that
+	// the captured objects are quiesced, and scales it back up from the captured replica
+	// count on restore. By default the workload is left running during capture and the
+	// restore only waits for it to become ready.
+	ScaleDownOnCapture bool
+}
+
+// VRGNamespaces returns the namespaces protected by a VRG: its protected namespaces if
+// set, otherwise its own namespace.
+func VRGNamespaces(vrg *ramen.VolumeReplicationGroup) []string {
+	if vrg.Spec.ProtectedNamespaces != nil && len(*vrg.Spec.ProtectedNamespaces) > 0 {
+		return slices.Clone(*vrg.Spec.ProtectedNamespaces)
+	}
+
+	return []string{vrg.Namespace}
+}
+
+// workload is what was found in the inspected namespaces.
+type workload struct {
+	pvcs         []corev1.PersistentVolumeClaim
+	deployments  map[string][]string // namespace to names
+	statefulSets map[string][]string // namespace to names
+	customTypes  []string            // plural.group of custom resources in use
+}
+
+// Generate inspects the namespaces and returns a recipe that captures and restores their
+// objects in dependency order: custom resource definitions, then secrets and config maps,
+// then the rest of the workload. PVCs are protected as the recipe volumes, and Deployments
+// and StatefulSets are scaled by scale hooks.
+func Generate(ctx context.Context, reader client.Reader, options Options) (*recipev1.Recipe, error) {
+	namespaces := options.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{options.Namespace}
+	}
+
+	namespaces = slices.Sorted(slices.Values(namespaces))
+	namespaces = slices.Compact(namespaces)
+
+	w, err := inspect(ctx, reader, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	hooks := scaleHooks(w)
+
+	return &recipev1.Recipe{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       recipeKind,
+			APIVersion: recipev1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      options.Name,
+			Namespace: options.Namespace,
+		},
+		Spec: recipev1.RecipeSpec{
+			Groups:    groups(w, namespaces),
+			Volumes:   volumes(w.pvcs),
+			Hooks:     hooks,
+			Workflows: workflows(w, hooks, options.ScaleDownOnCapture),
+		},
+	}, nil
+}
+
+func inspect(ctx context.Context, reader client.Reader, namespaces []string) (*workload, error) {
+	w := &workload{
+		deployments:  map[string][]string{},
+		statefulSets: map[string][]string{},
+	}
+
+	for _, namespace := range namespaces {
+		pvcList := corev1.PersistentVolumeClaimList{}
+		if err := reader.List(ctx, &pvcList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list PVCs in namespace %s: %w", namespace, err)
+		}
+
+		w.pvcs = append(w.pvcs, pvcList.Items...)
+
+		deploymentList := appsv1.DeploymentList{}
+		if err := reader.List(ctx, &deploymentList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list deployments in namespace %s: %w", namespace, err)
+		}
+
+		for i := range deploymentList.Items {
+			w.deployments[namespace] = append(w.deployments[namespace], deploymentList.Items[i].Name)
+		}
+
+		statefulSetList := appsv1.StatefulSetList{}
+		if err := reader.List(ctx, &statefulSetList, client.InNamespace(namespace)); err != nil {
+			return nil, fmt.Errorf("failed to list statefulsets in namespace %s: %w", namespace, err)
+		}
+
+		for i := range statefulSetList.Items {
+			w.statefulSets[namespace] = append(w.statefulSets[namespace], statefulSetList.Items[i].Name)
+		}
+	}
+
+	customTypes, err := customResourceTypesInUse(ctx, reader, namespaces)
+	if err != nil {
+		return nil, err
+	}
+
+	w.customTypes = customTypes
+
+	return w, nil
+}
+
+// customResourceTypesInUse returns the namespaced custom resource types that have at least
+// one object in the namespaces.
+func customResourceTypesInUse(ctx context.Context, reader client.Reader, namespaces []string,
+) ([]string, error) {
+	crdList := apiextensionsv1.CustomResourceDefinitionList{}
+	if err := reader.List(ctx, &crdList); err != nil {
+		return nil, fmt.Errorf("failed to list custom resource definitions: %w", err)
+	}
+
+	customTypes := []string{}
+
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		if crd.Spec.Scope != apiextensionsv1.NamespaceScoped {
+			continue
+		}
+
+		version := storageVersion(crd)
+		if version == "" {
+			continue
+		}
+
+		gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: version, Kind: crd.Spec.Names.ListKind}
+
+		for _, namespace := range namespaces {
+			list := unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk)
+
+			if err := reader.List(ctx, &list, client.InNamespace(namespace), client.Limit(1)); err != nil {
+				return nil, fmt.Errorf("failed to list %s in namespace %s: %w", crd.Name, namespace, err)
+			}
+
+			if len(list.Items) > 0 {
+				customTypes = append(customTypes, crd.Name)
+
+				break
+			}
+		}
+	}
+
+	slices.Sort(customTypes)
+
+	return customTypes, nil
+}
+
+func storageVersion(crd *apiextensionsv1.CustomResourceDefinition) string {
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			return version.Name
+		}
+	}
+
+	return ""
+}
+
+func groups(w *workload, namespaces []string) []*recipev1.Group {
+	groups := []*recipev1.Group{}
+
+	if len(w.customTypes) > 0 {
+		includeClusterResources := true
+
+		// The custom resources in use are captured with the definitions of their kinds,
+		// which are included as cluster resources associated with them. Only the
+		// definitions are restored from that capture, the custom resources are restored
+		// with the workloads.
+		groups = append(groups,
+			&recipev1.Group{
+				Name:                  crdsGroupName,
+				Type:                  resourceGroupType,
+				IncludedNamespaces:    namespaces,
+				IncludedResourceTypes: w.customTypes,
+			},
+			&recipev1.Group{
+				Name:                    crdsRestoreGroupName,
+				BackupRef:               crdsGroupName,
+				Type:                    resourceGroupType,
+				IncludedResourceTypes:   []string{"customresourcedefinitions"},
+				IncludeClusterResources: &includeClusterResources,
+			},
+		)
+	}
+
+	return append(groups,
+		&recipev1.Group{
+			Name:                  configGroupName,
+			Type:                  resourceGroupType,
+			IncludedNamespaces:    namespaces,
+			IncludedResourceTypes: configResourceTypes,
+		},
+		&recipev1.Group{
+			Name:                  workloadsGroupName,
+			Type:                  resourceGroupType,
+			IncludedNamespaces:    namespaces,
+			ExcludedResourceTypes: workloadExcludedResourceTypes,
+		},
+	)
+}
+
+// volumes returns the volume group selecting the PVCs by the labels they all share, or all
+// the PVCs in their namespaces if they share none. It returns nil if there are no PVCs, so
+// that the VRG PVC selector is used.
+func volumes(pvcs []corev1.PersistentVolumeClaim) *recipev1.Group {
+	if len(pvcs) == 0 {
+		return nil
+	}
+
+	namespaces := []string{}
+	commonLabels := maps.Clone(pvcs[0].Labels)
+
+	for i := range pvcs {
+		namespaces = append(namespaces, pvcs[i].Namespace)
+
+		maps.DeleteFunc(commonLabels, func(key, value string) bool {
+			return pvcs[i].Labels[key] != value
+		})
+	}
+
+	namespaces = slices.Compact(slices.Sorted(slices.Values(namespaces)))
+
+	var matchLabels map[string]string
+	if len(commonLabels) > 0 {
+		matchLabels = commonLabels
+	}
+
+	return &recipev1.Group{
+		Name:               volumesGroupName,
+		Type:               volumeGroupType,
+		IncludedNamespaces: namespaces,
+		LabelSelector:      &metav1.LabelSelector{MatchLabels: matchLabels},
+	}
+}
+
+// scaleHooks returns a scale hook per namespace and kind. StatefulSets are listed before
+// Deployments so that stateful services are scaled up before their clients.
+func scaleHooks(w *workload) []*recipev1.Hook {
+	hooks := []*recipev1.Hook{}
+
+	for _, namespace := range slices.Sorted(maps.Keys(w.statefulSets)) {
+		hooks = append(hooks, scaleHook(namespace, "statefulset", w.statefulSets[namespace]))
+	}
+
+	for _, namespace := range slices.Sorted(maps.Keys(w.deployments)) {
+		hooks = append(hooks, scaleHook(namespace, "deployment", w.deployments[namespace]))
+	}
+
+	return hooks
+}
+
+func scaleHook(namespace, selectResource string, names []string) *recipev1.Hook {
+	return &recipev1.Hook{
+		Name:           fmt.Sprintf("%s-%ss", namespace, selectResource),
+		Namespace:      namespace,
+		Type:           scaleHookType,
+		SelectResource: selectResource,
+		NameSelector:   nameSelector(names),
+		Timeout:        defaultHookTimeout,
+	}
+}
+
+// nameSelector returns the name if there is only one, or a regex matching exactly the names.
+func nameSelector(names []string) string {
+	names = slices.Sorted(slices.Values(names))
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+
+	return "^(" + strings.Join(quoted, "|") + ")$"
+}
+
+func workflows(w *workload, hooks []*recipev1.Hook, scaleDownOnCapture bool) []*recipev1.Workflow {
+	backupGroupSteps := []map[string]string{}
+	restoreGroupSteps := []map[string]string{}
+
+	if len(w.customTypes) > 0 {
+		backupGroupSteps = append(backupGroupSteps, map[string]string{"group": crdsGroupName})
+		restoreGroupSteps = append(restoreGroupSteps, map[string]string{"group": crdsRestoreGroupName})
+	}
+
+	for _, group := range []string{configGroupName, workloadsGroupName} {
+		backupGroupSteps = append(backupGroupSteps, map[string]string{"group": group})
+		restoreGroupSteps = append(restoreGroupSteps, map[string]string{"group": group})
+	}
+
+	backup := &recipev1.Workflow{Name: backupWorkflowName}
+	restore := &recipev1.Workflow{Name: restoreWorkflowName}
+
+	if scaleDownOnCapture {
+		// Scale down clients before the stateful services they use.
+		for i := len(hooks) - 1; i >= 0; i-- {
+			backup.Sequence = append(backup.Sequence, hookStep(hooks[i], scaleDown))
+		}
+	}
+
+	backup.Sequence = append(backup.Sequence, backupGroupSteps...)
+	restore.Sequence = append(restore.Sequence, restoreGroupSteps...)
+
+	for _, hook := range hooks {
+		if scaleDownOnCapture {
+			backup.Sequence = append(backup.Sequence, hookStep(hook, scaleUp))
+			restore.Sequence = append(restore.Sequence, hookStep(hook, scaleUp))
+		}
+
+		restore.Sequence = append(restore.Sequence, hookStep(hook, scaleSync))
+	}
+
+	return []*recipev1.Workflow{backup, restore}
+}
+
+func hookStep(hook *recipev1.Hook, operation string) map[string]string {
+	return map[string]string{"hook": hook.Name + "/" + operation}
+}
@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package recipegen_test
+
+import (
+	"context"
+	"testing"
+
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/recipegen"
+)
+
+var widgetGVK = schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Widget"}
+
+func setupFakeClient(t *testing.T, objects ...client.Object) client.Client {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, clientgoscheme.AddToScheme(scheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(scheme))
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for gvk := range scheme.AllKnownTypes() {
+		mapper.Add(gvk, meta.RESTScopeNamespace)
+	}
+
+	mapper.Add(widgetGVK, meta.RESTScopeNamespace)
+	mapper.Add(widgetGVK.GroupVersion().WithKind("WidgetList"), meta.RESTScopeNamespace)
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithRESTMapper(mapper).WithObjects(objects...).Build()
+}
+
+func newPVC(namespace, name string, labels map[string]string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels},
+	}
+}
+
+func newWidgetCRD(scope apiextensionsv1.ResourceScope) *apiextensionsv1.CustomResourceDefinition {
+	return &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "widgets.example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: widgetGVK.Group,
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:   "widgets",
+				Kind:     widgetGVK.Kind,
+				ListKind: "WidgetList",
+			},
+			Scope:    scope,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1", Served: true, Storage: true}},
+		},
+	}
+}
+
+func newWidget(namespace, name string) *unstructured.Unstructured {
+	widget := &unstructured.Unstructured{}
+	widget.SetGroupVersionKind(widgetGVK)
+	widget.SetNamespace(namespace)
+	widget.SetName(name)
+
+	return widget
+}
+
+func sequence(workflow *recipev1.Workflow) []string {
+	steps := []string{}
+
+	for _, step := range workflow.Sequence {
+		for kind, name := range step {
+			steps = append(steps, kind+":"+name)
+		}
+	}
+
+	return steps
+}
+
+func TestGenerateWorkload(t *testing.T) {
+	fakeClient := setupFakeClient(t,
+		newPVC("app", "data-db-0", map[string]string{"app": "shop", "tier": "db"}),
+		newPVC("app", "uploads", map[string]string{"app": "shop", "tier": "web"}),
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "web"}},
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "worker"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "db"}},
+		newWidgetCRD(apiextensionsv1.NamespaceScoped),
+		newWidget("app", "widget1"),
+	)
+
+	recipe, err := recipegen.Generate(context.TODO(), fakeClient, recipegen.Options{
+		Name:      "shop",
+		Namespace: "app",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "Recipe", recipe.Kind)
+	assert.Equal(t, "app", recipe.Namespace)
+	assert.Equal(t, "shop", recipe.Name)
+
+	groupNames := []string{}
+	for _, group := range recipe.Spec.Groups {
+		groupNames = append(groupNames, group.Name)
+	}
+
+	assert.Equal(t, []string{"crds", "crds-restore", "config", "workloads"}, groupNames)
+	assert.Equal(t, []string{"app"}, recipe.Spec.Groups[0].IncludedNamespaces)
+	assert.Equal(t, []string{"widgets.example.com"}, recipe.Spec.Groups[0].IncludedResourceTypes)
+	assert.Nil(t, recipe.Spec.Groups[0].IncludeClusterResources)
+	assert.Equal(t, "crds", recipe.Spec.Groups[1].BackupRef)
+	assert.Equal(t, []string{"customresourcedefinitions"}, recipe.Spec.Groups[1].IncludedResourceTypes)
+	assert.Equal(t, []string{"secrets", "configmaps"}, recipe.Spec.Groups[2].IncludedResourceTypes)
+	assert.Contains(t, recipe.Spec.Groups[3].ExcludedResourceTypes, "persistentvolumeclaims")
+	assert.Contains(t, recipe.Spec.Groups[3].ExcludedResourceTypes, "events.events.k8s.io")
+
+	require.NotNil(t, recipe.Spec.Volumes)
+	assert.Equal(t, "volume", recipe.Spec.Volumes.Type)
+	assert.Equal(t, []string{"app"}, recipe.Spec.Volumes.IncludedNamespaces)
+	assert.Equal(t, map[string]string{"app": "shop"}, recipe.Spec.Volumes.LabelSelector.MatchLabels)
+
+	require.Len(t, recipe.Spec.Hooks, 2)
+	assert.Equal(t, "app-statefulsets", recipe.Spec.Hooks[0].Name)
+	assert.Equal(t, "statefulset", recipe.Spec.Hooks[0].SelectResource)
+	assert.Equal(t, "db", recipe.Spec.Hooks[0].NameSelector)
+	assert.Equal(t, "app-deployments", recipe.Spec.Hooks[1].Name)
+	assert.Equal(t, "^(web|worker)$", recipe.Spec.Hooks[1].NameSelector)
+
+	require.Len(t, recipe.Spec.Workflows, 2)
+	assert.Equal(t, []string{"group:crds", "group:config", "group:workloads"},
+		sequence(recipe.Spec.Workflows[0]))
+	assert.Equal(t, []string{
+		"group:crds-restore", "group:config", "group:workloads",
+		"hook:app-statefulsets/sync", "hook:app-deployments/sync",
+	}, sequence(recipe.Spec.Workflows[1]))
+}
+
+func TestGenerateScaleDownOnCapture(t *testing.T) {
+	fakeClient := setupFakeClient(t,
+		&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "web"}},
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "db"}},
+	)
+
+	recipe, err := recipegen.Generate(context.TODO(), fakeClient, recipegen.Options{
+		Name:               "shop",
+		Namespace:          "app",
+		ScaleDownOnCapture: true,
+	})
+	require.NoError(t, err)
+
+	assert.Nil(t, recipe.Spec.Volumes)
+	assert.Equal(t, []string{
+		"hook:app-deployments/down", "hook:app-statefulsets/down",
+		"group:config", "group:workloads",
+		"hook:app-statefulsets/up", "hook:app-deployments/up",
+	}, sequence(recipe.Spec.Workflows[0]))
+	assert.Equal(t, []string{
+		"group:config", "group:workloads",
+		"hook:app-statefulsets/up", "hook:app-statefulsets/sync",
+		"hook:app-deployments/up", "hook:app-deployments/sync",
+	}, sequence(recipe.Spec.Workflows[1]))
+}
+
+func TestGenerateSkipsUnusedAndClusterScopedCRDs(t *testing.T) {
+	clusterScoped := newWidgetCRD(apiextensionsv1.ClusterScoped)
+
+	fakeClient := setupFakeClient(t,
+		clusterScoped,
+		newPVC("app1", "data", nil),
+		newPVC("app2", "data", map[string]string{"app": "shop"}),
+	)
+
+	recipe, err := recipegen.Generate(context.TODO(), fakeClient, recipegen.Options{
+		Name:       "shop",
+		Namespace:  "ramen-ops",
+		Namespaces: []string{"app2", "app1", "app2"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "config", recipe.Spec.Groups[0].Name)
+	assert.Equal(t, []string{"app1", "app2"}, recipe.Spec.Groups[0].IncludedNamespaces)
+	assert.Empty(t, recipe.Spec.Hooks)
+
+	require.NotNil(t, recipe.Spec.Volumes)
+	assert.Equal(t, []string{"app1", "app2"}, recipe.Spec.Volumes.IncludedNamespaces)
+	assert.Empty(t, recipe.Spec.Volumes.LabelSelector.MatchLabels)
+}
+
+func TestVRGNamespaces(t *testing.T) {
+	vrg := &ramen.VolumeReplicationGroup{ObjectMeta: metav1.ObjectMeta{Namespace: "ramen-ops", Name: "vrg"}}
+	assert.Equal(t, []string{"ramen-ops"}, recipegen.VRGNamespaces(vrg))
+
+	vrg.Spec.ProtectedNamespaces = &[]string{"app1", "app2"}
+	assert.Equal(t, []string{"app1", "app2"}, recipegen.VRGNamespaces(vrg))
+}
@@ -22,9 +22,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 // ReplicationGroupDestinationReconciler reconciles a ReplicationGroupDestination object
@@ -84,7 +84,7 @@ func (r *ReplicationGroupDestinationReconciler) Reconcile(ctx context.Context, r
 	logger.Info("Run ReplicationGroupDestination state machine", "DefaultCephFSCSIDriverName", defaultCephFSCSIDriverName)
 	result, err := statemachine.Run(
 		ctx,
-		cephfscg.NewRGDMachine(r.Client, rgd,
+		volsynccg.NewRGDMachine(r.Client, rgd,
 			volsync.NewVSHandler(ctx, r.Client, logger, vrg,
 				&ramendrv1alpha1.VRGAsyncSpec{
 					VolumeSnapshotClassSelector: rgd.Spec.VolumeSnapshotClassSelector,
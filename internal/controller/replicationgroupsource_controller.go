@@ -21,9 +21,9 @@ import (
 	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 /*
@@ -42,8 +42,8 @@ ReplicationGroupSource create VolumeGroupSnapshot, Restored PVC and ReplicationS
 At the end of each sync, VolumeGroupSnapshot, Restored PVC will be deleted by ramen,
 ReplicationSource will not be deleted.
 
-2. VolumeGroupSnapshot Name = cephfscg-<ReplicationGroupSource Name>
-3. Restored PVC Name = cephfscg-<Application PVC Name>
+2. VolumeGroupSnapshot Name = <ReplicationGroupSource Name>
+3. Restored PVC Name = vs-<Application PVC Name>
 4. ReplicationSource Name = ReplicationDestination Name = <Application PVC Name>
 
 5. ReplicationDestinationServiceName = volsync-rsync-tls-dst-<Application PVC Name>.<RD Namespace>.svc.clusterset.local
@@ -103,9 +103,9 @@ func (r *ReplicationGroupSourceReconciler) Reconcile(ctx context.Context, req ct
 
 	defaultCephFSCSIDriverName := cephFSCSIDriverNameOrDefault(ramenConfig)
 
-	vgsHandler := cephfscg.NewVolumeGroupSourceHandler(r.Client, rgs, defaultCephFSCSIDriverName, logger)
+	vgsHandler := volsynccg.NewVolumeGroupSourceHandler(r.Client, rgs, defaultCephFSCSIDriverName, logger)
 
-	if cephfscg.IsPrepareForFinalSyncTriggered(rgs) {
+	if volsynccg.IsPrepareForFinalSyncTriggered(rgs) {
 		logger.Info("Detected request for final sync preparation, waiting for confirmation to continue")
 
 		err := vgsHandler.CleanVolumeGroupSnapshot(ctx)
@@ -130,7 +130,7 @@ func (r *ReplicationGroupSourceReconciler) Reconcile(ctx context.Context, req ct
 	logger.Info("Run ReplicationGroupSource state machine", "DefaultCephFSCSIDriverName", defaultCephFSCSIDriverName)
 	result, err := statemachine.Run(
 		ctx,
-		cephfscg.NewRGSMachine(r.Client, rgs, vrg,
+		volsynccg.NewRGSMachine(r.Client, rgs, vrg,
 			volsync.NewVSHandler(ctx, r.Client, logger, vrg,
 				&ramendrv1alpha1.VRGAsyncSpec{}, defaultCephFSCSIDriverName,
 				volSyncDestinationCopyMethodOrDefault(ramenConfig), adminNamespaceVRG,
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -481,23 +483,23 @@ func downloadVGRs(s ObjectStorer, vgrKeyPrefix string) (
 }
 
 // downloadPVs downloads all PVs in the bucket.
-// - Downloads PVs with the given key prefix.
+// - Downloads PVs with the given key prefix, with at most concurrency downloads in flight at a time.
 // - If bucket doesn't exists, will return ErrCodeNoSuchBucket "NoSuchBucket"
-func downloadPVs(s ObjectStorer, pvKeyPrefix string) (
+func downloadPVs(s ObjectStorer, pvKeyPrefix string, concurrency int) (
 	pvList []corev1.PersistentVolume, err error,
 ) {
-	err = DownloadTypedObjects(s, pvKeyPrefix, &pvList)
+	err = DownloadTypedObjectsConcurrently(s, pvKeyPrefix, &pvList, concurrency)
 
 	return
 }
 
 // downloadPVCs downloads all PVCs in the bucket.
-// - Downloads PVCs with the given key prefix.
+// - Downloads PVCs with the given key prefix, with at most concurrency downloads in flight at a time.
 // - If bucket doesn't exists, will return ErrCodeNoSuchBucket "NoSuchBucket"
-func downloadPVCs(s ObjectStorer, pvcKeyPrefix string) (
+func downloadPVCs(s ObjectStorer, pvcKeyPrefix string, concurrency int) (
 	pvcList []corev1.PersistentVolumeClaim, err error,
 ) {
-	err = DownloadTypedObjects(s, pvcKeyPrefix, &pvcList)
+	err = DownloadTypedObjectsConcurrently(s, pvcKeyPrefix, &pvcList, concurrency)
 
 	return
 }
@@ -518,6 +520,14 @@ func DownloadVRGs(s ObjectStorer, pvKeyPrefix string) (
 //   - Objects being downloaded should meet the decoding expectations of
 //     the DownloadObject() method.
 func DownloadTypedObjects(s ObjectStorer, keyPrefix string, objectsPointer interface{},
+) error {
+	return DownloadTypedObjectsConcurrently(s, keyPrefix, objectsPointer, 1)
+}
+
+// DownloadTypedObjectsConcurrently downloads all objects of the given type like
+// DownloadTypedObjects(), with at most concurrency downloads in flight at a time.
+func DownloadTypedObjectsConcurrently(s ObjectStorer, keyPrefix string, objectsPointer interface{},
+	concurrency int,
 ) error {
 	objectsValue := reflect.ValueOf(objectsPointer).Elem()
 	objectType := objectsValue.Type().Elem()
@@ -532,9 +542,12 @@ func DownloadTypedObjects(s ObjectStorer, keyPrefix string, objectsPointer inter
 	objects := reflect.MakeSlice(reflect.SliceOf(objectType),
 		len(keys), len(keys))
 
-	for i := range keys {
-		objectReceiver := objects.Index(i).Addr().Interface()
-		if err := s.DownloadObject(keys[i], objectReceiver); err != nil {
+	errs := doConcurrently(len(keys), concurrency, func(i int) error {
+		return s.DownloadObject(keys[i], objects.Index(i).Addr().Interface())
+	})
+
+	for i, err := range errs {
+		if err != nil {
 			return fmt.Errorf("unable to DownloadObject of key %s, %w",
 				keys[i], err)
 		}
@@ -545,6 +558,89 @@ func DownloadTypedObjects(s ObjectStorer, keyPrefix string, objectsPointer inter
 	return nil
 }
 
+// doConcurrently calls do for each index below count, with at most concurrency calls in flight
+// at a time, and returns the errors of the calls by index.
+func doConcurrently(count, concurrency int, do func(int) error) []error {
+	errs := make([]error, count)
+	sem := make(chan struct{}, max(concurrency, 1))
+
+	var wg sync.WaitGroup
+
+	for i := range count {
+		sem <- struct{}{}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = do(i)
+		}()
+	}
+
+	wg.Wait()
+
+	return errs
+}
+
+// ClusterDataManifest lists the keys of the PV and PVC cluster data objects of a VRG in an S3 store,
+// which are uploaded before it. It lets their recovery detect an incomplete set of objects.
+type ClusterDataManifest struct {
+	PVKeys  []string `json:"pvKeys,omitempty"`
+	PVCKeys []string `json:"pvcKeys,omitempty"`
+}
+
+const clusterDataManifestKeySuffix = "clusterDataManifest"
+
+func clusterDataManifestKey(keyPrefix string) string {
+	return keyPrefix + clusterDataManifestKeySuffix
+}
+
+// uploadClusterDataManifest uploads the given manifest to the bucket with a key of
+// "<keyPrefix>clusterDataManifest".
+func uploadClusterDataManifest(s ObjectStorer, keyPrefix string, manifest ClusterDataManifest) error {
+	return s.UploadObject(clusterDataManifestKey(keyPrefix), manifest)
+}
+
+// downloadClusterDataManifest downloads the manifest with the given key prefix, or returns nil
+// if there is none, for example if it was uploaded by an earlier version.
+func downloadClusterDataManifest(s ObjectStorer, keyPrefix string) (*ClusterDataManifest, error) {
+	key := clusterDataManifestKey(keyPrefix)
+
+	keys, err := s.ListKeys(key)
+	if err != nil {
+		return nil, fmt.Errorf("unable to ListKeys of cluster data manifest %s, %w", key, err)
+	}
+
+	if !slices.Contains(keys, key) {
+		return nil, nil
+	}
+
+	manifest := &ClusterDataManifest{}
+	if err := s.DownloadObject(key, manifest); err != nil {
+		return nil, fmt.Errorf("unable to DownloadObject of key %s, %w", key, err)
+	}
+
+	return manifest, nil
+}
+
+// missingClusterDataKeys returns the keys listed in the keys of the manifest, that are not
+// among the keys of the downloaded objects.
+func missingClusterDataKeys(manifestKeys, keys []string) []string {
+	missingKeys := []string{}
+
+	for _, key := range manifestKeys {
+		if !slices.Contains(keys, key) {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+
+	return missingKeys
+}
+
 // ListKeys lists the keys (of objects) with the given keyPrefix in the bucket.
 // - If bucket doesn't exists, will return ErrCodeNoSuchBucket "NoSuchBucket"
 // - Refer to aws documentation of s3.ListObjectsV2Input for more list options
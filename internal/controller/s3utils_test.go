@@ -16,6 +16,8 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
@@ -216,4 +218,24 @@ var _ = Describe("FakeObjectStorer", func() {
 			Expect(objectStorer.DeleteObject(key2)).To(Succeed())
 		})
 	})
+	Context("DownloadTypedObjectsConcurrently", func() {
+		const keyPrefix = "concurrent/"
+		pvNames := []string{"pv0", "pv1", "pv2", "pv3", "pv4"}
+		BeforeEach(func() {
+			for _, pvName := range pvNames {
+				pv := corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: pvName}}
+				Expect(controllers.UploadPV(objectStorer, keyPrefix, pvName, pv)).To(Succeed())
+			}
+			DeferCleanup(objectStorer.DeleteObjectsWithKeyPrefix, keyPrefix)
+		})
+		It("should download all objects of the type with fewer downloads in flight than objects", func() {
+			var pvs []corev1.PersistentVolume
+			Expect(controllers.DownloadTypedObjectsConcurrently(objectStorer, keyPrefix, &pvs, 2)).To(Succeed())
+			pvNamesDownloaded := []string{}
+			for _, pv := range pvs {
+				pvNamesDownloaded = append(pvNamesDownloaded, pv.Name)
+			}
+			Expect(pvNamesDownloaded).To(ConsistOf(pvNames))
+		})
+	})
 })
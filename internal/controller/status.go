@@ -55,6 +55,25 @@ const (
 	// Indicates no conflict in PVC and Kubernetes resource data
 	// between primary and secondary clusters.
 	VRGConditionTypeNoClusterDataConflict = "NoClusterDataConflict"
+
+	// Indicates whether the VolSync destinations of the PVCs have the same data as their sources,
+	// when their verification is enabled.
+	VRGConditionTypeDataVerified = "DataVerified"
+
+	// Indicates whether the capacity of the volume of a PVC matches the capacity requested by it, such
+	// that the PVC is restored to a volume of the same capacity on failover. On a secondary, it indicates
+	// whether the destinations of the PVCs have the capacity requested on the primary. It is reported at
+	// individual PVCs, and aggregated at the VRG.
+	VRGConditionTypeCapacityMatched = "CapacityMatched"
+
+	// Indicates that the PVCs are protected without modifying them or their PVs, and the guarantees
+	// that are lost by it. It is only present in the protect only mode.
+	VRGConditionTypeProtectOnly = "ProtectOnly"
+
+	// Indicates whether the replication of the PVCs protected by VolumeReplication is degraded, or in
+	// split-brain, as reported by the storage driver, to tell broken replication from slow replication.
+	// It is only present when the state of the VolumeReplications is known.
+	VRGConditionTypeReplicationDegraded = "ReplicationDegraded"
 )
 
 // VRG condition reasons
@@ -83,6 +102,7 @@ const (
 	VRGConditionReasonClusterDataAnnotationFailed = "AnnotationFailed"
 	VRGConditionReasonPeerClassNotFound           = "PeerClassNotFound"
 	VRGConditionReasonStorageIDNotFound           = "StorageIDNotFound"
+	VRGConditionReasonRecipeParametersInvalid     = "RecipeParametersInvalid"
 	// Indicates a conflict in cluster data detected on the primary cluster.
 	VRGConditionReasonClusterDataConflictPrimary = "ClusterDataConflictPrimary"
 
@@ -95,6 +115,20 @@ const (
 	VRGConditionReasonAutoCleanupProgressing = "Progressing"
 	VRGConditionReasonAutoCleanupNotFeasible = "NotFeasible"
 	VRGConditionReasonAutoCleanupCompleted   = "Completed"
+
+	VRGConditionReasonDataVerified       = "Verified"
+	VRGConditionReasonDataDiverged       = "Diverged"
+	VRGConditionReasonVerificationFailed = "VerificationFailed"
+
+	VRGConditionReasonCapacityMatched  = "Matched"
+	VRGConditionReasonResizing         = "Resizing"
+	VRGConditionReasonCapacityMismatch = "Mismatched"
+
+	VRGConditionReasonResourcesUnmodified = "ResourcesUnmodified"
+
+	VRGConditionReasonReplicationHealthy = "Healthy"
+	VRGConditionReasonDegraded           = "Degraded"
+	VRGConditionReasonSplitBrain         = "SplitBrain"
 )
 
 const (
@@ -329,6 +363,19 @@ func setVRGDataStorageIDNotFoundCondition(conditions *[]metav1.Condition, observ
 	})
 }
 
+// sets condition when the VRG recipe parameters do not match the recipe parameters declaration
+func setVRGDataRecipeParametersInvalidCondition(conditions *[]metav1.Condition, observedGeneration int64,
+	message string,
+) {
+	util.SetStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeDataReady,
+		Reason:             VRGConditionReasonRecipeParametersInvalid,
+		ObservedGeneration: observedGeneration,
+		Status:             metav1.ConditionFalse,
+		Message:            message,
+	})
+}
+
 // sets condition when PeerClass is not found
 func setVRGDataPeerClassNotFoundCondition(conditions *[]metav1.Condition, observedGeneration int64, message string) {
 	util.SetStatusCondition(conditions, metav1.Condition{
@@ -528,6 +575,18 @@ func updateVRGNoClusterDataConflictCondition(observedGeneration int64,
 	}
 }
 
+func newVRGDataVerifiedCondition(observedGeneration int64,
+	status metav1.ConditionStatus, reason, message string,
+) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               VRGConditionTypeDataVerified,
+		Status:             status,
+		ObservedGeneration: observedGeneration,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
 func setVRGAutoCleanupCondition(conditions *[]metav1.Condition, observedGeneration int64,
 	status metav1.ConditionStatus, reason, message string,
 ) {
@@ -540,3 +599,15 @@ func setVRGAutoCleanupCondition(conditions *[]metav1.Condition, observedGenerati
 	}
 	util.SetStatusCondition(conditions, *autoCleanupCondition)
 }
+
+func setVRGCapacityMatchedCondition(conditions *[]metav1.Condition, observedGeneration int64,
+	status metav1.ConditionStatus, reason, message string,
+) {
+	util.SetStatusCondition(conditions, metav1.Condition{
+		Type:               VRGConditionTypeCapacityMatched,
+		Reason:             reason,
+		ObservedGeneration: observedGeneration,
+		Status:             status,
+		Message:            message,
+	})
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+// ClusterMapping returns the names of the other clusters of name mappings keyed by cluster name, mapped to the names
+// on the cluster. The mappings that come later override those before them.
+func ClusterMapping(mappings []map[string]string, clusterName string) map[string]string {
+	mapping := map[string]string{}
+
+	for _, names := range mappings {
+		name, ok := names[clusterName]
+		if !ok {
+			continue
+		}
+
+		for peerClusterName, peerName := range names {
+			if peerClusterName != clusterName && peerName != name {
+				mapping[peerName] = name
+			}
+		}
+	}
+
+	if len(mapping) == 0 {
+		return nil
+	}
+
+	return mapping
+}
+
+// MappedName returns the name that a name is mapped to, or the name if it is not mapped
+func MappedName(mapping map[string]string, name string) string {
+	if mappedName, ok := mapping[name]; ok {
+		return mappedName
+	}
+
+	return name
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("ClusterMapping", func() {
+	type m map[string]string
+
+	DescribeTable("ClusterMapping",
+		func(mappings []map[string]string, clusterName string, expected m) {
+			Expect(util.ClusterMapping(mappings, clusterName)).To(Equal(map[string]string(expected)))
+		},
+		Entry("without mappings", nil, "east", nil),
+		Entry("to the cluster", []map[string]string{{"east": "a", "west": "a-dr"}}, "west", m{"a": "a-dr"}),
+		Entry("without the cluster", []map[string]string{{"east": "a", "west": "a-dr"}}, "north", nil),
+		Entry("with the same name", []map[string]string{{"east": "a", "west": "a"}}, "west", nil),
+		Entry("of three clusters", []map[string]string{{"east": "a", "west": "a-dr", "north": "a-n"}}, "west",
+			m{"a": "a-dr", "a-n": "a-dr"}),
+		Entry("overridden by a later mapping",
+			[]map[string]string{{"east": "a", "west": "a-dr"}, {"east": "a", "west": "a-west"}}, "west",
+			m{"a": "a-west"}),
+	)
+
+	DescribeTable("MappedName",
+		func(name, expected string) {
+			Expect(util.MappedName(m{"a": "a-dr"}, name)).To(Equal(expected))
+		},
+		Entry("mapped", "a", "a-dr"),
+		Entry("not mapped", "other", "other"),
+	)
+
+	drpolicy := &rmn.DRPolicy{
+		Spec: rmn.DRPolicySpec{StorageClassMappings: []rmn.StorageClassMapping{
+			{StorageClassNames: m{"east": "rbd", "west": "rbd-spec"}},
+		}},
+		Status: rmn.DRPolicyStatus{StorageClassMappings: []rmn.StorageClassMapping{
+			{StorageClassNames: m{"east": "rbd", "west": "rbd-status"}},
+			{StorageClassNames: m{"east": "cephfs", "west": "cephfs-dr"}},
+		}},
+	}
+
+	It("maps StorageClass names with the mappings of the policy spec overriding those of its status", func() {
+		mapping := util.DRPolicyStorageClassMapping(drpolicy, "west")
+		Expect(mapping).To(Equal(map[string]string{"rbd": "rbd-spec", "cephfs": "cephfs-dr"}))
+		Expect(util.MappedStorageClassName(mapping, ptr.To("rbd"))).To(Equal(ptr.To("rbd-spec")))
+		Expect(util.MappedStorageClassName(mapping, ptr.To("other"))).To(Equal(ptr.To("other")))
+		Expect(util.MappedStorageClassName(mapping, nil)).To(BeNil())
+	})
+})
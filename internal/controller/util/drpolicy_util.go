@@ -118,3 +118,33 @@ func GetSecondsFromSchedulingInterval(drpolicy *rmn.DRPolicy) (float64, error) {
 func DrpolicyContainsDrcluster(drpolicy *rmn.DRPolicy, drcluster string) bool {
 	return slices.Contains(DRPolicyClusterNames(drpolicy), drcluster)
 }
+
+// DRPolicyStorageClassMapping returns the names of the StorageClasses of the other clusters of a
+// policy, mapped to the names of the StorageClasses of the same storage on the cluster. The mappings
+// of the spec of the policy override those derived in its status.
+func DRPolicyStorageClassMapping(drpolicy *rmn.DRPolicy, clusterName string) map[string]string {
+	mappings := []map[string]string{}
+
+	for _, scMappings := range [][]rmn.StorageClassMapping{
+		drpolicy.Status.StorageClassMappings,
+		drpolicy.Spec.StorageClassMappings,
+	} {
+		for _, scMapping := range scMappings {
+			mappings = append(mappings, scMapping.StorageClassNames)
+		}
+	}
+
+	return ClusterMapping(mappings, clusterName)
+}
+
+// MappedStorageClassName returns the name of the StorageClass that a StorageClass name is mapped to,
+// or the name if it is not mapped
+func MappedStorageClassName(mapping map[string]string, scName *string) *string {
+	if scName == nil {
+		return nil
+	}
+
+	mappedSCName := MappedName(mapping, *scName)
+
+	return &mappedSCName
+}
@@ -55,6 +55,10 @@ const (
 	// EventReasonSecondarySuccess is an event generated when VRG is successfully
 	// processed as Primary.
 	EventReasonDeleteSuccess = "VRGDeleteSuccess"
+
+	// EventReasonForceDeleteSkipped is an event generated when the forced deletion of a VRG skips
+	// cleanup steps that failed
+	EventReasonForceDeleteSkipped = "VRGForceDeleteSkipped"
 	// TODO: Add any additional events (or remove one of existing ones above) if necessary.
 
 	// Events for DRPC Reconciler
@@ -90,6 +94,17 @@ const (
 	// EventReasonSwitchFailed is generated when DRPC fails to switch the cluster
 	// where the app is placed
 	EventReasonSwitchFailed = "DRPCClusterSwitchFailed"
+
+	// EventReasonSplitBrainDetected is generated when DRPC detects more than one cluster acting as the primary
+	// of the application
+	EventReasonSplitBrainDetected = "DRPCSplitBrainDetected"
+
+	// EventReasonSplitBrainResolving is generated when DRPC applies the winner of a split-brain chosen by the
+	// operator, and demotes the other clusters
+	EventReasonSplitBrainResolving = "DRPCSplitBrainResolving"
+
+	// EventReasonSplitBrainResolved is generated when DRPC completes the resolution of a split-brain
+	EventReasonSplitBrainResolved = "DRPCSplitBrainResolved"
 )
 
 // EventReporter is custom events reporter type which allows user to limit the events
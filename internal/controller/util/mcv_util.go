@@ -13,6 +13,7 @@ import (
 	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
 	"github.com/go-logr/logr"
 	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
 	groupsnapv1beta1 "github.com/red-hat-storage/external-snapshotter/client/v8/apis/volumegroupsnapshot/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
@@ -88,6 +89,10 @@ type ManagedClusterViewGetter interface {
 
 	ListVGRClassMCVs(managedCluster string) (*viewv1beta1.ManagedClusterViewList, error)
 
+	GetRecipeFromManagedCluster(
+		resourceName, resourceNamespace, managedCluster string,
+		annotations map[string]string) (*recipev1.Recipe, error)
+
 	GetResource(mcv *viewv1beta1.ManagedClusterView, resource interface{}) error
 
 	DeleteManagedClusterView(clusterName, mcvName string, logger logr.Logger) error
@@ -97,6 +102,8 @@ type ManagedClusterViewGetter interface {
 	DeleteNamespaceManagedClusterView(resourceName, resourceNamespace, clusterName, resourceType string) error
 
 	DeleteNFManagedClusterView(resourceName, resourceNamespace, clusterName, resourceType string) error
+
+	DeleteRecipeManagedClusterView(resourceName, resourceNamespace, clusterName string) error
 }
 
 type ManagedClusterViewGetterImpl struct {
@@ -408,6 +415,27 @@ func (m ManagedClusterViewGetterImpl) GetNSFromManagedCluster(cluster, resourceN
 	return ns, err
 }
 
+func (m ManagedClusterViewGetterImpl) GetRecipeFromManagedCluster(resourceName, resourceNamespace,
+	managedCluster string, annotations map[string]string,
+) (*recipev1.Recipe, error) {
+	recipe := &recipev1.Recipe{}
+
+	err := m.getResourceFromManagedCluster(
+		resourceName,
+		resourceNamespace,
+		managedCluster,
+		annotations,
+		nil,
+		BuildManagedClusterViewName(resourceName, resourceNamespace, MWTypeRecipe),
+		"Recipe",
+		recipev1.GroupVersion.Group,
+		recipev1.GroupVersion.Version,
+		recipe,
+	)
+
+	return recipe, err
+}
+
 func (m ManagedClusterViewGetterImpl) ListVRClassMCVs(cluster string) (*viewv1beta1.ManagedClusterViewList, error) {
 	return m.listMCVsWithLabel(cluster, map[string]string{VRClassLabel: ""})
 }
@@ -595,6 +623,15 @@ func (m ManagedClusterViewGetterImpl) DeleteNFManagedClusterView(
 	return m.DeleteManagedClusterView(clusterName, mcvNameNF, logger)
 }
 
+func (m ManagedClusterViewGetterImpl) DeleteRecipeManagedClusterView(
+	resourceName, resourceNamespace, clusterName string,
+) error {
+	logger := ctrl.Log.WithName("MCV").WithValues("resouceName", resourceName)
+	mcvNameRecipe := BuildManagedClusterViewName(resourceName, resourceNamespace, MWTypeRecipe)
+
+	return m.DeleteManagedClusterView(clusterName, mcvNameRecipe, logger)
+}
+
 func (m ManagedClusterViewGetterImpl) DeleteDRClusterConfigManagedClusterView(clusterName string) error {
 	logger := ctrl.Log.WithName("MCV").WithValues("resouceName", clusterName)
 	mcvNameDRCConfig := BuildManagedClusterViewName(clusterName, "", MWTypeDRCConfig)
@@ -377,9 +377,9 @@ func IsCGEnabledForVolRep(ctx context.Context, apiReader client.Reader) bool {
 	return IsCRDInstalled(ctx, apiReader, VGRCRDName)
 }
 
-// IsCGEnabledForVolSync determines whether consistency group (CG) protection is enabled for CephFS volumes.
+// IsCGEnabledForVolSync determines whether consistency group (CG) protection is enabled for VolSync volumes.
 // It checks whether the VolumeGroupSnapshot CRD is installed.
-// This condition must be true for CephFS CG protection to be considered enabled.
+// This condition must be true for VolSync CG protection to be considered enabled.
 func IsCGEnabledForVolSync(ctx context.Context, apiReader client.Reader) bool {
 	return IsCRDInstalled(ctx, apiReader, VGSCRDName) || IsCRDInstalled(ctx, apiReader, VGSCRDPrivateName)
 }
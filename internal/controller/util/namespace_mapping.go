@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util
+
+import (
+	"maps"
+	"slices"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// DRPCNamespaceMapping returns the names of the protected namespaces of the other clusters of a
+// DRPC, mapped to the names of the same namespaces on the cluster
+func DRPCNamespaceMapping(drpc *rmn.DRPlacementControl, clusterName string) map[string]string {
+	mappings := make([]map[string]string, len(drpc.Spec.NamespaceMappings))
+
+	for idx := range drpc.Spec.NamespaceMappings {
+		mappings[idx] = drpc.Spec.NamespaceMappings[idx].NamespaceNames
+	}
+
+	return ClusterMapping(mappings, clusterName)
+}
+
+// MappedNamespaceName returns the name of the namespace that a namespace name is mapped to, or the
+// name if it is not mapped
+func MappedNamespaceName(mapping map[string]string, nsName string) string {
+	return MappedName(mapping, nsName)
+}
+
+// MappedNamespaceNames returns the names of the namespaces that namespace names are mapped to
+func MappedNamespaceNames(mapping map[string]string, nsNames []string) []string {
+	mappedNSNames := make([]string, len(nsNames))
+
+	for idx, nsName := range nsNames {
+		mappedNSNames[idx] = MappedNamespaceName(mapping, nsName)
+	}
+
+	return mappedNSNames
+}
+
+// PeerNamespaceName returns the name of the namespace of a peer cluster that is mapped to a
+// namespace name, or the name if none is mapped to it
+func PeerNamespaceName(mapping map[string]string, nsName string) string {
+	for _, peerNSName := range slices.Sorted(maps.Keys(mapping)) {
+		if mapping[peerNSName] == nsName {
+			return peerNSName
+		}
+	}
+
+	return nsName
+}
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package util_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	rmn "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+var _ = Describe("NamespaceMapping", func() {
+	type m map[string]string
+
+	drpc := func(mappings ...m) *rmn.DRPlacementControl {
+		drpc := &rmn.DRPlacementControl{}
+		for _, mapping := range mappings {
+			drpc.Spec.NamespaceMappings = append(drpc.Spec.NamespaceMappings,
+				rmn.NamespaceMapping{NamespaceNames: mapping})
+		}
+
+		return drpc
+	}
+
+	DescribeTable("DRPCNamespaceMapping",
+		func(drpc *rmn.DRPlacementControl, clusterName string, expected m) {
+			Expect(util.DRPCNamespaceMapping(drpc, clusterName)).To(Equal(map[string]string(expected)))
+		},
+		Entry("without mappings", drpc(), "east", nil),
+		Entry("to the cluster", drpc(m{"east": "app", "west": "app-dr"}), "west", m{"app": "app-dr"}),
+		Entry("back to the cluster", drpc(m{"east": "app", "west": "app-dr"}), "east", m{"app-dr": "app"}),
+		Entry("without the cluster", drpc(m{"east": "app", "west": "app-dr"}), "north", nil),
+		Entry("with the same name", drpc(m{"east": "app", "west": "app"}), "west", nil),
+		Entry("of namespaces", drpc(m{"east": "a", "west": "a-dr"}, m{"east": "b", "west": "b-dr"}), "west",
+			m{"a": "a-dr", "b": "b-dr"}),
+	)
+
+	mapping := m{"app": "app-dr", "db": "db-dr"}
+
+	DescribeTable("MappedNamespaceName",
+		func(nsName, expected string) {
+			Expect(util.MappedNamespaceName(mapping, nsName)).To(Equal(expected))
+		},
+		Entry("mapped", "app", "app-dr"),
+		Entry("not mapped", "other", "other"),
+		Entry("named as on this cluster", "app-dr", "app-dr"),
+	)
+
+	DescribeTable("PeerNamespaceName",
+		func(nsName, expected string) {
+			Expect(util.PeerNamespaceName(mapping, nsName)).To(Equal(expected))
+		},
+		Entry("mapped to", "db-dr", "db"),
+		Entry("not mapped to", "other", "other"),
+		Entry("named as on the peer", "app", "app"),
+	)
+
+	It("maps namespace names", func() {
+		Expect(util.MappedNamespaceNames(mapping, []string{"app", "other"})).To(Equal([]string{"app-dr", "other"}))
+		Expect(util.MappedNamespaceNames(nil, []string{"app"})).To(Equal([]string{"app"}))
+	})
+})
@@ -36,9 +36,22 @@ const (
 	SuffixForFinalsyncPVC = "-for-finalsync"
 
 	ManagedAnnotationKeysMarker string = "ramendr.openshift.io/managed-annotation-keys"
+
+	// ExcludedFromProtectionAnnotation excludes a PVC from protection even though it is selected, for example
+	// a scratch volume. Its value is the reason for the exclusion, which is reported in the VRG status.
+	ExcludedFromProtectionAnnotation = "ramendr.openshift.io/exclude-from-protection"
 )
 
-// nolint:funlen
+// PVCExcludedFromProtection returns whether a PVC is excluded from protection by its annotation,
+// and the reason for it
+func PVCExcludedFromProtection(pvc *corev1.PersistentVolumeClaim) (string, bool) {
+	reason, excluded := pvc.GetAnnotations()[ExcludedFromProtectionAnnotation]
+
+	return reason, excluded
+}
+
+// ListPVCsByPVCSelector lists the PVCs in the namespaces that are selected by the label selector,
+// except those that are excluded from protection
 func ListPVCsByPVCSelector(
 	ctx context.Context,
 	k8sClient client.Client,
@@ -47,12 +60,30 @@ func ListPVCsByPVCSelector(
 	namespaces []string,
 	volSyncDisabled bool,
 ) (*corev1.PersistentVolumeClaimList, error) {
+	pvcList, _, err := ListPVCsAndExcludedPVCsByPVCSelector(ctx, k8sClient, logger, pvcLabelSelector, namespaces,
+		volSyncDisabled)
+
+	return pvcList, err
+}
+
+// ListPVCsAndExcludedPVCsByPVCSelector lists the PVCs in the namespaces that are selected by the label
+// selector, separating out those that are excluded from protection
+//
+// nolint:funlen
+func ListPVCsAndExcludedPVCsByPVCSelector(
+	ctx context.Context,
+	k8sClient client.Client,
+	logger logr.Logger,
+	pvcLabelSelector metav1.LabelSelector,
+	namespaces []string,
+	volSyncDisabled bool,
+) (*corev1.PersistentVolumeClaimList, []corev1.PersistentVolumeClaim, error) {
 	// convert metav1.LabelSelector to a labels.Selector
 	pvcSelector, err := metav1.LabelSelectorAsSelector(&pvcLabelSelector)
 	if err != nil {
 		logger.Error(err, "error with PVC label selector", "pvcSelector", pvcLabelSelector)
 
-		return nil, fmt.Errorf("error with PVC label selector, %w", err)
+		return nil, nil, fmt.Errorf("error with PVC label selector, %w", err)
 	}
 
 	updatedPVCSelector := pvcSelector
@@ -64,7 +95,7 @@ func ListPVCsByPVCSelector(
 		if err != nil {
 			logger.Error(err, "error updating PVC label selector")
 
-			return nil, fmt.Errorf("error updating PVC label selector, %w", err)
+			return nil, nil, fmt.Errorf("error updating PVC label selector, %w", err)
 		}
 
 		updatedPVCSelector = pvcSelector.Add(*notCreatedByVolsyncReq)
@@ -75,7 +106,7 @@ func ListPVCsByPVCSelector(
 		if err != nil {
 			logger.Error(err, "error updating PVC label selector for created by ramen label")
 
-			return nil, fmt.Errorf("error updating PVC label selector for created by ramen label, %w", err)
+			return nil, nil, fmt.Errorf("error updating PVC label selector for created by ramen label, %w", err)
 		}
 
 		updatedPVCSelector = updatedPVCSelector.Add(*notCreatedByRamen)
@@ -93,24 +124,35 @@ func ListPVCsByPVCSelector(
 	if err := k8sClient.List(ctx, pvcList, listOptions...); err != nil {
 		logger.Error(err, "Failed to list PersistentVolumeClaims", "pvcSelector", updatedPVCSelector)
 
-		return nil, fmt.Errorf("failed to list PersistentVolumeClaims, %w", err)
+		return nil, nil, fmt.Errorf("failed to list PersistentVolumeClaims, %w", err)
 	}
 
 	logger.Info(fmt.Sprintf("Found %d PVCs using label selector %v", len(pvcList.Items), updatedPVCSelector))
 
-	var pvcs []corev1.PersistentVolumeClaim
+	var pvcs, excludedPVCs []corev1.PersistentVolumeClaim
 
 	for _, pvc := range pvcList.Items {
-		if slices.Contains(namespaces, pvc.Namespace) {
-			pvcs = append(pvcs, pvc)
+		if !slices.Contains(namespaces, pvc.Namespace) {
+			continue
+		}
+
+		if reason, excluded := PVCExcludedFromProtection(&pvc); excluded {
+			logger.Info("PVC excluded from protection", "pvc", pvc.Namespace+"/"+pvc.Name, "reason", reason)
+
+			excludedPVCs = append(excludedPVCs, pvc)
+
+			continue
 		}
+
+		pvcs = append(pvcs, pvc)
 	}
 
-	logger.Info(fmt.Sprintf("Returning %d PVCs in namespace(s) %v", len(pvcs), namespaces))
+	logger.Info(fmt.Sprintf("Returning %d PVCs in namespace(s) %v, excluding %d", len(pvcs), namespaces,
+		len(excludedPVCs)))
 
 	pvcList.Items = pvcs
 
-	return pvcList, nil
+	return pvcList, excludedPVCs, nil
 }
 
 func ListPVCsByCGLabel(
@@ -183,7 +225,7 @@ func IsPVCInUseByPod(ctx context.Context,
 		if pod.Status.Phase == corev1.PodRunning {
 			// Assuming in use by running pod if at least 1 pod mounting the PVC is in Running phase
 			// and has the Ready podCondition set to True
-			mountingPodIsReady = isPodReady(pod.Status.Conditions)
+			mountingPodIsReady = IsPodReady(pod.Status.Conditions)
 		}
 	}
 
@@ -291,7 +333,8 @@ func IndexFieldsForVSHandler(ctx context.Context, fieldIndexer client.FieldIndex
 		})
 }
 
-func isPodReady(podConditions []corev1.PodCondition) bool {
+// IsPodReady returns true if the conditions of a pod report it Ready.
+func IsPodReady(podConditions []corev1.PodCondition) bool {
 	for _, podCondition := range podConditions {
 		if podCondition.Type == corev1.PodReady && podCondition.Status == corev1.ConditionTrue {
 			return true
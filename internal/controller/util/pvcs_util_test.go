@@ -225,6 +225,30 @@ var _ = Describe("PVCS_Util", func() {
 				))
 			})
 		})
+
+		Context("With a PVC excluded from protection", func() {
+			var pvcSelector metav1.LabelSelector
+
+			BeforeEach(func() {
+				pvcD.SetAnnotations(map[string]string{util.ExcludedFromProtectionAnnotation: "scratch"})
+				Expect(k8sClient.Update(testCtx, pvcD)).To(Succeed())
+			})
+
+			It("Should list the excluded PVC separately", func() {
+				pvcList, excludedPVCs, err := util.ListPVCsAndExcludedPVCsByPVCSelector(testCtx, k8sClient,
+					testLogger, pvcSelector,
+					[]string{testNamespace.GetName()},
+					false /* Volsync NOT Disabled */)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pvcList).NotTo(BeNil())
+				Expect(pvcList.Items).Should(ConsistOf(
+					HavePVCName(pvcB.GetName()),
+				))
+				Expect(excludedPVCs).Should(ConsistOf(
+					HavePVCName(pvcD.GetName()),
+				))
+			})
+		})
 	})
 })
 
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// destinationVolumeOptions returns the volume options of the mover of a ReplicationDestination
+func destinationVolumeOptions(rd *volsyncv1alpha1.ReplicationDestination,
+) *volsyncv1alpha1.ReplicationDestinationVolumeOptions {
+	switch {
+	case rd.Spec.RsyncTLS != nil:
+		return &rd.Spec.RsyncTLS.ReplicationDestinationVolumeOptions
+	case rd.Spec.Restic != nil:
+		return &rd.Spec.Restic.ReplicationDestinationVolumeOptions
+	case rd.Spec.Rclone != nil:
+		return &rd.Spec.Rclone.ReplicationDestinationVolumeOptions
+	}
+
+	return nil
+}
+
+// destinationPVCName returns the name of the PVC that a ReplicationDestination replicates to, or "" if it is not
+// created yet. It is the PVC of its volume options, the application PVC with the Direct copy method, or else the PVC
+// that VolSync creates for it, which is the latest image with the Direct copy method, and is controlled by it.
+func (v *VSHandler) destinationPVCName(rd *volsyncv1alpha1.ReplicationDestination) (string, error) {
+	volumeOptions := destinationVolumeOptions(rd)
+	if volumeOptions != nil && volumeOptions.DestinationPVC != nil {
+		return *volumeOptions.DestinationPVC, nil
+	}
+
+	if rd.Status != nil && rd.Status.LatestImage != nil && rd.Status.LatestImage.Kind == "PersistentVolumeClaim" &&
+		(rd.Status.LatestImage.APIGroup == nil || *rd.Status.LatestImage.APIGroup == "") {
+		return rd.Status.LatestImage.Name, nil
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := v.client.List(v.ctx, pvcs, client.InNamespace(rd.GetNamespace())); err != nil {
+		return "", fmt.Errorf("failed to list PVCs in namespace %s (%w)", rd.GetNamespace(), err)
+	}
+
+	for idx := range pvcs.Items {
+		if metav1.IsControlledBy(&pvcs.Items[idx], rd) {
+			return pvcs.Items[idx].GetName(), nil
+		}
+	}
+
+	return "", nil
+}
+
+// ReconcileDestinationCapacity expands the PVC that the ReplicationDestination of a protected PVC replicates to,
+// when it requests less capacity than the protected PVC, as VolSync only sets the capacity of the PVCs it creates.
+// It returns a message describing how the destination has less capacity than the protected PVC requests, or "" if
+// neither the PVC, nor the latest snapshot of the destination, has less capacity, and whether the destination exists
+// to be compared. Snapshots cannot be expanded, so a snapshot taken before the expansion is reported until it is
+// replaced by the next sync, and PVCs restored from it are created with the requested capacity, see
+// ensurePVCFromSnapshot.
+func (v *VSHandler) ReconcileDestinationCapacity(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+) (string, bool, error) {
+	requested := rdSpec.ProtectedPVC.Resources.Requests.Storage()
+	if requested.IsZero() {
+		return "", false, nil
+	}
+
+	rd, err := GetRD(v.ctx, v.client, rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace, v.log)
+	if err != nil || rd == nil {
+		return "", false, err
+	}
+
+	mismatches := []string{}
+
+	pvcMismatch, err := v.reconcileDestinationPVCCapacity(rd, *requested)
+	if err != nil {
+		return "", false, err
+	}
+
+	if pvcMismatch != "" {
+		mismatches = append(mismatches, pvcMismatch)
+	}
+
+	snapshotMismatch, err := v.destinationSnapshotCapacityMismatch(rd, *requested)
+	if err != nil {
+		return "", false, err
+	}
+
+	if snapshotMismatch != "" {
+		mismatches = append(mismatches, snapshotMismatch)
+	}
+
+	return strings.Join(mismatches, "; "), true, nil
+}
+
+func (v *VSHandler) reconcileDestinationPVCCapacity(rd *volsyncv1alpha1.ReplicationDestination,
+	requested resource.Quantity,
+) (string, error) {
+	pvcName, err := v.destinationPVCName(rd)
+	if err != nil || pvcName == "" {
+		return "", err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+
+	err = v.client.Get(v.ctx, types.NamespacedName{Name: pvcName, Namespace: rd.GetNamespace()}, pvc)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to get destination PVC %s (%w)", pvcName, err)
+	}
+
+	pvcRequested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	if pvcRequested.Cmp(requested) < 0 {
+		if pvc.Spec.Resources.Requests == nil {
+			pvc.Spec.Resources.Requests = corev1.ResourceList{}
+		}
+
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = requested
+
+		if err := v.client.Update(v.ctx, pvc); err != nil {
+			return "", fmt.Errorf("failed to expand destination PVC %s from %s to %s (%w)", pvc.GetName(),
+				pvcRequested.String(), requested.String(), err)
+		}
+
+		v.log.Info("Expanded destination PVC", "pvc", pvc.GetName(), "from", pvcRequested.String(),
+			"to", requested.String())
+	}
+
+	capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	if capacity.Cmp(requested) < 0 {
+		return fmt.Sprintf("destination PVC %s is being resized from %s to %s", pvc.GetName(), capacity.String(),
+			requested.String()), nil
+	}
+
+	return "", nil
+}
+
+func (v *VSHandler) destinationSnapshotCapacityMismatch(rd *volsyncv1alpha1.ReplicationDestination,
+	requested resource.Quantity,
+) (string, error) {
+	if rd.Status == nil || !isLatestImageReady(rd.Status.LatestImage) {
+		return "", nil
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: rd.Status.LatestImage.Name, Namespace: rd.GetNamespace()},
+		snapshot)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("failed to get destination snapshot %s (%w)", rd.Status.LatestImage.Name, err)
+	}
+
+	if snapshot.Status == nil || snapshot.Status.RestoreSize == nil || snapshot.Status.RestoreSize.Cmp(requested) >= 0 {
+		return "", nil
+	}
+
+	return fmt.Sprintf("latest destination snapshot %s has a restore size of %s, less than %s until the next sync",
+		snapshot.GetName(), snapshot.Status.RestoreSize.String(), requested.String()), nil
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"context"
+	"testing"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	"github.com/go-logr/logr"
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+func capacityReplicationDestination(destinationPVC *string, latestImage *corev1.TypedLocalObjectReference,
+) *volsyncv1alpha1.ReplicationDestination {
+	rd := &volsyncv1alpha1.ReplicationDestination{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "app", UID: "rd"},
+		Spec: volsyncv1alpha1.ReplicationDestinationSpec{
+			RsyncTLS: &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
+				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+					CopyMethod:     volsyncv1alpha1.CopyMethodSnapshot,
+					Capacity:       ptr.To(resource.MustParse("2Gi")),
+					DestinationPVC: destinationPVC,
+				},
+			},
+		},
+	}
+
+	if latestImage != nil {
+		rd.Status = &volsyncv1alpha1.ReplicationDestinationStatus{LatestImage: latestImage}
+	}
+
+	return rd
+}
+
+func capacityPVC(name, requested, capacity string, controlledBy *volsyncv1alpha1.ReplicationDestination,
+) *corev1.PersistentVolumeClaim {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+			},
+		},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+
+	if controlledBy != nil {
+		pvc.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: volsyncv1alpha1.GroupVersion.String(),
+			Kind:       "ReplicationDestination",
+			Name:       controlledBy.GetName(),
+			UID:        controlledBy.GetUID(),
+			Controller: ptr.To(true),
+		}}
+	}
+
+	return pvc
+}
+
+func capacitySnapshot(name, restoreSize string) *snapv1.VolumeSnapshot {
+	return &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"},
+		Status:     &snapv1.VolumeSnapshotStatus{RestoreSize: ptr.To(resource.MustParse(restoreSize))},
+	}
+}
+
+func snapshotImage(name string) *corev1.TypedLocalObjectReference {
+	return &corev1.TypedLocalObjectReference{
+		APIGroup: ptr.To(snapv1.GroupName),
+		Kind:     volsync.VolumeSnapshotKind,
+		Name:     name,
+	}
+}
+
+func TestReconcileDestinationCapacity(t *testing.T) {
+	rd := capacityReplicationDestination(nil, nil)
+
+	tests := []struct {
+		name      string
+		objects   []client.Object
+		mismatch  string
+		found     bool
+		requested map[string]string
+	}{
+		{
+			name:      "PVC that VolSync created for a snapshot copy destination",
+			objects:   []client.Object{rd, capacityPVC("dst", "1Gi", "1Gi", rd)},
+			mismatch:  "destination PVC dst is being resized from 1Gi to 2Gi",
+			found:     true,
+			requested: map[string]string{"dst": "2Gi"},
+		},
+		{
+			name: "application PVC of a direct copy destination",
+			objects: []client.Object{
+				capacityReplicationDestination(ptr.To("pvc"), nil), capacityPVC("pvc", "1Gi", "2Gi", nil),
+			},
+			found:     true,
+			requested: map[string]string{"pvc": "2Gi"},
+		},
+		{
+			name: "PVC that is the latest image of a direct copy destination",
+			objects: []client.Object{
+				capacityReplicationDestination(nil, &corev1.TypedLocalObjectReference{
+					Kind: "PersistentVolumeClaim", Name: "image",
+				}),
+				capacityPVC("image", "1Gi", "1Gi", nil),
+			},
+			mismatch:  "destination PVC image is being resized from 1Gi to 2Gi",
+			found:     true,
+			requested: map[string]string{"image": "2Gi"},
+		},
+		{
+			name: "PVC that the destination does not control",
+			objects: []client.Object{
+				rd, capacityPVC("dst", "1Gi", "1Gi", nil),
+			},
+			found:     true,
+			requested: map[string]string{"dst": "1Gi"},
+		},
+		{
+			name: "latest snapshot taken before the expansion",
+			objects: []client.Object{
+				capacityReplicationDestination(nil, snapshotImage("snapshot")),
+				capacitySnapshot("snapshot", "1Gi"),
+			},
+			mismatch: "latest destination snapshot snapshot has a restore size of 1Gi, less than 2Gi until the next sync",
+			found:    true,
+		},
+		{
+			name: "destination with the requested capacity",
+			objects: []client.Object{
+				rd, capacityPVC("dst", "2Gi", "2Gi", rd), capacitySnapshot("snapshot", "2Gi"),
+			},
+			found: true,
+		},
+		{
+			name: "destination that does not exist yet",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			require.NoError(t, clientgoscheme.AddToScheme(scheme))
+			require.NoError(t, volsyncv1alpha1.AddToScheme(scheme))
+			require.NoError(t, snapv1.AddToScheme(scheme))
+
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+			vrg := &ramendrv1alpha1.VolumeReplicationGroup{ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app"}}
+			vsHandler := volsync.NewVSHandler(context.TODO(), k8sClient, logr.Discard(), vrg, nil, "", "", false)
+
+			mismatch, found, err := vsHandler.ReconcileDestinationCapacity(ramendrv1alpha1.VolSyncReplicationDestinationSpec{
+				ProtectedPVC: ramendrv1alpha1.ProtectedPVC{
+					Name:               "pvc",
+					Namespace:          "app",
+					ProtectedByVolSync: true,
+					Resources: corev1.VolumeResourceRequirements{
+						Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("2Gi")},
+					},
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.mismatch, mismatch)
+			assert.Equal(t, tt.found, found)
+
+			for name, requested := range tt.requested {
+				pvc := &corev1.PersistentVolumeClaim{}
+				require.NoError(t, k8sClient.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: "app"}, pvc))
+				assert.Equal(t, requested, pvc.Spec.Resources.Requests.Storage().String())
+			}
+		})
+	}
+}
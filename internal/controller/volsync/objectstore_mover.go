@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	RestoreTriggerString string = "vrg-restore"
+
+	// Repositories are kept below the key prefix of the VRG cluster data, so that they are
+	// deleted along with it.
+	repositoryKeyPrefix = "volsync"
+
+	repositorySecretSuffix = "repository"
+	repositoryCAKey        = "ca.crt"
+	pskSecretKey           = "psk.txt"
+
+	rcloneConfigKey     = "rclone.conf"
+	rcloneConfigSection = "ramen-s3"
+
+	resticRetainLast        = "2"
+	resticPruneIntervalDays = int32(7)
+)
+
+// ObjectStoreRepository is the object store bucket, and its credentials, through which the
+// Restic and Rclone movers replicate.
+type ObjectStoreRepository struct {
+	Endpoint        string
+	Bucket          string
+	Region          string
+	AccessKeyID     []byte
+	SecretAccessKey []byte
+	CACertificates  []byte
+}
+
+// SetObjectStoreRepositoryGetter sets the function returning the repository of the Restic and
+// Rclone movers. It is only called when such a mover is used.
+func (v *VSHandler) SetObjectStoreRepositoryGetter(get func() (*ObjectStoreRepository, error)) {
+	v.objectStoreRepositoryGet = get
+}
+
+// IsObjectStoreMover returns true if PVCs are replicated through an object store repository
+// rather than directly to the peer cluster.
+func (v *VSHandler) IsObjectStoreMover() bool {
+	return v.mover == ramendrv1alpha1.VolSyncMoverRestic || v.mover == ramendrv1alpha1.VolSyncMoverRclone
+}
+
+func (v *VSHandler) getObjectStoreRepository() (*ObjectStoreRepository, error) {
+	if v.objectStoreRepositoryGet == nil {
+		return nil, fmt.Errorf("no object store repository configured for VolSync mover %s", v.mover)
+	}
+
+	return v.objectStoreRepositoryGet()
+}
+
+// repositoryPath returns the path of the repository of a PVC in the bucket. The path is the
+// same on both clusters, as is the VRG name and namespace.
+func (v *VSHandler) repositoryPath(repository *ObjectStoreRepository, pvc ramendrv1alpha1.ProtectedPVC) string {
+	return path.Join(repository.Bucket, v.owner.GetNamespace(), v.owner.GetName(), repositoryKeyPrefix,
+		pvc.Namespace, pvc.Name)
+}
+
+func getRepositorySecretName(owner client.Object) string {
+	kind := "rs"
+	if _, ok := owner.(*volsyncv1alpha1.ReplicationDestination); ok {
+		kind = "rd"
+	}
+
+	return fmt.Sprintf("%s-%s-%s", owner.GetName(), kind, repositorySecretSuffix)
+}
+
+// ensureRepositorySecret creates or updates the secret with the repository configuration of
+// the mover of a ReplicationSource or ReplicationDestination, owned by it.
+func (v *VSHandler) ensureRepositorySecret(owner client.Object, repository *ObjectStoreRepository,
+	pvc ramendrv1alpha1.ProtectedPVC,
+) error {
+	data, err := v.repositorySecretData(repository, pvc)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getRepositorySecretName(owner),
+			Namespace: owner.GetNamespace(),
+		},
+	}
+
+	op, err := ctrlutil.CreateOrUpdate(v.ctx, v.client, secret, func() error {
+		util.AddLabel(secret, util.CreatedByRamenLabel, "true")
+		util.AddLabel(secret, util.ExcludeFromVeleroBackup, "true")
+
+		secret.Data = data
+
+		return ctrlutil.SetControllerReference(owner, secret, v.client.Scheme())
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update repository secret %s: %w", secret.GetName(), err)
+	}
+
+	v.log.V(1).Info("Repository secret createOrUpdate Complete", "secret", secret.GetName(), "op", op)
+
+	return nil
+}
+
+func (v *VSHandler) repositorySecretData(repository *ObjectStoreRepository, pvc ramendrv1alpha1.ProtectedPVC,
+) (map[string][]byte, error) {
+	data := map[string][]byte{}
+
+	if len(repository.CACertificates) > 0 {
+		data[repositoryCAKey] = repository.CACertificates
+	}
+
+	if v.mover == ramendrv1alpha1.VolSyncMoverRclone {
+		data[rcloneConfigKey] = []byte(strings.Join([]string{
+			"[" + rcloneConfigSection + "]",
+			"type = s3",
+			"provider = Other",
+			"env_auth = false",
+			"access_key_id = " + string(repository.AccessKeyID),
+			"secret_access_key = " + string(repository.SecretAccessKey),
+			"region = " + repository.Region,
+			"endpoint = " + repository.Endpoint,
+			"",
+		}, "\n"))
+
+		if v.bandwidthLimit != nil {
+			data[rcloneBandwidthLimit] = []byte(rcloneBandwidthLimitValue(v.bandwidthLimit.Value()))
+		}
+
+		return data, nil
+	}
+
+	// The repository is encrypted with the pre-shared key, which both clusters have
+	password, err := v.getPSK()
+	if err != nil {
+		return nil, err
+	}
+
+	data["RESTIC_REPOSITORY"] = []byte("s3:" + strings.TrimSuffix(repository.Endpoint, "/") + "/" +
+		v.repositoryPath(repository, pvc))
+	data["RESTIC_PASSWORD"] = password
+	data["AWS_ACCESS_KEY_ID"] = repository.AccessKeyID
+	data["AWS_SECRET_ACCESS_KEY"] = repository.SecretAccessKey
+	data["AWS_DEFAULT_REGION"] = []byte(repository.Region)
+
+	return data, nil
+}
+
+func (v *VSHandler) getPSK() ([]byte, error) {
+	secret := &corev1.Secret{}
+	secretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+
+	if err := v.client.Get(v.ctx, types.NamespacedName{Name: secretName, Namespace: v.owner.GetNamespace()},
+		secret); err != nil {
+		return nil, fmt.Errorf("failed to get psk secret %s: %w", secretName, err)
+	}
+
+	psk, ok := secret.Data[pskSecretKey]
+	if !ok || len(psk) == 0 {
+		return nil, fmt.Errorf("psk secret %s has no %s", secretName, pskSecretKey)
+	}
+
+	return psk, nil
+}
+
+func repositoryCustomCA(repository *ObjectStoreRepository, secretName string) volsyncv1alpha1.CustomCASpec {
+	if len(repository.CACertificates) == 0 {
+		return volsyncv1alpha1.CustomCASpec{}
+	}
+
+	return volsyncv1alpha1.CustomCASpec{SecretName: secretName, Key: repositoryCAKey}
+}
+
+// setRSObjectStoreMover sets the Restic or Rclone mover of a ReplicationSource in place of the
+// rsync-TLS mover.
+func (v *VSHandler) setRSObjectStoreMover(rs *volsyncv1alpha1.ReplicationSource,
+	repository *ObjectStoreRepository, pvc ramendrv1alpha1.ProtectedPVC,
+	volumeOptions volsyncv1alpha1.ReplicationSourceVolumeOptions, moverConfig volsyncv1alpha1.MoverConfig,
+) {
+	secretName := getRepositorySecretName(rs)
+	customCA := repositoryCustomCA(repository, secretName)
+
+	rs.Spec.RsyncTLS = nil
+
+	if v.mover == ramendrv1alpha1.VolSyncMoverRclone {
+		rs.Spec.Restic = nil
+		rs.Spec.Rclone = &volsyncv1alpha1.ReplicationSourceRcloneSpec{
+			ReplicationSourceVolumeOptions: volumeOptions,
+			RcloneConfigSection:            ptr.To(rcloneConfigSection),
+			RcloneDestPath:                 ptr.To(v.repositoryPath(repository, pvc)),
+			RcloneConfig:                   &secretName,
+			CustomCA:                       customCA,
+			MoverConfig:                    moverConfig,
+		}
+
+		return
+	}
+
+	rs.Spec.Rclone = nil
+	rs.Spec.Restic = &volsyncv1alpha1.ReplicationSourceResticSpec{
+		ReplicationSourceVolumeOptions: volumeOptions,
+		PruneIntervalDays:              ptr.To(resticPruneIntervalDays),
+		Repository:                     secretName,
+		CustomCA:                       volsyncv1alpha1.ReplicationSourceResticCA(customCA),
+		Retain:                         &volsyncv1alpha1.ResticRetainPolicy{Last: ptr.To(resticRetainLast)},
+		MoverConfig:                    moverConfig,
+	}
+}
+
+// setRDObjectStoreMover sets the Restic or Rclone mover of a ReplicationDestination in place of
+// the rsync-TLS mover. The destination restores from the repository at the scheduling
+// interval, as it is not notified of the syncs of the source.
+func (v *VSHandler) setRDObjectStoreMover(rd *volsyncv1alpha1.ReplicationDestination,
+	repository *ObjectStoreRepository, pvc ramendrv1alpha1.ProtectedPVC,
+	volumeOptions volsyncv1alpha1.ReplicationDestinationVolumeOptions, moverConfig volsyncv1alpha1.MoverConfig,
+) error {
+	scheduleCronSpec, err := v.GetScheduleCronSpec(pvc.SchedulingInterval)
+	if err != nil {
+		return err
+	}
+
+	rd.Spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Schedule: scheduleCronSpec}
+
+	secretName := getRepositorySecretName(rd)
+	customCA := repositoryCustomCA(repository, secretName)
+
+	rd.Spec.RsyncTLS = nil
+
+	if v.mover == ramendrv1alpha1.VolSyncMoverRclone {
+		rd.Spec.Restic = nil
+		rd.Spec.Rclone = &volsyncv1alpha1.ReplicationDestinationRcloneSpec{
+			ReplicationDestinationVolumeOptions: volumeOptions,
+			RcloneConfigSection:                 ptr.To(rcloneConfigSection),
+			RcloneDestPath:                      ptr.To(v.repositoryPath(repository, pvc)),
+			RcloneConfig:                        &secretName,
+			CustomCA:                            customCA,
+			MoverConfig:                         moverConfig,
+		}
+
+		return nil
+	}
+
+	rd.Spec.Rclone = nil
+	rd.Spec.Restic = &volsyncv1alpha1.ReplicationDestinationResticSpec{
+		ReplicationDestinationVolumeOptions: volumeOptions,
+		Repository:                          secretName,
+		CustomCA:                            volsyncv1alpha1.ReplicationDestinationResticCA(customCA),
+		MoverConfig:                         moverConfig,
+	}
+
+	return nil
+}
+
+// restoreLatestToRD runs a last restore from the repository before the PVC is restored from
+// the latest image of the ReplicationDestination, so that it has the data of the final sync of
+// a relocation, or the latest data in the repository on failover. The restore is run once per
+// generation of the VRG.
+func (v *VSHandler) restoreLatestToRD(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec) error {
+	rd, err := GetRD(v.ctx, v.client, rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace, v.log)
+	if err != nil {
+		return err
+	}
+
+	if rd == nil {
+		return fmt.Errorf("ReplicationDestination %s not found", rdSpec.ProtectedPVC.Name)
+	}
+
+	trigger := RestoreTriggerString + "-" + strconv.FormatInt(v.owner.GetGeneration(), 10)
+
+	if rd.Status != nil && rd.Status.LastManualSync == trigger {
+		return nil
+	}
+
+	if rd.Spec.Trigger == nil || rd.Spec.Trigger.Manual != trigger {
+		rd.Spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Manual: trigger}
+
+		if err := v.client.Update(v.ctx, rd); err != nil {
+			return fmt.Errorf("failed to trigger restore of ReplicationDestination %s: %w", rd.GetName(), err)
+		}
+	}
+
+	return fmt.Errorf("waiting for ReplicationDestination %s to restore from the repository", rd.GetName())
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"fmt"
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("VolSync_Handler - object store movers", func() {
+	var testNamespace *corev1.Namespace
+	var owner *ramendrv1alpha1.VolumeReplicationGroup
+	var vsHandler *volsync.VSHandler
+
+	repository := &volsync.ObjectStoreRepository{
+		Endpoint:        "https://s3.example.com/",
+		Bucket:          "bucket",
+		Region:          "us-east-1",
+		AccessKeyID:     []byte("access"),
+		SecretAccessKey: []byte("secret"),
+	}
+
+	protectedPVC := ramendrv1alpha1.ProtectedPVC{
+		Name:               "mytestpvc",
+		ProtectedByVolSync: true,
+		StorageClassName:   &testStorageClassName,
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: resource.MustParse("1Gi"),
+			},
+		},
+		AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+	}
+
+	setupOwner := func(volSyncSpec ramendrv1alpha1.VolSyncSpec) {
+		testNamespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{GenerateName: "vh-os-"}}
+		Expect(k8sClient.Create(ctx, testNamespace)).To(Succeed())
+
+		owner = &ramendrv1alpha1.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: testNamespace.GetName()},
+			Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+				ReplicationState: ramendrv1alpha1.Secondary,
+				S3Profiles:       []string{"s3profile"},
+				Async:            &ramendrv1alpha1.VRGAsyncSpec{SchedulingInterval: "5m"},
+				VolSync:          volSyncSpec,
+			},
+		}
+		Expect(k8sClient.Create(ctx, owner)).To(Succeed())
+
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      volsync.GetVolSyncPSKSecretNameFromVRGName(owner.GetName()),
+				Namespace: testNamespace.GetName(),
+			},
+			StringData: map[string]string{"psk.txt": "volsyncramen:0123456789abcdef"},
+		})).To(Succeed())
+
+		vsHandler = volsync.NewVSHandler(ctx, k8sClient, logger, owner, owner.Spec.Async, "none", "Snapshot", false)
+		vsHandler.SetObjectStoreRepositoryGetter(func() (*volsync.ObjectStoreRepository, error) {
+			return repository, nil
+		})
+	}
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, testNamespace)).To(Succeed())
+	})
+
+	Context("With the Restic mover", func() {
+		BeforeEach(func() {
+			setupOwner(ramendrv1alpha1.VolSyncSpec{Mover: ramendrv1alpha1.VolSyncMoverRestic})
+		})
+
+		It("Should create a scheduled Restic RD with its repository secret, and return RDInfo without address",
+			func() {
+				rdSpec := ramendrv1alpha1.VolSyncReplicationDestinationSpec{ProtectedPVC: protectedPVC}
+				rdSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+
+				rd, rdInfo, err := vsHandler.ReconcileRD(rdSpec, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rd).ToNot(BeNil())
+				Expect(rdInfo).ToNot(BeNil())
+				Expect(rdInfo.RsyncTLS).To(BeNil())
+
+				Expect(rd.Spec.RsyncTLS).To(BeNil())
+				Expect(rd.Spec.Restic).ToNot(BeNil())
+				Expect(rd.Spec.Restic.Repository).To(Equal("mytestpvc-rd-repository"))
+				Expect(*rd.Spec.Restic.VolumeSnapshotClassName).To(Equal(testVolumeSnapshotClassName))
+				Expect(rd.Spec.Trigger).ToNot(BeNil())
+				Expect(*rd.Spec.Trigger.Schedule).To(Equal("*/5 * * * *"))
+
+				secret := &corev1.Secret{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{
+					Name: rd.Spec.Restic.Repository, Namespace: testNamespace.GetName(),
+				}, secret)).To(Succeed())
+				Expect(string(secret.Data["RESTIC_REPOSITORY"])).To(Equal(fmt.Sprintf(
+					"s3:https://s3.example.com/bucket/%[1]s/vrg/volsync/%[1]s/mytestpvc", testNamespace.GetName())))
+				Expect(string(secret.Data["RESTIC_PASSWORD"])).To(Equal("volsyncramen:0123456789abcdef"))
+				Expect(string(secret.Data["AWS_ACCESS_KEY_ID"])).To(Equal("access"))
+				Expect(ownerMatches(secret, rd.GetName(), "ReplicationDestination", true)).To(BeTrue())
+			})
+
+		It("Should restore from the repository before ensuring the PVC from the RD", func() {
+			rdSpec := ramendrv1alpha1.VolSyncReplicationDestinationSpec{ProtectedPVC: protectedPVC}
+			rdSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+
+			_, _, err := vsHandler.ReconcileRD(rdSpec, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(vsHandler.EnsurePVCfromRD(rdSpec, true)).To(HaveOccurred())
+
+			rd := &volsyncv1alpha1.ReplicationDestination{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: rdSpec.ProtectedPVC.Name, Namespace: testNamespace.GetName(),
+			}, rd)).To(Succeed())
+			Expect(rd.Spec.Trigger).ToNot(BeNil())
+			Expect(rd.Spec.Trigger.Manual).To(Equal(fmt.Sprintf("%s-%d", volsync.RestoreTriggerString,
+				owner.GetGeneration())))
+		})
+	})
+
+	Context("With the Rclone mover", func() {
+		BeforeEach(func() {
+			setupOwner(ramendrv1alpha1.VolSyncSpec{Mover: ramendrv1alpha1.VolSyncMoverRclone})
+		})
+
+		It("Should create an Rclone RS without a remote address", func() {
+			rsSpec := ramendrv1alpha1.VolSyncReplicationSourceSpec{ProtectedPVC: protectedPVC}
+			rsSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+
+			_, rs, err := vsHandler.ReconcileRS(rsSpec, false, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).ToNot(BeNil())
+
+			Expect(rs.Spec.RsyncTLS).To(BeNil())
+			Expect(rs.Spec.Rclone).ToNot(BeNil())
+			Expect(*rs.Spec.Rclone.RcloneConfig).To(Equal("mytestpvc-rs-repository"))
+			Expect(*rs.Spec.Rclone.RcloneDestPath).To(Equal(fmt.Sprintf("bucket/%[1]s/vrg/volsync/%[1]s/mytestpvc",
+				testNamespace.GetName())))
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: *rs.Spec.Rclone.RcloneConfig, Namespace: testNamespace.GetName(),
+			}, secret)).To(Succeed())
+			Expect(string(secret.Data["rclone.conf"])).To(ContainSubstring("endpoint = https://s3.example.com/"))
+			Expect(ownerMatches(secret, rs.GetName(), "ReplicationSource", true)).To(BeTrue())
+		})
+
+		It("Should schedule the RS at the scheduling interval of the PVC", func() {
+			rsSpec := ramendrv1alpha1.VolSyncReplicationSourceSpec{ProtectedPVC: protectedPVC}
+			rsSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+			rsSpec.ProtectedPVC.SchedulingInterval = "2h"
+
+			_, rs, err := vsHandler.ReconcileRS(rsSpec, false, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).ToNot(BeNil())
+			Expect(rs.Spec.Trigger).ToNot(BeNil())
+			Expect(*rs.Spec.Trigger.Schedule).To(Equal("0 */2 * * *"))
+		})
+	})
+
+	Context("With the Rclone mover, a bandwidth limit and a sync window", func() {
+		BeforeEach(func() {
+			bandwidthLimit := resource.MustParse("80M")
+			setupOwner(ramendrv1alpha1.VolSyncSpec{
+				Mover:          ramendrv1alpha1.VolSyncMoverRclone,
+				BandwidthLimit: &bandwidthLimit,
+				SyncWindows:    []ramendrv1alpha1.SyncWindow{{Start: "22:00", End: "06:00"}},
+			})
+		})
+
+		It("Should limit the bandwidth of the mover and schedule the RS within the window", func() {
+			rsSpec := ramendrv1alpha1.VolSyncReplicationSourceSpec{ProtectedPVC: protectedPVC}
+			rsSpec.ProtectedPVC.Namespace = testNamespace.GetName()
+
+			_, rs, err := vsHandler.ReconcileRS(rsSpec, false, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs).ToNot(BeNil())
+			Expect(*rs.Spec.Trigger.Schedule).To(Equal("*/5 0,1,2,3,4,5,22,23 * * *"))
+
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name: *rs.Spec.Rclone.RcloneConfig, Namespace: testNamespace.GetName(),
+			}, secret)).To(Succeed())
+			Expect(string(secret.Data["RCLONE_BWLIMIT"])).To(Equal("9765K"))
+		})
+
+		It("Should report syncs deferred to the window outside of it", func() {
+			Expect(vsHandler.SyncDeferredUntil(time.Date(2024, 1, 1, 23, 30, 0, 0, time.UTC))).To(BeNil())
+
+			deferredUntil := vsHandler.SyncDeferredUntil(time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC))
+			Expect(deferredUntil).ToNot(BeNil())
+			Expect(deferredUntil.Time).To(Equal(time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC)))
+		})
+	})
+})
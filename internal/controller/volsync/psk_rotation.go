@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// A pre-shared key is rotated in three steps, each taken once the secret of the previous one is
+// on all the clusters, and a sync has completed since, so that the movers started before it
+// have exited:
+//  1. KeyAdded: the new key is added after the current one, which the ReplicationSources keep
+//     using, while the ReplicationDestinations accept both.
+//  2. KeySwitched: the new key is moved first, so that the ReplicationSources use it.
+//  3. The current key is removed.
+const (
+	// PSKRotationStepAnnotation records, on the hub secret, the step of an ongoing rotation
+	PSKRotationStepAnnotation = "ramendr.openshift.io/volsync-psk-rotation-step"
+
+	// PSKRotationStepSyncedAnnotation records, on the hub secret, when the secret of the step of
+	// an ongoing rotation was first found on all the clusters
+	PSKRotationStepSyncedAnnotation = "ramendr.openshift.io/volsync-psk-rotation-step-synced"
+
+	// PSKRotationTimeAnnotation records, on the hub secret, when its key was last rotated
+	PSKRotationTimeAnnotation = "ramendr.openshift.io/volsync-psk-rotation-time"
+
+	PSKRotationStepKeyAdded    = "KeyAdded"
+	PSKRotationStepKeySwitched = "KeySwitched"
+
+	DefaultPSKRotationInterval = 90 * 24 * time.Hour
+
+	pskIdentityPrefix = "volsyncramen"
+)
+
+// PSKRotation is the state of the clusters a pre-shared key secret is propagated to
+type PSKRotation struct {
+	// Interval at which the key is rotated, or 0 to not rotate it
+	Interval time.Duration
+
+	// ClusterIdentities are the identities of the keys of the secret on each cluster, as
+	// reported by its VRG
+	ClusterIdentities [][]string
+
+	// LastSyncTime is the time of the most recent sync of all the PVCs
+	LastSyncTime *metav1.Time
+
+	Now time.Time
+}
+
+// PSKIdentities returns the identities of the keys of a pre-shared key secret, in order. The
+// first is the one ReplicationSources use.
+func PSKIdentities(secret *corev1.Secret) []string {
+	identities := []string{}
+
+	for _, line := range pskLines(secret) {
+		identity, _, _ := strings.Cut(line, ":")
+		identities = append(identities, identity)
+	}
+
+	return identities
+}
+
+func pskLines(secret *corev1.Secret) []string {
+	lines := []string{}
+
+	for _, line := range strings.Split(string(secret.Data[pskSecretKey]), "\n") {
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// GetPSKIdentities returns the identities of the keys of the pre-shared key secret of the VRG,
+// or nil if there is no secret.
+func (v *VSHandler) GetPSKIdentities() ([]string, error) {
+	secret := &corev1.Secret{}
+	secretName := GetVolSyncPSKSecretNameFromVRGName(v.owner.GetName())
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: secretName, Namespace: v.owner.GetNamespace()}, secret)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get psk secret %s: %w", secretName, err)
+	}
+
+	return PSKIdentities(secret), nil
+}
+
+// PSKRotationTime returns the time at which the key of a hub secret was last rotated, or
+// created.
+func PSKRotationTime(secret *corev1.Secret) metav1.Time {
+	rotationTime, err := time.Parse(time.RFC3339, secret.GetAnnotations()[PSKRotationTimeAnnotation])
+	if err != nil {
+		return secret.GetCreationTimestamp()
+	}
+
+	return metav1.NewTime(rotationTime)
+}
+
+// RotateVolSyncReplicationSecret takes the next step of the rotation of the key of a hub secret,
+// if it is due, and returns the time at which the key was last rotated.
+func RotateVolSyncReplicationSecret(ctx context.Context, k8sClient client.Client, secret *corev1.Secret,
+	rotation PSKRotation, log logr.Logger,
+) (metav1.Time, error) {
+	rotationTime := PSKRotationTime(secret)
+	identities := PSKIdentities(secret)
+
+	if len(identities) == 0 {
+		return rotationTime, nil
+	}
+
+	for _, clusterIdentities := range rotation.ClusterIdentities {
+		if !slices.Equal(clusterIdentities, identities) {
+			return rotationTime, nil
+		}
+	}
+
+	annotations := secret.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	step := annotations[PSKRotationStepAnnotation]
+
+	if step != "" {
+		synced, err := time.Parse(time.RFC3339, annotations[PSKRotationStepSyncedAnnotation])
+		if err != nil {
+			annotations[PSKRotationStepSyncedAnnotation] = rotation.Now.UTC().Format(time.RFC3339)
+
+			return rotationTime, updatePSKSecret(ctx, k8sClient, secret, annotations, pskLines(secret))
+		}
+
+		if rotation.LastSyncTime == nil || !rotation.LastSyncTime.After(synced) {
+			return rotationTime, nil
+		}
+	}
+
+	lines := pskLines(secret)
+
+	switch step {
+	case "":
+		if rotation.Interval <= 0 || rotation.Now.Before(rotationTime.Add(rotation.Interval)) {
+			return rotationTime, nil
+		}
+
+		tlsKey, err := genTLSPreSharedKey(log)
+		if err != nil {
+			return rotationTime, err
+		}
+
+		lines = append(lines, fmt.Sprintf("%s-%d:%s", pskIdentityPrefix, rotation.Now.Unix(), tlsKey))
+		annotations[PSKRotationStepAnnotation] = PSKRotationStepKeyAdded
+	case PSKRotationStepKeyAdded:
+		lines = append(lines[len(lines)-1:], lines[:len(lines)-1]...)
+		annotations[PSKRotationStepAnnotation] = PSKRotationStepKeySwitched
+	default:
+		lines = lines[:1]
+		rotationTime = metav1.NewTime(rotation.Now)
+		annotations[PSKRotationTimeAnnotation] = rotation.Now.UTC().Format(time.RFC3339)
+
+		delete(annotations, PSKRotationStepAnnotation)
+	}
+
+	delete(annotations, PSKRotationStepSyncedAnnotation)
+
+	log.Info("Rotating volsync psk secret", "secretName", secret.GetName(),
+		"step", annotations[PSKRotationStepAnnotation])
+
+	return rotationTime, updatePSKSecret(ctx, k8sClient, secret, annotations, lines)
+}
+
+func updatePSKSecret(ctx context.Context, k8sClient client.Client, secret *corev1.Secret,
+	annotations map[string]string, lines []string,
+) error {
+	secret.SetAnnotations(annotations)
+	secret.Data[pskSecretKey] = []byte(strings.Join(lines, "\n"))
+
+	if err := k8sClient.Update(ctx, secret); err != nil {
+		return fmt.Errorf("failed to update psk secret %s: %w", secret.GetName(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("PSK rotation", func() {
+	var testNamespace *corev1.Namespace
+	var secret *corev1.Secret
+
+	rotationInterval := time.Hour
+
+	rotate := func(rotation volsync.PSKRotation) metav1.Time {
+		rotationTime, err := volsync.RotateVolSyncReplicationSecret(ctx, k8sClient, secret, rotation, logger)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+
+		return rotationTime
+	}
+
+	BeforeEach(func() {
+		testNamespace = &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "psk-rotation-test-",
+			},
+		}
+		Expect(k8sClient.Create(ctx, testNamespace)).To(Succeed())
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-secret-hub",
+				Namespace: testNamespace.GetName(),
+			},
+			StringData: map[string]string{"psk.txt": "volsyncramen:0123456789abcdef"},
+		}
+		Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(ctx, testNamespace)).To(Succeed())
+	})
+
+	Context("When the rotation interval has not elapsed", func() {
+		It("Should leave the secret unchanged", func() {
+			rotationTime := rotate(volsync.PSKRotation{
+				Interval:          rotationInterval,
+				ClusterIdentities: [][]string{{"volsyncramen"}, {"volsyncramen"}},
+				Now:               time.Now(),
+			})
+
+			Expect(rotationTime).To(Equal(secret.GetCreationTimestamp()))
+			Expect(volsync.PSKIdentities(secret)).To(Equal([]string{"volsyncramen"}))
+		})
+	})
+
+	Context("When the rotation interval has elapsed", func() {
+		It("Should not rotate the key until the secret is on all the clusters", func() {
+			rotate(volsync.PSKRotation{
+				Interval:          rotationInterval,
+				ClusterIdentities: [][]string{{"volsyncramen"}, nil},
+				Now:               time.Now().Add(2 * rotationInterval),
+			})
+
+			Expect(volsync.PSKIdentities(secret)).To(Equal([]string{"volsyncramen"}))
+		})
+
+		It("Should rotate the key in steps paced by the clusters and the syncs", func() {
+			now := time.Now().Add(2 * rotationInterval)
+			rotate(volsync.PSKRotation{
+				Interval:          rotationInterval,
+				ClusterIdentities: [][]string{{"volsyncramen"}, {"volsyncramen"}},
+				Now:               now,
+			})
+
+			identities := volsync.PSKIdentities(secret)
+			Expect(identities).To(HaveLen(2))
+			Expect(identities[0]).To(Equal("volsyncramen"))
+			Expect(secret.GetAnnotations()[volsync.PSKRotationStepAnnotation]).
+				To(Equal(volsync.PSKRotationStepKeyAdded))
+
+			// The clusters have the new secret, which is recorded, but no sync has completed since
+			lastSyncTime := metav1.NewTime(now)
+			rotation := volsync.PSKRotation{
+				Interval:          rotationInterval,
+				ClusterIdentities: [][]string{identities, identities},
+				LastSyncTime:      &lastSyncTime,
+				Now:               now.Add(time.Minute),
+			}
+			rotate(rotation)
+			rotate(rotation)
+			Expect(volsync.PSKIdentities(secret)).To(Equal(identities))
+
+			// A sync has completed since, so the ReplicationSources are switched to the new key
+			lastSyncTime = metav1.NewTime(now.Add(2 * time.Minute))
+			rotation.Now = now.Add(3 * time.Minute)
+			rotate(rotation)
+
+			switched := []string{identities[1], identities[0]}
+			Expect(volsync.PSKIdentities(secret)).To(Equal(switched))
+			Expect(secret.GetAnnotations()[volsync.PSKRotationStepAnnotation]).
+				To(Equal(volsync.PSKRotationStepKeySwitched))
+
+			rotation.ClusterIdentities = [][]string{switched, switched}
+			rotate(rotation)
+
+			lastSyncTime = metav1.NewTime(now.Add(4 * time.Minute))
+			rotation.Now = now.Add(5 * time.Minute)
+			rotationTime := rotate(rotation)
+
+			Expect(volsync.PSKIdentities(secret)).To(Equal([]string{identities[1]}))
+			Expect(secret.GetAnnotations()).NotTo(HaveKey(volsync.PSKRotationStepAnnotation))
+			Expect(rotationTime.Unix()).To(Equal(rotation.Now.Unix()))
+			Expect(volsync.PSKRotationTime(secret).Unix()).To(Equal(rotation.Now.Unix()))
+		})
+	})
+})
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	// RecoveryPointLabel marks the VolumeSnapshots of the destinations of PVCs that are retained
+	// as recovery points. They are also marked do-not-delete, so that VolSync does not delete
+	// them once they are superseded.
+	RecoveryPointLabel    = "volsync.ramendr.openshift.io/recovery-point"
+	RecoveryPointLabelVal = "true"
+
+	// RecoveryPointPVCAnnotation is the name of the PVC of which a VolumeSnapshot is a recovery point
+	RecoveryPointPVCAnnotation = "volsync.ramendr.openshift.io/recovery-point-pvc"
+)
+
+// RetainedRecoveryPoints returns whether the retention retains each of the images of a PVC, given
+// the times at which they were taken, from the most recent. The most recent is always retained,
+// unless there is no retention.
+func RetainedRecoveryPoints(times []time.Time, retention *ramendrv1alpha1.VolSyncSnapshotRetention) []bool {
+	retained := make([]bool, len(times))
+	if retention == nil || len(times) == 0 {
+		return retained
+	}
+
+	retained[0] = true
+
+	for idx := 0; idx < len(times) && idx < int(retention.Last); idx++ {
+		retained[idx] = true
+	}
+
+	retainLastOfPeriods(times, retained, int(retention.Hourly), func(t time.Time) time.Time {
+		return t.UTC().Truncate(time.Hour)
+	})
+
+	retainLastOfPeriods(times, retained, int(retention.Daily), func(t time.Time) time.Time {
+		year, month, day := t.UTC().Date()
+
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	})
+
+	return retained
+}
+
+// retainLastOfPeriods retains the most recent image of each of the most recent periods with images,
+// given the start of the period of a time
+func retainLastOfPeriods(times []time.Time, retained []bool, periods int, periodStart func(time.Time) time.Time) {
+	var lastPeriodStart time.Time
+
+	for idx := 0; idx < len(times) && periods > 0; idx++ {
+		start := periodStart(times[idx])
+		if idx > 0 && start.Equal(lastPeriodStart) {
+			continue
+		}
+
+		retained[idx] = true
+		lastPeriodStart = start
+		periods--
+	}
+}
+
+// ReconcileRecoveryPoints retains the latest image of the destination of a PVC as a recovery point,
+// if there is a retention, and deletes the recovery points of the PVC that it no longer retains.
+// The latest image is never deleted, as the PVC is restored from it on failover.
+func (v *VSHandler) ReconcileRecoveryPoints(pvcName, pvcNamespace string,
+	retention *ramendrv1alpha1.VolSyncSnapshotRetention,
+) error {
+	latestImage, err := v.getRDLatestImage(pvcName, pvcNamespace)
+	if err != nil {
+		return err
+	}
+
+	latestImageName := ""
+	if isLatestImageReady(latestImage) {
+		latestImageName = latestImage.Name
+	}
+
+	if retention != nil && latestImageName != "" {
+		if err := v.retainRecoveryPoint(latestImageName, pvcName, pvcNamespace); err != nil {
+			return err
+		}
+	}
+
+	snapshots, err := v.listRecoveryPointSnapshots(pvcName, pvcNamespace)
+	if err != nil {
+		return err
+	}
+
+	times := make([]time.Time, len(snapshots))
+	for idx := range snapshots {
+		times[idx] = snapshots[idx].CreationTimestamp.Time
+	}
+
+	retained := RetainedRecoveryPoints(times, retention)
+
+	unretained := []snapv1.VolumeSnapshot{}
+
+	for idx := range snapshots {
+		if !retained[idx] && snapshots[idx].GetName() != latestImageName {
+			unretained = append(unretained, snapshots[idx])
+		}
+	}
+
+	return v.deleteVolumeSnapshots(unretained)
+}
+
+// ListRecoveryPoints returns the recovery points of a PVC, from the most recent
+func (v *VSHandler) ListRecoveryPoints(pvcName, pvcNamespace string,
+) ([]ramendrv1alpha1.VolSyncRecoveryPoint, error) {
+	snapshots, err := v.listRecoveryPointSnapshots(pvcName, pvcNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]ramendrv1alpha1.VolSyncRecoveryPoint, 0, len(snapshots))
+	for idx := range snapshots {
+		points = append(points, ramendrv1alpha1.VolSyncRecoveryPoint{
+			SnapshotName: snapshots[idx].GetName(),
+			Time:         snapshots[idx].CreationTimestamp,
+		})
+	}
+
+	return points, nil
+}
+
+func (v *VSHandler) retainRecoveryPoint(snapshotName, pvcName, pvcNamespace string) error {
+	volSnap := &snapv1.VolumeSnapshot{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: snapshotName, Namespace: pvcNamespace}, volSnap)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil // Superseded and deleted, the next latest image is retained instead
+		}
+
+		return fmt.Errorf("error getting volumesnapshot %s (%w)", snapshotName, err)
+	}
+
+	err = util.NewResourceUpdater(volSnap).
+		AddLabel(util.VRGOwnerNameLabel, v.owner.GetName()).
+		AddLabel(util.VRGOwnerNamespaceLabel, v.owner.GetNamespace()).
+		AddLabel(VolSyncDoNotDeleteLabel, VolSyncDoNotDeleteLabelVal).
+		AddLabel(RecoveryPointLabel, RecoveryPointLabelVal).
+		AddAnnotation(RecoveryPointPVCAnnotation, pvcName).
+		Update(v.ctx, v.client)
+	if err != nil {
+		return fmt.Errorf("failed to retain volumesnapshot %s as a recovery point (%w)", snapshotName, err)
+	}
+
+	return nil
+}
+
+// listRecoveryPointSnapshots returns the VolumeSnapshots of the recovery points of a PVC, from the
+// most recent
+func (v *VSHandler) listRecoveryPointSnapshots(pvcName, pvcNamespace string) ([]snapv1.VolumeSnapshot, error) {
+	snapList := &snapv1.VolumeSnapshotList{}
+
+	err := v.client.List(v.ctx, snapList,
+		client.InNamespace(pvcNamespace),
+		client.MatchingLabels{
+			util.VRGOwnerNameLabel:      v.owner.GetName(),
+			util.VRGOwnerNamespaceLabel: v.owner.GetNamespace(),
+			RecoveryPointLabel:          RecoveryPointLabelVal,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("error listing recovery points of PVC %s (%w)", pvcName, err)
+	}
+
+	snapshots := slices.DeleteFunc(snapList.Items, func(snapshot snapv1.VolumeSnapshot) bool {
+		return snapshot.GetAnnotations()[RecoveryPointPVCAnnotation] != pvcName
+	})
+
+	slices.SortFunc(snapshots, func(a, b snapv1.VolumeSnapshot) int {
+		return b.CreationTimestamp.Compare(a.CreationTimestamp.Time)
+	})
+
+	return snapshots, nil
+}
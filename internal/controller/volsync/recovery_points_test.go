@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("VolSync recovery points", func() {
+	now := time.Date(2025, time.March, 10, 12, 30, 0, 0, time.UTC)
+
+	// Images every 20 minutes, from the most recent, back to 2 days and 1 hour ago
+	times := []time.Time{}
+	for age := time.Duration(0); age <= 49*time.Hour; age += 20 * time.Minute {
+		times = append(times, now.Add(-age))
+	}
+
+	retainedTimes := func(retention *ramendrv1alpha1.VolSyncSnapshotRetention) []time.Time {
+		retained := []time.Time{}
+
+		for idx, isRetained := range volsync.RetainedRecoveryPoints(times, retention) {
+			if isRetained {
+				retained = append(retained, times[idx])
+			}
+		}
+
+		return retained
+	}
+
+	It("Should retain no image without a retention", func() {
+		Expect(retainedTimes(nil)).To(BeEmpty())
+	})
+	It("Should always retain the most recent image", func() {
+		Expect(retainedTimes(&ramendrv1alpha1.VolSyncSnapshotRetention{})).To(Equal([]time.Time{now}))
+	})
+	It("Should retain the last images", func() {
+		Expect(retainedTimes(&ramendrv1alpha1.VolSyncSnapshotRetention{Last: 3})).To(Equal([]time.Time{
+			now, now.Add(-20 * time.Minute), now.Add(-40 * time.Minute),
+		}))
+	})
+	It("Should retain the last image of each hour and day", func() {
+		Expect(retainedTimes(&ramendrv1alpha1.VolSyncSnapshotRetention{Hourly: 3, Daily: 3})).To(Equal([]time.Time{
+			now,
+			now.Add(-40 * time.Minute),              // 11:50
+			now.Add(-100 * time.Minute),             // 10:50
+			now.Add(-12*time.Hour - 40*time.Minute), // 23:50 of the day before
+			now.Add(-36*time.Hour - 40*time.Minute), // 23:50 of the day before that
+		}))
+	})
+})
@@ -82,7 +82,7 @@ func generateNewVolSyncReplicationSecret(secretName, secretNamespace string, log
 			},
 		},
 		StringData: map[string]string{
-			"psk.txt": "volsyncramen:" + tlsKey,
+			pskSecretKey: pskIdentityPrefix + ":" + tlsKey,
 		},
 	}
 
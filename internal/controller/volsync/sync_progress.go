@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	// moverProgressLogLines is the number of the most recent lines of the logs of a mover that
+	// are searched for its progress. Restic reports its progress every 10s, and Rclone every 20s,
+	// among its debug logs.
+	moverProgressLogLines = 200
+	secondsPerMinute      = 60
+	rsyncSummaryUnits     = "KMGT"
+)
+
+var (
+	// [0:10] 12.34%  3 files 1.234 GiB, total 25 files 10.000 GiB, 0 errors ETA 1:20:00
+	resticProgressRegexp = regexp.MustCompile(
+		`\d+ files ([\d.]+ [KMGTP]?i?B), total \d+ files ([\d.]+ [KMGTP]?i?B), \d+ errors(?: ETA ([\d:]+))?`)
+
+	// Transferred:   	    1.234 GiB / 10.000 GiB, 12%, 10.000 MiB/s, ETA 15m0s
+	rcloneProgressRegexp = regexp.MustCompile(
+		`Transferred:\s+([\d.]+ [KMGTP]?i?B) / ([\d.]+ [KMGTP]?i?B), [^,]*, [^,]*, ETA (\S+)`)
+
+	// sent 833.81K bytes  received 397.18K bytes  30.39K bytes/sec, in the human-readable format of
+	// the rsync-TLS mover
+	rsyncSummaryRegexp = regexp.MustCompile(
+		`[sS]ent ([\d.,]+)([KMGT]?) bytes\s+[rR]eceived ([\d.,]+)([KMGT]?) bytes`)
+)
+
+// MoverProgress is the progress of the transfer of a mover, as reported in its logs
+type MoverProgress struct {
+	BytesTransferred *int64
+	TotalBytes       *int64
+	Remaining        *time.Duration
+}
+
+// SyncProgress returns the progress of the sync in progress of a PVC, or nil if it is not syncing.
+// The transfer of the sync is parsed from the logs of its mover.
+func (v *VSHandler) SyncProgress(pvcName, pvcNamespace string, now time.Time,
+) (*ramendrv1alpha1.VolSyncSyncProgress, error) {
+	return v.replicationSourceSyncProgress(getReplicationSourceName(pvcName), pvcNamespace, v.mover, now)
+}
+
+// GroupMemberSyncProgress returns the progress of the sync in progress of a PVC of a consistency
+// group by the ReplicationSource of its restored PVC, or nil if it is not syncing. The PVCs of a
+// group are replicated with the rsync-TLS mover.
+func (v *VSHandler) GroupMemberSyncProgress(rsName, namespace string, now time.Time,
+) (*ramendrv1alpha1.VolSyncSyncProgress, error) {
+	return v.replicationSourceSyncProgress(rsName, namespace, ramendrv1alpha1.VolSyncMoverRsyncTLS, now)
+}
+
+//nolint:cyclop
+func (v *VSHandler) replicationSourceSyncProgress(rsName, namespace string,
+	mover ramendrv1alpha1.VolSyncMoverType, now time.Time,
+) (*ramendrv1alpha1.VolSyncSyncProgress, error) {
+	rs := &volsyncv1alpha1.ReplicationSource{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: rsName, Namespace: namespace}, rs)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("error getting replicationsource %s (%w)", rsName, err)
+	}
+
+	if rs.Status == nil {
+		return nil, nil
+	}
+
+	synchronizing := meta.FindStatusCondition(rs.Status.Conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if synchronizing == nil || synchronizing.Status != metav1.ConditionTrue {
+		return nil, nil
+	}
+
+	progress := &ramendrv1alpha1.VolSyncSyncProgress{StartTime: rs.Status.LastSyncStartTime}
+
+	switch synchronizing.Reason {
+	case volsyncv1alpha1.SynchronizingReasonSync:
+		progress.Phase = ramendrv1alpha1.VolSyncSyncPhasePreparing
+	case volsyncv1alpha1.SynchronizingReasonCleanup:
+		progress.Phase = ramendrv1alpha1.VolSyncSyncPhaseCleaningUp
+
+		return progress, nil
+	default:
+		return nil, nil
+	}
+
+	pod, err := v.runningMoverPod(rs.GetName(), namespace, mover)
+	if err != nil || pod == nil {
+		return progress, err
+	}
+
+	progress.Phase = ramendrv1alpha1.VolSyncSyncPhaseTransferring
+
+	moverProgress, err := v.getMoverProgress(pod, mover)
+	if err != nil {
+		return progress, err
+	}
+
+	progress.BytesTransferred = moverProgress.BytesTransferred
+	progress.TotalBytes = moverProgress.TotalBytes
+
+	remaining := moverProgress.Remaining
+	if remaining == nil {
+		remaining = remainingFromLastSyncDuration(rs.Status, now)
+	}
+
+	if remaining != nil {
+		progress.EstimatedCompletionTime = &metav1.Time{Time: now.Add(*remaining).Truncate(time.Second)}
+	}
+
+	return progress, nil
+}
+
+// remainingFromLastSyncDuration estimates the time remaining of a sync that takes as long as the
+// previous sync, for movers that do not report it, or nil if the previous sync took less time.
+func remainingFromLastSyncDuration(status *volsyncv1alpha1.ReplicationSourceStatus, now time.Time,
+) *time.Duration {
+	if status.LastSyncStartTime == nil || status.LastSyncDuration == nil {
+		return nil
+	}
+
+	remaining := status.LastSyncStartTime.Add(status.LastSyncDuration.Duration).Sub(now)
+	if remaining <= 0 {
+		return nil
+	}
+
+	return &remaining
+}
+
+// moverJobName returns the name of the job of the mover of a ReplicationSource
+func moverJobName(rsName string, mover ramendrv1alpha1.VolSyncMoverType) string {
+	switch mover {
+	case ramendrv1alpha1.VolSyncMoverRestic:
+		return util.GetJobName("volsync-src-", rsName)
+	case ramendrv1alpha1.VolSyncMoverRclone:
+		return util.GetJobName("volsync-rclone-src-", rsName)
+	default:
+		return util.GetJobName("volsync-rsync-tls-src-", rsName)
+	}
+}
+
+func (v *VSHandler) runningMoverPod(rsName, namespace string, mover ramendrv1alpha1.VolSyncMoverType,
+) (*corev1.Pod, error) {
+	pods := &corev1.PodList{}
+
+	if err := v.client.List(v.ctx, pods, client.InNamespace(namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: moverJobName(rsName, mover)}); err != nil {
+		return nil, fmt.Errorf("failed to list mover pods of replicationsource %s: %w", rsName, err)
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodRunning {
+			return &pods.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// getMoverProgress parses the progress of a mover from its logs. The most recent lines are
+// enough for Restic and Rclone, which report their progress periodically, but the rsync-TLS
+// mover reports the bytes it sent only at the end of each of its rsync passes.
+func (v *VSHandler) getMoverProgress(pod *corev1.Pod, mover ramendrv1alpha1.VolSyncMoverType,
+) (MoverProgress, error) {
+	options := &corev1.PodLogOptions{}
+	if mover == ramendrv1alpha1.VolSyncMoverRestic || mover == ramendrv1alpha1.VolSyncMoverRclone {
+		options.TailLines = ptr.To(int64(moverProgressLogLines))
+	}
+
+	logs, err := v.streamPodLogs(pod, options)
+	if err != nil {
+		return MoverProgress{}, err
+	}
+	defer logs.Close()
+
+	return ParseMoverProgress(mover, bufio.NewScanner(logs))
+}
+
+// ParseMoverProgress parses the most recent progress reported in the lines of the logs of a
+// Restic or Rclone mover, or the bytes sent by the passes of an rsync-TLS mover. Its fields are
+// nil if no progress is reported, or if they are unknown, for example while Restic is still
+// scanning the files to transfer.
+func ParseMoverProgress(mover ramendrv1alpha1.VolSyncMoverType, scanner *bufio.Scanner) (MoverProgress, error) {
+	if mover != ramendrv1alpha1.VolSyncMoverRestic && mover != ramendrv1alpha1.VolSyncMoverRclone {
+		return parseRsyncProgress(scanner)
+	}
+
+	progressRegexp := resticProgressRegexp
+	parseRemaining := parseResticRemaining
+
+	if mover == ramendrv1alpha1.VolSyncMoverRclone {
+		progressRegexp = rcloneProgressRegexp
+		parseRemaining = parseRcloneRemaining
+	}
+
+	progress := MoverProgress{}
+
+	for scanner.Scan() {
+		match := progressRegexp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+
+		progress = MoverProgress{
+			BytesTransferred: parseProgressBytes(match[1]),
+			TotalBytes:       parseProgressBytes(match[2]),
+			Remaining:        parseRemaining(match[3]),
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return MoverProgress{}, fmt.Errorf("failed to read mover logs: %w", err)
+	}
+
+	if progress.TotalBytes != nil && *progress.TotalBytes == 0 {
+		progress.TotalBytes = nil // Unknown while the files to transfer are being scanned
+	}
+
+	return progress, nil
+}
+
+func parseRsyncProgress(scanner *bufio.Scanner) (MoverProgress, error) {
+	progress := MoverProgress{}
+
+	for scanner.Scan() {
+		if sent := RsyncTransferredBytes(scanner.Text(), false); sent != nil {
+			progress.BytesTransferred = ptr.To(ptr.Deref(progress.BytesTransferred, 0) + *sent)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return MoverProgress{}, fmt.Errorf("failed to read mover logs: %w", err)
+	}
+
+	return progress, nil
+}
+
+// RsyncTransferredBytes returns the number of bytes sent, or received, by the rsync transfers
+// whose summaries are in the logs of an rsync-TLS mover, or nil if the logs have none.
+func RsyncTransferredBytes(logs string, received bool) *int64 {
+	var total *int64
+
+	for _, match := range rsyncSummaryRegexp.FindAllStringSubmatch(logs, -1) {
+		value, unit := match[1], match[2]
+		if received {
+			value, unit = match[3], match[4]
+		}
+
+		count, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+		if err != nil {
+			continue
+		}
+
+		multiplier := float64(1)
+		if unit != "" {
+			multiplier = math.Pow(bytesPerKibibyte, float64(strings.Index(rsyncSummaryUnits, unit)+1))
+		}
+
+		total = ptr.To(ptr.Deref(total, 0) + int64(count*multiplier))
+	}
+
+	return total
+}
+
+// parseProgressBytes parses a size such as "1.234 GiB", in the binary units of Restic and Rclone
+func parseProgressBytes(size string) *int64 {
+	number, unit, ok := strings.Cut(size, " ")
+	if !ok {
+		return nil
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return nil
+	}
+
+	multiplier := float64(1)
+
+	for _, prefix := range []string{"K", "M", "G", "T", "P"} {
+		multiplier *= bytesPerKibibyte
+
+		if strings.HasPrefix(unit, prefix) {
+			value *= multiplier
+
+			break
+		}
+	}
+
+	return ptr.To(int64(value))
+}
+
+// parseResticRemaining parses a time remaining such as "1:20:00" or "20:00"
+func parseResticRemaining(remaining string) *time.Duration {
+	if remaining == "" {
+		return nil
+	}
+
+	seconds := 0
+
+	for _, field := range strings.Split(remaining, ":") {
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil
+		}
+
+		seconds = seconds*secondsPerMinute + value
+	}
+
+	return ptr.To(time.Duration(seconds) * time.Second)
+}
+
+// parseRcloneRemaining parses a time remaining such as "15m0s", or "-" if it is unknown
+func parseRcloneRemaining(remaining string) *time.Duration {
+	duration, err := time.ParseDuration(remaining)
+	if err != nil {
+		return nil
+	}
+
+	return &duration
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"time"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("VolSync mover progress", func() {
+	parse := func(mover ramendrv1alpha1.VolSyncMoverType, logs string) volsync.MoverProgress {
+		progress, err := volsync.ParseMoverProgress(mover, bufio.NewScanner(strings.NewReader(logs)))
+		Expect(err).NotTo(HaveOccurred())
+
+		return progress
+	}
+
+	It("Should parse the most recent progress of Restic", func() {
+		progress := parse(ramendrv1alpha1.VolSyncMoverRestic, `
+using parent snapshot 1c5f35a5
+[0:10] 10.00%  2 files 1.000 GiB, total 25 files 10.000 GiB, 0 errors ETA 1:30
+[0:20] 20.00%  5 files 2.000 GiB, total 25 files 10.000 GiB, 0 errors ETA 1:20:00
+`)
+		Expect(progress).To(Equal(volsync.MoverProgress{
+			BytesTransferred: ptr.To(int64(2 << 30)),
+			TotalBytes:       ptr.To(int64(10 << 30)),
+			Remaining:        ptr.To(80 * time.Minute),
+		}))
+	})
+	It("Should not report the total of Restic while it is scanning", func() {
+		progress := parse(ramendrv1alpha1.VolSyncMoverRestic,
+			"[0:10] 3 files 512 B, total 0 files 0 B, 0 errors\n")
+		Expect(progress).To(Equal(volsync.MoverProgress{BytesTransferred: ptr.To(int64(512))}))
+	})
+	It("Should parse the most recent progress of Rclone", func() {
+		progress := parse(ramendrv1alpha1.VolSyncMoverRclone, `
+Transferred:   	  512.000 MiB / 10.000 GiB, 5%, 10.000 MiB/s, ETA -
+Transferred:   	    1.500 GiB / 10.000 GiB, 15%, 10.000 MiB/s, ETA 14m30s
+Transferred:            3 / 25, 12%
+`)
+		Expect(progress).To(Equal(volsync.MoverProgress{
+			BytesTransferred: ptr.To(int64(3 << 29)),
+			TotalBytes:       ptr.To(int64(10 << 30)),
+			Remaining:        ptr.To(14*time.Minute + 30*time.Second),
+		}))
+	})
+	It("Should sum the bytes sent by the passes of rsync-TLS", func() {
+		progress := parse(ramendrv1alpha1.VolSyncMoverRsyncTLS, `
+.d..t...... ./
+>f+++++++++ file1
+sent 1,003 bytes  received 5,657 bytes  13,320.00 bytes/sec
+total size is 1,234  speedup is 0.19
+>f+++++++++ file2
+sent 2K bytes  received 1.5M bytes  30.39K bytes/sec
+`)
+		Expect(progress).To(Equal(volsync.MoverProgress{BytesTransferred: ptr.To(int64(1003 + 2*1024))}))
+	})
+	It("Should report no progress of rsync-TLS before a pass completes", func() {
+		Expect(parse(ramendrv1alpha1.VolSyncMoverRsyncTLS, ">f+++++++++ file1\n")).To(Equal(volsync.MoverProgress{}))
+	})
+	It("Should count the bytes of the rsync summaries without a unit", func() {
+		Expect(volsync.RsyncTransferredBytes("sent 512 bytes  received 2.5G bytes  1K bytes/sec", false)).
+			To(Equal(ptr.To(int64(512))))
+		Expect(volsync.RsyncTransferredBytes("sent 512 bytes  received 2.5G bytes  1K bytes/sec", true)).
+			To(Equal(ptr.To(int64(5 << 29))))
+		Expect(volsync.RsyncTransferredBytes("rsync completed in 2s", false)).To(BeNil())
+	})
+	It("Should report no progress if there is none", func() {
+		Expect(parse(ramendrv1alpha1.VolSyncMoverRclone, "rclone sync\n")).To(Equal(volsync.MoverProgress{}))
+	})
+})
+
+var _ = Describe("VolSync sync progress", func() {
+	var (
+		vsHandler *volsync.VSHandler
+		now       time.Time
+	)
+
+	newHandler := func(objects ...runtime.Object) *volsync.VSHandler {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(volsyncv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		vrg := &ramendrv1alpha1.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app"},
+			Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+				VolSync: ramendrv1alpha1.VolSyncSpec{Mover: ramendrv1alpha1.VolSyncMoverRestic},
+			},
+		}
+		handler := volsync.NewVSHandler(context.TODO(),
+			fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(), logger, vrg, nil,
+			"", "", false)
+		handler.SetPodsGetter(kubefake.NewSimpleClientset().CoreV1())
+
+		return handler
+	}
+
+	replicationSource := func(name string) *volsyncv1alpha1.ReplicationSource {
+		return &volsyncv1alpha1.ReplicationSource{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"},
+			Status: &volsyncv1alpha1.ReplicationSourceStatus{
+				LastSyncStartTime: &metav1.Time{Time: now.Add(-time.Minute)},
+				LastSyncDuration:  &metav1.Duration{Duration: 5 * time.Minute},
+				Conditions: []metav1.Condition{{
+					Type:   volsyncv1alpha1.ConditionSynchronizing,
+					Status: metav1.ConditionTrue,
+					Reason: volsyncv1alpha1.SynchronizingReasonSync,
+				}},
+			},
+		}
+	}
+
+	moverPod := func(jobName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      jobName + "-abcde",
+				Namespace: "app",
+				Labels:    map[string]string{batchv1.JobNameLabel: jobName},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	BeforeEach(func() {
+		now = time.Now().Truncate(time.Second)
+	})
+
+	It("Should find the ReplicationSource of a member of a group by its name", func() {
+		vsHandler = newHandler(replicationSource("restored-pvc"), moverPod("volsync-rsync-tls-src-restored-pvc"))
+
+		progress, err := vsHandler.SyncProgress("pvc", "app", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress).To(BeNil())
+
+		progress, err = vsHandler.GroupMemberSyncProgress("restored-pvc", "app", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress).NotTo(BeNil())
+		Expect(progress.Phase).To(Equal(ramendrv1alpha1.VolSyncSyncPhaseTransferring))
+	})
+
+	It("Should estimate the completion of rsync-TLS from the duration of the previous sync", func() {
+		vsHandler = newHandler(replicationSource("restored-pvc"), moverPod("volsync-rsync-tls-src-restored-pvc"))
+
+		progress, err := vsHandler.GroupMemberSyncProgress("restored-pvc", "app", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress.EstimatedCompletionTime).To(Equal(&metav1.Time{Time: now.Add(4 * time.Minute)}))
+	})
+
+	It("Should not estimate the completion of a sync that takes longer than the previous sync", func() {
+		rs := replicationSource("restored-pvc")
+		rs.Status.LastSyncStartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		vsHandler = newHandler(rs, moverPod("volsync-rsync-tls-src-restored-pvc"))
+
+		progress, err := vsHandler.GroupMemberSyncProgress("restored-pvc", "app", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress.EstimatedCompletionTime).To(BeNil())
+	})
+
+	It("Should report a sync that has not started its mover as preparing", func() {
+		vsHandler = newHandler(replicationSource("restored-pvc"))
+
+		progress, err := vsHandler.GroupMemberSyncProgress("restored-pvc", "app", now)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(progress.Phase).To(Equal(ramendrv1alpha1.VolSyncSyncPhasePreparing))
+		Expect(progress.StartTime).To(Equal(&metav1.Time{Time: now.Add(-time.Minute)}))
+	})
+})
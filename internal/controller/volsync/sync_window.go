@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+const (
+	hoursPerDay          = 24
+	cronSpecFields       = 5
+	cronSpecHourField    = 1
+	rcloneBandwidthLimit = "RCLONE_BWLIMIT"
+	bitsPerByte          = 8
+	bytesPerKibibyte     = 1024
+)
+
+func syncWindowHour(hour string) (int, error) {
+	hh, mm, ok := strings.Cut(hour, ":")
+	if !ok || mm != "00" {
+		return 0, fmt.Errorf("sync window hour %q is not of the form HH:00", hour)
+	}
+
+	h, err := strconv.Atoi(hh)
+	if err != nil || h < 0 || h >= hoursPerDay {
+		return 0, fmt.Errorf("sync window hour %q is not of the form HH:00", hour)
+	}
+
+	return h, nil
+}
+
+// syncWindowsHours returns the sorted hours of the day within the sync windows, or nil if
+// there are no windows.
+func syncWindowsHours(windows []ramendrv1alpha1.SyncWindow) ([]int, error) {
+	if len(windows) == 0 {
+		return nil, nil
+	}
+
+	within := [hoursPerDay]bool{}
+
+	for _, window := range windows {
+		start, err := syncWindowHour(window.Start)
+		if err != nil {
+			return nil, err
+		}
+
+		end, err := syncWindowHour(window.End)
+		if err != nil {
+			return nil, err
+		}
+
+		within[start] = true
+
+		for h := (start + 1) % hoursPerDay; h != end; h = (h + 1) % hoursPerDay {
+			within[h] = true
+		}
+	}
+
+	hours := []int{}
+
+	for h, ok := range within {
+		if ok {
+			hours = append(hours, h)
+		}
+	}
+
+	return hours, nil
+}
+
+// RestrictCronSpecToSyncWindows restricts the hours of a cronspec to those within the sync
+// windows. A cronspec with no hour within them, such as one of a longer interval than the
+// windows, is run at the start of the first window instead.
+func RestrictCronSpecToSyncWindows(cronSpec string, windows []ramendrv1alpha1.SyncWindow) (string, error) {
+	windowHours, err := syncWindowsHours(windows)
+	if err != nil || windowHours == nil {
+		return cronSpec, err
+	}
+
+	fields := strings.Fields(cronSpec)
+	if len(fields) != cronSpecFields {
+		return "", fmt.Errorf("cronspec %q has no hour field", cronSpec)
+	}
+
+	cronHours, err := cronSpecHours(fields[cronSpecHourField])
+	if err != nil {
+		return "", err
+	}
+
+	hours := []string{}
+
+	for _, h := range windowHours {
+		if slices.Contains(cronHours, h) {
+			hours = append(hours, strconv.Itoa(h))
+		}
+	}
+
+	if len(hours) == 0 {
+		start, _ := syncWindowHour(windows[0].Start)
+		hours = append(hours, strconv.Itoa(start))
+	}
+
+	fields[cronSpecHourField] = strings.Join(hours, ",")
+
+	return strings.Join(fields, " "), nil
+}
+
+// cronSpecHours returns the hours of an hour field of the forms ConvertSchedulingIntervalToCronSpec
+// generates: *, */N or N.
+func cronSpecHours(field string) ([]int, error) {
+	step := 1
+
+	switch {
+	case field == "*":
+	case strings.HasPrefix(field, "*/"):
+		n, err := strconv.Atoi(strings.TrimPrefix(field, "*/"))
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("cronspec hour field %q is invalid", field)
+		}
+
+		step = n
+	default:
+		h, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("cronspec hour field %q is invalid", field)
+		}
+
+		return []int{h}, nil
+	}
+
+	hours := []int{}
+	for h := 0; h < hoursPerDay; h += step {
+		hours = append(hours, h)
+	}
+
+	return hours, nil
+}
+
+// SyncDeferredUntil returns the time at which the next sync window opens, if the given time is
+// outside of the sync windows, and nil otherwise.
+func (v *VSHandler) SyncDeferredUntil(now time.Time) *metav1.Time {
+	hours, err := syncWindowsHours(v.syncWindows)
+	if err != nil || hours == nil {
+		return nil
+	}
+
+	now = now.UTC()
+	if slices.Contains(hours, now.Hour()) {
+		return nil
+	}
+
+	next := now.Truncate(time.Hour)
+	for !slices.Contains(hours, next.Hour()) {
+		next = next.Add(time.Hour)
+	}
+
+	return &metav1.Time{Time: next}
+}
+
+// rcloneBandwidthLimitValue returns the rclone bandwidth limit, in KiB per second, of the
+// bandwidth limit in bits per second.
+func rcloneBandwidthLimitValue(bitsPerSecond int64) string {
+	return strconv.FormatInt(max(bitsPerSecond/bitsPerByte/bytesPerKibibyte, 1), 10) + "K"
+}
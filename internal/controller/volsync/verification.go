@@ -0,0 +1,526 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	// DefaultVerificationImage is the image of the jobs checksumming the files of PVCs. It has the
+	// shell and the coreutils the checksumming script uses.
+	DefaultVerificationImage = "quay.io/backube/volsync:0.11.0"
+
+	// VerificationLabel is set on the snapshots, PVCs and jobs of the verifications of PVCs
+	VerificationLabel = "ramendr.openshift.io/volsync-verification"
+
+	verificationNamePrefix      = "ramen-verify-"
+	verificationContainerName   = "verify"
+	verificationMountPath       = "/data"
+	verificationScratchPath     = "/scratch"
+	verificationSampleRatio     = 16
+	verificationJobDeadline     = int64(3600)
+	verificationManifestFields  = 4
+	verificationSummaryFields   = 2
+	verificationSampleRatioFull = 1
+)
+
+// verificationScript prints a line of the modification time, size, checksum and path of each
+// file of the PVC, or of a sample of them chosen by the checksum of the seed and the path. Files
+// whose paths have newlines are skipped. The number of lines and the checksum of the output are
+// written to the termination message, to detect logs that were truncated or rotated.
+const verificationScript = `set -e
+cd ` + verificationMountPath + `
+find . -type f ! -path "$(printf '*\n*')" | while IFS= read -r f; do
+  if [ "$SAMPLE_RATIO" -gt 1 ]; then
+    h=$(printf '%s%s' "$SEED" "$f" | cksum | cut -d' ' -f1)
+    [ $((h % SAMPLE_RATIO)) -eq 0 ] || continue
+  fi
+  echo "$(stat -c '%Y %s' "$f") $(sha256sum "$f" | cut -d' ' -f1) $f"
+done > ` + verificationScratchPath + `/manifest
+cat ` + verificationScratchPath + `/manifest
+echo "$(wc -l < ` + verificationScratchPath + `/manifest) $(sha256sum ` + verificationScratchPath +
+	`/manifest | cut -d' ' -f1)" > /dev/termination-log
+`
+
+// ErrVerificationFailed is returned when the checksumming job of a verification fails, or its
+// manifest is incomplete or malformed
+var ErrVerificationFailed = errors.New("verification job failed")
+
+// VerificationFile is the modification time, size and checksum of a file of a PVC
+type VerificationFile struct {
+	ModTime  int64  `json:"modTime"`
+	Size     int64  `json:"size"`
+	Checksum string `json:"checksum"`
+}
+
+// VerificationManifest maps the paths of the files of a PVC to their checksums
+type VerificationManifest map[string]VerificationFile
+
+// VerificationResult is the manifest of a snapshot of a PVC, and the time of the snapshot
+type VerificationResult struct {
+	Time     metav1.Time
+	Manifest VerificationManifest
+}
+
+func getVerificationName(pvcName string) string {
+	return util.GetJobName(verificationNamePrefix, pvcName)
+}
+
+// ReconcileSourceVerification checksums a snapshot of a PVC taken for its verification, and
+// returns the manifest once complete, or nil while in progress.
+func (v *VSHandler) ReconcileSourceVerification(protectedPVC ramendrv1alpha1.ProtectedPVC,
+	mode ramendrv1alpha1.VolSyncVerificationMode, image string, moverConfig *ramendrv1alpha1.MoverConfig,
+) (*VerificationResult, error) {
+	snapshot, err := v.ensureVerificationSnapshot(protectedPVC)
+	if err != nil || snapshot == nil {
+		return nil, err
+	}
+
+	snapshotRef := &corev1.TypedLocalObjectReference{
+		APIGroup: &snapv1.SchemeGroupVersion.Group,
+		Kind:     VolumeSnapshotKind,
+		Name:     snapshot.GetName(),
+	}
+
+	return v.reconcileVerificationJob(protectedPVC, snapshotRef, snapshot.GetCreationTimestamp(), mode, image,
+		moverConfig)
+}
+
+// ReconcileDestinationVerification checksums the latest image of the ReplicationDestination of
+// a PVC, with the sample of the source checksummed at the given time, and returns the manifest
+// once complete, or nil while in progress.
+func (v *VSHandler) ReconcileDestinationVerification(protectedPVC ramendrv1alpha1.ProtectedPVC,
+	sourceTime metav1.Time, mode ramendrv1alpha1.VolSyncVerificationMode, image string,
+	moverConfig *ramendrv1alpha1.MoverConfig,
+) (*VerificationResult, error) {
+	pvc := &corev1.PersistentVolumeClaim{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{
+		Name:      getVerificationName(protectedPVC.Name),
+		Namespace: protectedPVC.Namespace,
+	}, pvc)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to get verification pvc: %w", err)
+	}
+
+	// The PVC is restored once, from the latest image at the start of the verification
+	snapshotRef := pvc.Spec.DataSource
+	if snapshotRef == nil {
+		snapshotRef, err = v.getRDLatestImage(protectedPVC.Name, protectedPVC.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isLatestImageReady(snapshotRef) {
+			return nil, fmt.Errorf("%w: no snapshot of the destination of pvc %s", ErrVerificationFailed,
+				protectedPVC.Name)
+		}
+	}
+
+	return v.reconcileVerificationJob(protectedPVC, snapshotRef, sourceTime, mode, image, moverConfig)
+}
+
+func (v *VSHandler) ensureVerificationSnapshot(protectedPVC ramendrv1alpha1.ProtectedPVC,
+) (*snapv1.VolumeSnapshot, error) {
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &snapv1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerificationName(protectedPVC.Name),
+			Namespace: protectedPVC.Namespace,
+		},
+	}
+
+	err = v.client.Get(v.ctx, client.ObjectKeyFromObject(snapshot), snapshot)
+	if err != nil {
+		if !kerrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get verification snapshot: %w", err)
+		}
+
+		v.addVerificationLabels(snapshot)
+		snapshot.Spec = snapv1.VolumeSnapshotSpec{
+			Source:                  snapv1.VolumeSnapshotSource{PersistentVolumeClaimName: &protectedPVC.Name},
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+		}
+
+		if err := v.client.Create(v.ctx, snapshot); err != nil {
+			return nil, fmt.Errorf("failed to create verification snapshot: %w", err)
+		}
+
+		v.log.Info("Created verification snapshot", "name", snapshot.GetName())
+
+		return nil, nil
+	}
+
+	if snapshot.Status == nil || snapshot.Status.ReadyToUse == nil || !*snapshot.Status.ReadyToUse {
+		return nil, nil
+	}
+
+	return snapshot, nil
+}
+
+func (v *VSHandler) addVerificationLabels(obj client.Object) {
+	util.AddLabel(obj, util.CreatedByRamenLabel, "true")
+	util.AddLabel(obj, VerificationLabel, "true")
+	util.AddLabel(obj, util.VRGOwnerNameLabel, v.owner.GetName())
+	util.AddLabel(obj, util.VRGOwnerNamespaceLabel, v.owner.GetNamespace())
+}
+
+func (v *VSHandler) reconcileVerificationJob(protectedPVC ramendrv1alpha1.ProtectedPVC,
+	snapshotRef *corev1.TypedLocalObjectReference, seed metav1.Time, mode ramendrv1alpha1.VolSyncVerificationMode,
+	image string, moverConfig *ramendrv1alpha1.MoverConfig,
+) (*VerificationResult, error) {
+	if err := v.ensureVerificationPVC(protectedPVC, snapshotRef); err != nil {
+		return nil, err
+	}
+
+	job, err := v.ensureVerificationJob(protectedPVC, seed, mode, image, moverConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch condition.Type {
+		case batchv1.JobFailed:
+			return nil, fmt.Errorf("%w: %s", ErrVerificationFailed, condition.Message)
+		case batchv1.JobComplete:
+			manifest, err := v.getVerificationManifest(job)
+			if err != nil {
+				return nil, err
+			}
+
+			return &VerificationResult{Time: seed, Manifest: manifest}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (v *VSHandler) ensureVerificationPVC(protectedPVC ramendrv1alpha1.ProtectedPVC,
+	snapshotRef *corev1.TypedLocalObjectReference,
+) error {
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getVerificationName(protectedPVC.Name),
+			Namespace: protectedPVC.Namespace,
+		},
+	}
+
+	err := v.client.Get(v.ctx, client.ObjectKeyFromObject(pvc), pvc)
+	if err == nil || !kerrors.IsNotFound(err) {
+		return client.IgnoreNotFound(err)
+	}
+
+	storageClass, err := v.getStorageClass(protectedPVC.StorageClassName)
+	if err != nil {
+		return err
+	}
+
+	accessModes := protectedPVC.AccessModes
+	if storageClass.Provisioner == v.defaultCephFSCSIDriverName {
+		accessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
+	}
+
+	capacity := protectedPVC.Resources.Requests[corev1.ResourceStorage]
+	if capacity.IsZero() {
+		return fmt.Errorf("pvc %s has no storage request", protectedPVC.Name)
+	}
+
+	v.addVerificationLabels(pvc)
+	pvc.Spec = corev1.PersistentVolumeClaimSpec{
+		AccessModes:      accessModes,
+		StorageClassName: protectedPVC.StorageClassName,
+		DataSource:       snapshotRef,
+		Resources: corev1.VolumeResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceStorage: capacity},
+		},
+	}
+
+	if err := v.client.Create(v.ctx, pvc); err != nil {
+		return fmt.Errorf("failed to create verification pvc: %w", err)
+	}
+
+	v.log.Info("Created verification pvc", "name", pvc.GetName(), "dataSource", snapshotRef.Name)
+
+	return nil
+}
+
+func (v *VSHandler) ensureVerificationJob(protectedPVC ramendrv1alpha1.ProtectedPVC, seed metav1.Time,
+	mode ramendrv1alpha1.VolSyncVerificationMode, image string, moverConfig *ramendrv1alpha1.MoverConfig,
+) (*batchv1.Job, error) {
+	name := getVerificationName(protectedPVC.Name)
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: protectedPVC.Namespace,
+		},
+	}
+
+	err := v.client.Get(v.ctx, client.ObjectKeyFromObject(job), job)
+	if err == nil || !kerrors.IsNotFound(err) {
+		return job, client.IgnoreNotFound(err)
+	}
+
+	if image == "" {
+		return nil, fmt.Errorf("%w: no verification image is set in the ramen config", ErrVerificationFailed)
+	}
+
+	sampleRatio := verificationSampleRatio
+	if mode == ramendrv1alpha1.VolSyncVerificationModeFull {
+		sampleRatio = verificationSampleRatioFull
+	}
+
+	v.addVerificationLabels(job)
+	job.Spec = batchv1.JobSpec{
+		BackoffLimit:          ptr.To(int32(0)),
+		ActiveDeadlineSeconds: ptr.To(verificationJobDeadline),
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				Containers: []corev1.Container{{
+					Name:    verificationContainerName,
+					Image:   image,
+					Command: []string{"/bin/sh", "-c", verificationScript},
+					Env: []corev1.EnvVar{
+						{Name: "SAMPLE_RATIO", Value: strconv.Itoa(sampleRatio)},
+						{Name: "SEED", Value: strconv.FormatInt(seed.Unix(), 10)},
+					},
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "data", MountPath: verificationMountPath, ReadOnly: true},
+						{Name: "scratch", MountPath: verificationScratchPath},
+					},
+					TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+				}},
+				Volumes: []corev1.Volume{
+					{
+						Name: "data",
+						VolumeSource: corev1.VolumeSource{
+							PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name, ReadOnly: true},
+						},
+					},
+					{
+						Name:         "scratch",
+						VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+					},
+				},
+			},
+		},
+	}
+
+	// The files are read with the permissions of the movers of the PVC
+	if moverConfig != nil {
+		job.Spec.Template.Spec.SecurityContext = moverConfig.MoverSecurityContext
+
+		if moverConfig.MoverServiceAccount != nil {
+			job.Spec.Template.Spec.ServiceAccountName = *moverConfig.MoverServiceAccount
+		}
+	}
+
+	if err := v.client.Create(v.ctx, job); err != nil {
+		return nil, fmt.Errorf("failed to create verification job: %w", err)
+	}
+
+	v.log.Info("Created verification job", "name", job.GetName(), "mode", mode)
+
+	return job, nil
+}
+
+func (v *VSHandler) getVerificationManifest(job *batchv1.Job) (VerificationManifest, error) {
+	pods := &corev1.PodList{}
+
+	if err := v.client.List(v.ctx, pods, client.InNamespace(job.GetNamespace()),
+		client.MatchingLabels{batchv1.JobNameLabel: job.GetName()}); err != nil {
+		return nil, fmt.Errorf("failed to list verification job pods: %w", err)
+	}
+
+	for i := range pods.Items {
+		if pods.Items[i].Status.Phase == corev1.PodSucceeded {
+			return v.getVerificationPodManifest(&pods.Items[i])
+		}
+	}
+
+	return nil, fmt.Errorf("%w: no succeeded pod of job %s", ErrVerificationFailed, job.GetName())
+}
+
+func (v *VSHandler) getVerificationPodManifest(pod *corev1.Pod) (VerificationManifest, error) {
+	summary := ""
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == verificationContainerName && status.State.Terminated != nil {
+			summary = status.State.Terminated.Message
+		}
+	}
+
+	logs, err := v.streamPodLogs(pod, &corev1.PodLogOptions{Container: verificationContainerName})
+	if err != nil {
+		return nil, err
+	}
+	defer logs.Close()
+
+	return ParseVerificationOutput(logs, summary)
+}
+
+// ParseVerificationOutput parses the output of the checksumming script, and checks it against
+// the number of lines and the checksum of the output the script reported in its termination
+// message.
+func ParseVerificationOutput(output io.Reader, summary string) (VerificationManifest, error) {
+	fields := strings.Fields(summary)
+	if len(fields) != verificationSummaryFields {
+		return nil, fmt.Errorf("%w: malformed termination message %q", ErrVerificationFailed, summary)
+	}
+
+	lines, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed termination message %q", ErrVerificationFailed, summary)
+	}
+
+	hash := sha256.New()
+
+	manifest, err := ParseVerificationManifest(bufio.NewScanner(io.TeeReader(output, hash)))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(manifest) != lines || hex.EncodeToString(hash.Sum(nil)) != fields[1] {
+		return nil, fmt.Errorf("%w: manifest of %d files does not match the %d files checksummed, the logs may "+
+			"have been truncated or rotated", ErrVerificationFailed, len(manifest), lines)
+	}
+
+	return manifest, nil
+}
+
+// ParseVerificationManifest parses the lines of the output of the checksumming script, and fails on
+// a malformed line.
+func ParseVerificationManifest(scanner *bufio.Scanner) (VerificationManifest, error) {
+	manifest := VerificationManifest{}
+
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.SplitN(scanner.Text(), " ", verificationManifestFields)
+		if len(fields) != verificationManifestFields || fields[2] == "" || fields[3] == "" {
+			return nil, fmt.Errorf("%w: malformed line %d of the manifest", ErrVerificationFailed, line)
+		}
+
+		modTime, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed modification time on line %d of the manifest",
+				ErrVerificationFailed, line)
+		}
+
+		size, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: malformed size on line %d of the manifest", ErrVerificationFailed, line)
+		}
+
+		manifest[fields[3]] = VerificationFile{ModTime: modTime, Size: size, Checksum: fields[2]}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read verification manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// CompareVerificationManifests compares the checksums of the files of the same size and
+// modification time in the source and the destination, and returns their number and the sorted
+// paths of those whose checksums differ. The other files are expected to differ, as
+// they changed since the latest sync, or were not synced yet.
+func CompareVerificationManifests(source, destination VerificationManifest) (int, []string) {
+	compared := 0
+	diverged := []string{}
+
+	for path, sourceFile := range source {
+		destinationFile, ok := destination[path]
+		if !ok || destinationFile.ModTime != sourceFile.ModTime || destinationFile.Size != sourceFile.Size {
+			continue
+		}
+
+		compared++
+
+		if destinationFile.Checksum != sourceFile.Checksum {
+			diverged = append(diverged, path)
+		}
+	}
+
+	slices.Sort(diverged)
+
+	return compared, diverged
+}
+
+// CleanupVerification deletes the job, PVC and snapshot of the verification of a PVC
+func (v *VSHandler) CleanupVerification(pvcName, pvcNamespace string) error {
+	name := getVerificationName(pvcName)
+	objects := []client.Object{
+		&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pvcNamespace}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pvcNamespace}},
+		&snapv1.VolumeSnapshot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pvcNamespace}},
+	}
+
+	for _, obj := range objects {
+		if err := v.client.Get(v.ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			if kerrors.IsNotFound(err) {
+				continue
+			}
+
+			return fmt.Errorf("failed to get verification %T %s: %w", obj, name, err)
+		}
+
+		if !util.HasLabelWithValue(obj, VerificationLabel, "true") {
+			continue
+		}
+
+		if err := v.client.Delete(v.ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+			!kerrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete verification %T %s: %w", obj, name, err)
+		}
+	}
+
+	return nil
+}
+
+// IsVerificationInProgress returns true if the source of a PVC is being checksummed for its
+// verification.
+func (v *VSHandler) IsVerificationInProgress(pvcName, pvcNamespace string) (bool, error) {
+	snapshot := &snapv1.VolumeSnapshot{}
+
+	err := v.client.Get(v.ctx, types.NamespacedName{Name: getVerificationName(pvcName), Namespace: pvcNamespace},
+		snapshot)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get verification snapshot: %w", err)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsync_test
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+var _ = Describe("VolSync verification manifests", func() {
+	// summary returns the termination message of the checksumming script for an output
+	summary := func(output string) string {
+		checksum := sha256.Sum256([]byte(output))
+
+		return fmt.Sprintf("%d %s\n", strings.Count(output, "\n"), hex.EncodeToString(checksum[:]))
+	}
+
+	It("Should parse the lines of the checksumming script", func() {
+		output := "1700000000 12 aaaa ./dir/file one\n" +
+			"1700000001 0 bbbb ./empty\n"
+
+		manifest, err := volsync.ParseVerificationOutput(strings.NewReader(output), summary(output))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest).To(Equal(volsync.VerificationManifest{
+			"./dir/file one": {ModTime: 1700000000, Size: 12, Checksum: "aaaa"},
+			"./empty":        {ModTime: 1700000001, Size: 0, Checksum: "bbbb"},
+		}))
+	})
+
+	It("Should parse the output of a PVC without files", func() {
+		manifest, err := volsync.ParseVerificationOutput(strings.NewReader(""), summary(""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(manifest).To(BeEmpty())
+	})
+
+	DescribeTable("Should fail on a malformed line",
+		func(line string) {
+			output := "1700000000 12 aaaa ./file\n" + line + "\n"
+
+			_, err := volsync.ParseVerificationManifest(bufio.NewScanner(strings.NewReader(output)))
+			Expect(err).To(MatchError(volsync.ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("line 2")))
+		},
+		Entry("without a path", "1700000001 12 bbbb"),
+		Entry("with an empty path", "1700000001 12 bbbb "),
+		Entry("with a malformed modification time", "yesterday 12 bbbb ./other"),
+		Entry("with a malformed size", "1700000001 big bbbb ./other"),
+		Entry("of another output", "sha256sum: ./other: Permission denied"),
+	)
+
+	It("Should fail when the logs miss lines of the output", func() {
+		output := "1700000000 12 aaaa ./first\n" +
+			"1700000001 12 bbbb ./second\n"
+
+		_, err := volsync.ParseVerificationOutput(strings.NewReader(output[len(output)/2+1:]), summary(output))
+		Expect(err).To(MatchError(volsync.ErrVerificationFailed))
+		Expect(err).To(MatchError(ContainSubstring("1 files does not match the 2 files")))
+	})
+
+	It("Should fail when the logs differ from the output", func() {
+		output := "1700000000 12 aaaa ./first\n"
+
+		_, err := volsync.ParseVerificationOutput(strings.NewReader("1700000000 12 aaab ./first\n"),
+			summary(output))
+		Expect(err).To(MatchError(volsync.ErrVerificationFailed))
+	})
+
+	DescribeTable("Should fail on a malformed termination message",
+		func(message string) {
+			_, err := volsync.ParseVerificationOutput(strings.NewReader(""), message)
+			Expect(err).To(MatchError(volsync.ErrVerificationFailed))
+			Expect(err).To(MatchError(ContainSubstring("termination message")))
+		},
+		Entry("that is empty", ""),
+		Entry("without a checksum", "0"),
+		Entry("with a malformed number of lines", "none e3b0c44298fc1c149afbf4c8996fb924"),
+	)
+
+	It("Should only compare the files of the same size and modification time", func() {
+		source := volsync.VerificationManifest{
+			"./same":     {ModTime: 1, Size: 1, Checksum: "a"},
+			"./corrupt":  {ModTime: 1, Size: 1, Checksum: "a"},
+			"./modified": {ModTime: 2, Size: 1, Checksum: "a"},
+			"./new":      {ModTime: 1, Size: 1, Checksum: "a"},
+		}
+		destination := volsync.VerificationManifest{
+			"./same":     {ModTime: 1, Size: 1, Checksum: "a"},
+			"./corrupt":  {ModTime: 1, Size: 1, Checksum: "b"},
+			"./modified": {ModTime: 1, Size: 1, Checksum: "b"},
+			"./deleted":  {ModTime: 1, Size: 1, Checksum: "a"},
+		}
+
+		compared, diverged := volsync.CompareVerificationManifests(source, destination)
+		Expect(compared).To(Equal(2))
+		Expect(diverged).To(Equal([]string{"./corrupt"}))
+	})
+
+	It("Should compare no files of manifests without files in common", func() {
+		compared, diverged := volsync.CompareVerificationManifests(
+			volsync.VerificationManifest{"./source": {ModTime: 1, Size: 1, Checksum: "a"}},
+			volsync.VerificationManifest{"./destination": {ModTime: 1, Size: 1, Checksum: "a"}})
+		Expect(compared).To(BeZero())
+		Expect(diverged).To(BeEmpty())
+	})
+
+	It("Should sort the paths of the diverged files", func() {
+		source := volsync.VerificationManifest{
+			"./c": {ModTime: 1, Size: 1, Checksum: "a"},
+			"./a": {ModTime: 1, Size: 1, Checksum: "a"},
+			"./b": {ModTime: 1, Size: 2, Checksum: "a"},
+		}
+		destination := volsync.VerificationManifest{
+			"./c": {ModTime: 1, Size: 1, Checksum: "b"},
+			"./a": {ModTime: 1, Size: 1, Checksum: "b"},
+			"./b": {ModTime: 1, Size: 1, Checksum: "b"},
+		}
+
+		compared, diverged := volsync.CompareVerificationManifests(source, destination)
+		Expect(compared).To(Equal(2))
+		Expect(diverged).To(Equal([]string{"./a", "./c"}))
+	})
+})
@@ -6,6 +6,7 @@ package volsync
 import (
 	"context"
 	"fmt"
+	"io"
 	"slices"
 	"strconv"
 	"strings"
@@ -24,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/reference"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -83,6 +85,13 @@ type VSHandler struct {
 	vrgInAdminNamespace         bool
 	workloadStatus              string
 	moverConfig                 []ramendrv1alpha1.MoverConfig
+	mover                       ramendrv1alpha1.VolSyncMoverType
+	objectStoreRepositoryGet    func() (*ObjectStoreRepository, error)
+	bandwidthLimit              *resource.Quantity
+	syncWindows                 []ramendrv1alpha1.SyncWindow
+	storageClassMapping         map[string]string
+	namespaceMapping            map[string]string
+	podsGetter                  corev1client.PodsGetter
 }
 
 func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, owner metav1.Object,
@@ -110,11 +119,41 @@ func NewVSHandler(ctx context.Context, client client.Client, log logr.Logger, ow
 		log.Info("VolumeReplicationGroup(PVC) map function received non-VRG resource")
 	} else {
 		vsHandler.moverConfig = append([]ramendrv1alpha1.MoverConfig(nil), vrg.Spec.VolSync.MoverConfig...)
+		vsHandler.mover = vrg.Spec.VolSync.Mover
+		vsHandler.bandwidthLimit = vrg.Spec.VolSync.BandwidthLimit
+		vsHandler.syncWindows = vrg.Spec.VolSync.SyncWindows
+		vsHandler.storageClassMapping = vrg.Spec.StorageClassMapping
+		vsHandler.namespaceMapping = vrg.Spec.NamespaceMapping
 	}
 
 	return vsHandler
 }
 
+// DestinationStorageClassName returns the StorageClass on this cluster of the storage of a
+// StorageClass of the primary cluster, which is named differently on heterogeneous clusters
+func (v *VSHandler) DestinationStorageClassName(storageClassName *string) *string {
+	return util.MappedStorageClassName(v.storageClassMapping, storageClassName)
+}
+
+// SetPodsGetter sets the client reading the logs of the pods of the movers and of the
+// verification jobs, which the controller-runtime client does not read.
+func (v *VSHandler) SetPodsGetter(podsGetter corev1client.PodsGetter) {
+	v.podsGetter = podsGetter
+}
+
+func (v *VSHandler) streamPodLogs(pod *corev1.Pod, options *corev1.PodLogOptions) (io.ReadCloser, error) {
+	if v.podsGetter == nil {
+		return nil, fmt.Errorf("no client to get the logs of pod %s", pod.GetName())
+	}
+
+	logs, err := v.podsGetter.Pods(pod.GetNamespace()).GetLogs(pod.GetName(), options).Stream(v.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get logs of pod %s: %w", pod.GetName(), err)
+	}
+
+	return logs, nil
+}
+
 func (v *VSHandler) GetWorkloadStatus() string {
 	return v.workloadStatus
 }
@@ -174,6 +213,10 @@ func (v *VSHandler) ReconcileRD(
 		return nil, nil, err
 	}
 
+	if v.IsObjectStoreMover() {
+		return v.generateObjectStoreRDInfo(rdSpec, rd, l)
+	}
+
 	err = v.ReconcileServiceExportForRD(rd)
 	if err != nil {
 		return nil, nil, err
@@ -346,6 +389,25 @@ func (v *VSHandler) assignRDAndRSAsOwnerToProtectedPVC(
 	return nil
 }
 
+// generateObjectStoreRDInfo returns the RDInfo of a ReplicationDestination of the Restic or Rclone
+// mover as soon as it is created, as the source replicates to the repository rather than to it.
+func (v *VSHandler) generateObjectStoreRDInfo(
+	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
+	rd *volsyncv1alpha1.ReplicationDestination,
+	l logr.Logger,
+) (*volsyncv1alpha1.ReplicationDestination, *ramendrv1alpha1.VolSyncReplicationDestinationInfo, error) {
+	if rd.Status != nil {
+		if err := v.pruneOldSnapshots(rd.Namespace); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	l.V(1).Info("ReplicationDestination Reconcile Complete (object store)",
+		"rd", rd.Name, "copyMethod", v.destinationCopyMethod, "mover", v.mover)
+
+	return rd, &ramendrv1alpha1.VolSyncReplicationDestinationInfo{ProtectedPVC: rdSpec.ProtectedPVC}, nil
+}
+
 //nolint:funlen
 func (v *VSHandler) createOrUpdateRD(
 	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec, pskSecretName string,
@@ -353,7 +415,8 @@ func (v *VSHandler) createOrUpdateRD(
 ) {
 	l := v.log.WithValues("rdSpec", rdSpec)
 
-	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(rdSpec.ProtectedPVC.StorageClassName)
+	volumeSnapshotClassName, err := v.GetVolumeSnapshotClassFromPVCStorageClass(
+		v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName))
 	if err != nil {
 		return nil, err
 	}
@@ -363,6 +426,15 @@ func (v *VSHandler) createOrUpdateRD(
 		pvcAccessModes = rdSpec.ProtectedPVC.AccessModes
 	}
 
+	var repository *ObjectStoreRepository
+
+	if v.IsObjectStoreMover() {
+		repository, err = v.getObjectStoreRepository()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	rd := &volsyncv1alpha1.ReplicationDestination{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      util.GetReplicationDestinationName(rdSpec.ProtectedPVC.Name),
@@ -387,19 +459,25 @@ func (v *VSHandler) createOrUpdateRD(
 			}
 		}
 
+		volumeOptions := volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+			CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
+			Capacity:                rdSpec.ProtectedPVC.Resources.Requests.Storage(),
+			StorageClassName:        v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
+			AccessModes:             pvcAccessModes,
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+			DestinationPVC:          dstPVC,
+		}
+
+		if repository != nil {
+			return v.setRDObjectStoreMover(rd, repository, rdSpec.ProtectedPVC, volumeOptions, moverConfig)
+		}
+
 		rd.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationDestinationRsyncTLSSpec{
 			ServiceType: v.GetRsyncServiceType(),
 			KeySecret:   &pskSecretName,
 
-			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
-				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
-				Capacity:                rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-				StorageClassName:        rdSpec.ProtectedPVC.StorageClassName,
-				AccessModes:             pvcAccessModes,
-				VolumeSnapshotClassName: &volumeSnapshotClassName,
-				DestinationPVC:          dstPVC,
-			},
-			MoverConfig: moverConfig,
+			ReplicationDestinationVolumeOptions: volumeOptions,
+			MoverConfig:                         moverConfig,
 		}
 
 		return nil
@@ -408,6 +486,12 @@ func (v *VSHandler) createOrUpdateRD(
 		return nil, fmt.Errorf("%w", err)
 	}
 
+	if repository != nil {
+		if err := v.ensureRepositorySecret(rd, repository, rdSpec.ProtectedPVC); err != nil {
+			return nil, err
+		}
+	}
+
 	l.V(1).Info("ReplicationDestination createOrUpdate Complete", "op", op)
 
 	return rd, nil
@@ -587,13 +671,28 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 		return nil, err
 	}
 
-	// Remote service address created for the ReplicationDestination on the secondary
-	// The secondary namespace will be the same as primary namespace so use the vrg.Namespace
-	remoteAddress, err := v.resolveRemoteAddress(rsSpec)
-	if err != nil {
-		l.Error(err, "unable to resolve remote address")
+	if v.bandwidthLimit != nil && v.mover != ramendrv1alpha1.VolSyncMoverRclone {
+		l.Info("Bandwidth limit is not enforced, as only the Rclone mover supports it",
+			"bandwidthLimit", v.bandwidthLimit.String())
+	}
 
-		return nil, err
+	var repository *ObjectStoreRepository
+
+	var remoteAddress string
+
+	if v.IsObjectStoreMover() {
+		repository, err = v.getObjectStoreRepository()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Remote service address created for the ReplicationDestination on the secondary
+		remoteAddress, err = v.resolveRemoteAddress(rsSpec)
+		if err != nil {
+			l.Error(err, "unable to resolve remote address")
+
+			return nil, err
+		}
 	}
 
 	rs := &volsyncv1alpha1.ReplicationSource{
@@ -631,19 +730,27 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 			}
 		}
 
+		volumeOptions := volsyncv1alpha1.ReplicationSourceVolumeOptions{
+			// Always using CopyMethod of snapshot for now - could use 'Clone' CopyMethod for specific
+			// storage classes that support it in the future
+			CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
+			VolumeSnapshotClassName: &volumeSnapshotClassName,
+			StorageClassName:        rsSpec.ProtectedPVC.StorageClassName,
+			AccessModes:             rsSpec.ProtectedPVC.AccessModes,
+		}
+
+		if repository != nil {
+			v.setRSObjectStoreMover(rs, repository, rsSpec.ProtectedPVC, volumeOptions, *moverConfig)
+
+			return nil
+		}
+
 		rs.Spec.RsyncTLS = &volsyncv1alpha1.ReplicationSourceRsyncTLSSpec{
 			KeySecret: &pskSecretName,
 			Address:   &remoteAddress,
 
-			ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
-				// Always using CopyMethod of snapshot for now - could use 'Clone' CopyMethod for specific
-				// storage classes that support it in the future
-				CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
-				VolumeSnapshotClassName: &volumeSnapshotClassName,
-				StorageClassName:        rsSpec.ProtectedPVC.StorageClassName,
-				AccessModes:             rsSpec.ProtectedPVC.AccessModes,
-			},
-			MoverConfig: *moverConfig,
+			ReplicationSourceVolumeOptions: volumeOptions,
+			MoverConfig:                    *moverConfig,
 		}
 
 		return nil
@@ -652,6 +759,12 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 		return nil, fmt.Errorf("%w", err)
 	}
 
+	if repository != nil {
+		if err := v.ensureRepositorySecret(rs, repository, rsSpec.ProtectedPVC); err != nil {
+			return nil, err
+		}
+	}
+
 	l.V(1).Info("ReplicationSource createOrUpdate Complete", "op", op)
 
 	return rs, nil
@@ -659,9 +772,10 @@ func (v *VSHandler) createOrUpdateRS(rsSpec ramendrv1alpha1.VolSyncReplicationSo
 
 func (v *VSHandler) resolveRemoteAddress(rsSpec ramendrv1alpha1.VolSyncReplicationSourceSpec) (string, error) {
 	if util.IsSubmarinerEnabled(v.owner.GetAnnotations()) {
-		// Remote service address created for the ReplicationDestination on the secondary
-		// The secondary namespace will be the same as primary namespace so use the vrg.Namespace
-		remoteAddress := util.GetRemoteServiceNameForRDFromPVCName(rsSpec.ProtectedPVC.Name, rsSpec.ProtectedPVC.Namespace)
+		// Remote service address created for the ReplicationDestination on the secondary, in the namespace
+		// of the secondary that is mapped to the namespace of the PVC
+		remoteAddress := util.GetRemoteServiceNameForRDFromPVCName(rsSpec.ProtectedPVC.Name,
+			util.PeerNamespaceName(v.namespaceMapping, rsSpec.ProtectedPVC.Namespace))
 		v.log.Info("Using Submariner remote address", "remoteAddress", remoteAddress)
 
 		return remoteAddress, nil
@@ -912,7 +1026,7 @@ func (v *VSHandler) configureReplicationSourceSpec(rs *volsyncv1alpha1.Replicati
 		}
 	} else {
 		// Set schedule trigger
-		scheduleCronSpec, err := v.getScheduleCronSpec()
+		scheduleCronSpec, err := v.GetScheduleCronSpec(rsSpec.ProtectedPVC.SchedulingInterval)
 		if err != nil {
 			v.log.Error(err, "unable to parse schedulingInterval")
 
@@ -927,34 +1041,22 @@ func (v *VSHandler) configureReplicationSourceSpec(rs *volsyncv1alpha1.Replicati
 	return nil
 }
 
+// UndoAfterFinalSync deletes the tmp PVC of the final sync of a PVC, and returns its PV to the
+// PVC. It is idempotent, as the final sync of a PVC of a group may be cleaned up repeatedly.
+//
 //nolint:cyclop,funlen
 func (v *VSHandler) UndoAfterFinalSync(pvcName, pvcNamespace string) error {
 	v.log.V(1).Info("Undo after final sync", "pvcName", pvcName)
-	// Remove claimRef and reset the original PVC claimRef (without uid)
-	tmpPVC, err := v.getPVC(types.NamespacedName{
-		Namespace: pvcNamespace,
-		Name:      util.GetTmpPVCNameForFinalSync(pvcName),
-	})
-	if err == nil {
-		err2 := v.client.Delete(v.ctx, tmpPVC)
-		if err2 != nil {
-			return err2
-		}
-
-		v.log.V(1).Info("Deleted tmp PVC", "pvcName", tmpPVC.GetName())
-	}
 
-	if err != nil {
-		if !errors.IsNotFound(err) {
-			return fmt.Errorf("wait for tmp PVC '%s' to go away", tmpPVC.GetName())
-		}
+	if err := v.deleteTmpPVCForFinalSync(pvcName, pvcNamespace); err != nil {
+		return err
 	}
 
 	// reset the original PVC claimRef (without uid)
 	originalPVC, err := v.getPVC(types.NamespacedName{Namespace: pvcNamespace, Name: pvcName})
 	if err != nil {
 		if !errors.IsNotFound(err) {
-			return fmt.Errorf("wait for tmp PVC '%s' to go away", tmpPVC.GetName())
+			return fmt.Errorf("failed to get PVC '%s' (%w)", pvcName, err)
 		}
 
 		return nil // PVC is gone, nothing more to do
@@ -966,33 +1068,37 @@ func (v *VSHandler) UndoAfterFinalSync(pvcName, pvcNamespace string) error {
 	}
 
 	if err := v.client.Get(v.ctx, pvObjectKey, pv); err != nil {
-		v.log.Info("Failed to get PersistentVolume", "volumeName", originalPVC.Spec.VolumeName, "error", err)
+		if !errors.IsNotFound(err) || originalPVC.Spec.VolumeName == "" {
+			v.log.Info("Failed to get PersistentVolume", "volumeName", originalPVC.Spec.VolumeName, "error", err)
 
-		return fmt.Errorf("failed to get PersistentVolume (%s), %w", pv.Name, err)
-	}
-
-	mutate := func(obj client.Object) error {
-		pvObj, ok := obj.(*corev1.PersistentVolume)
-		if !ok {
-			return fmt.Errorf("expected *corev1.PersistentVolume, got %T", obj)
+			return fmt.Errorf("failed to get PersistentVolume (%s), %w", originalPVC.Spec.VolumeName, err)
 		}
 
-		updateClaimRef(pvObj, originalPVC.Name, originalPVC.Namespace)
+		v.log.Info("PersistentVolume is gone", "volumeName", originalPVC.Spec.VolumeName)
+	} else {
+		mutate := func(obj client.Object) error {
+			pvObj, ok := obj.(*corev1.PersistentVolume)
+			if !ok {
+				return fmt.Errorf("expected *corev1.PersistentVolume, got %T", obj)
+			}
 
-		pvObj.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimDelete
-		delete(pvObj.ObjectMeta.Annotations, PVAnnotationRetentionKey)
+			updateClaimRef(pvObj, originalPVC.Name, originalPVC.Namespace)
 
-		return nil
-	}
-	if err := v.updateResource(pv, mutate); err != nil {
-		return err
+			pvObj.Spec.PersistentVolumeReclaimPolicy = corev1.PersistentVolumeReclaimDelete
+			delete(pvObj.ObjectMeta.Annotations, PVAnnotationRetentionKey)
+
+			return nil
+		}
+		if err := v.updateResource(pv, mutate); err != nil {
+			return err
+		}
 	}
 
 	err = util.NewResourceUpdater(originalPVC).RemoveFinalizer(PVCFinalizerProtected).Update(v.ctx, v.client)
 	if err != nil {
 		v.log.Info("Failed to update PVC", "pvcName", originalPVC.GetName(), "error", err)
 
-		return err
+		return client.IgnoreNotFound(err)
 	}
 
 	v.log.V(1).Info("UndoAfterFinalSync completed", "pvcName", pvcName)
@@ -1000,6 +1106,55 @@ func (v *VSHandler) UndoAfterFinalSync(pvcName, pvcNamespace string) error {
 	return nil
 }
 
+// RollbackFinalSync deletes the tmp PVC of the final sync of a PVC, if the final sync is abandoned
+// before it completes. Unlike UndoAfterFinalSync, it keeps the PV retained, as it holds the only
+// copy of the data of the PVC not replicated yet. It is idempotent.
+func (v *VSHandler) RollbackFinalSync(pvcName, pvcNamespace string) error {
+	v.log.V(1).Info("Rollback final sync", "pvcName", pvcName)
+
+	if err := v.deleteTmpPVCForFinalSync(pvcName, pvcNamespace); err != nil {
+		return err
+	}
+
+	originalPVC, err := v.getPVC(types.NamespacedName{Namespace: pvcNamespace, Name: pvcName})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get PVC '%s' (%w)", pvcName, err)
+		}
+
+		return nil
+	}
+
+	if util.ResourceIsDeleted(originalPVC) {
+		v.log.Info("Releasing deleted PVC, its PersistentVolume is retained",
+			"pvcName", pvcName, "volumeName", originalPVC.Spec.VolumeName)
+	}
+
+	return client.IgnoreNotFound(
+		util.NewResourceUpdater(originalPVC).RemoveFinalizer(PVCFinalizerProtected).Update(v.ctx, v.client))
+}
+
+func (v *VSHandler) deleteTmpPVCForFinalSync(pvcName, pvcNamespace string) error {
+	tmpPVCName := util.GetTmpPVCNameForFinalSync(pvcName)
+
+	tmpPVC, err := v.getPVC(types.NamespacedName{Namespace: pvcNamespace, Name: tmpPVCName})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get tmp PVC '%s' (%w)", tmpPVCName, err)
+		}
+
+		return nil
+	}
+
+	if err := v.client.Delete(v.ctx, tmpPVC); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	v.log.V(1).Info("Deleted tmp PVC", "pvcName", tmpPVCName)
+
+	return nil
+}
+
 func (v *VSHandler) PreparePVC(pvcNamespacedName types.NamespacedName,
 	cgLabelVal string,
 	isCGEnabled,
@@ -1537,7 +1692,7 @@ func (v *VSHandler) DeleteRD(pvcName, pvcNamespace string, skipPVCDisownership b
 }
 
 // pruneOldSnapshots deletes older VolumeSnapshots in the given PVC namespace,
-// keeping only the most recent snapshot
+// keeping only the most recent snapshot, and the recovery points, which are pruned by their retention
 func (v *VSHandler) pruneOldSnapshots(pvcNamespace string) error {
 	snapList := &snapv1.VolumeSnapshotList{}
 
@@ -1546,6 +1701,10 @@ func (v *VSHandler) pruneOldSnapshots(pvcNamespace string) error {
 		return err
 	}
 
+	snapList.Items = slices.DeleteFunc(snapList.Items, func(snapshot snapv1.VolumeSnapshot) bool {
+		return util.HasLabelWithValue(&snapshot, RecoveryPointLabel, RecoveryPointLabelVal)
+	})
+
 	if len(snapList.Items) <= 1 {
 		return nil
 	}
@@ -1788,6 +1947,12 @@ func (v *VSHandler) listByOwner(list client.ObjectList, objNamespace string) err
 
 func (v *VSHandler) EnsurePVCfromRD(rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec, failoverAction bool,
 ) error {
+	if v.IsObjectStoreMover() {
+		if err := v.restoreLatestToRD(rdSpec); err != nil {
+			return err
+		}
+	}
+
 	latestImage, err := v.getRDLatestImage(rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 	if err != nil {
 		return err
@@ -1872,11 +2037,16 @@ func (v *VSHandler) EnsurePVCforDirectCopy(ctx context.Context,
 	op, err := ctrlutil.CreateOrUpdate(ctx, v.client, pvc, func() error {
 		if pvc.CreationTimestamp.IsZero() {
 			pvc.Spec.AccessModes = rdSpec.ProtectedPVC.AccessModes
-			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
+			pvc.Spec.StorageClassName = v.DestinationStorageClassName(
+				rdSpec.ProtectedPVC.StorageClassName)
 			pvc.Spec.VolumeMode = v.volumeModeForProtectedPVC(&rdSpec.ProtectedPVC)
 		}
 
-		pvc.Spec.Resources.Requests = rdSpec.ProtectedPVC.Resources.Requests
+		// The PVC is expanded as the source is, and never shrunk, which is not supported
+		if pvc.CreationTimestamp.IsZero() ||
+			pvc.Spec.Resources.Requests.Storage().Cmp(*rdSpec.ProtectedPVC.Resources.Requests.Storage()) < 0 {
+			pvc.Spec.Resources.Requests = rdSpec.ProtectedPVC.Resources.Requests
+		}
 
 		util.SyncPVCLabels(pvc, rdSpec.ProtectedPVC.Labels)
 		util.SyncPVCAnnotations(pvc, rdSpec.ProtectedPVC.Annotations)
@@ -2067,7 +2237,8 @@ func (v *VSHandler) ensurePVCFromSnapshot(rdSpec ramendrv1alpha1.VolSyncReplicat
 
 		if pvc.CreationTimestamp.IsZero() { // set immutable fields
 			pvc.Spec.AccessModes = accessModes
-			pvc.Spec.StorageClassName = rdSpec.ProtectedPVC.StorageClassName
+			pvc.Spec.StorageClassName = v.DestinationStorageClassName(
+				rdSpec.ProtectedPVC.StorageClassName)
 
 			// Only set when initially creating
 			pvc.Spec.DataSource = &snapshotRef
@@ -2306,16 +2477,35 @@ func (v *VSHandler) GetVolumeSnapshotClasses() ([]snapv1.VolumeSnapshotClass, er
 	return v.volumeSnapshotClassList.Items, nil
 }
 
-func (v *VSHandler) getScheduleCronSpec() (*string, error) {
-	if v.schedulingInterval != "" {
-		return ConvertSchedulingIntervalToCronSpec(v.schedulingInterval)
+// GetScheduleCronSpec returns the cronspec of the scheduling interval of a PVC, which defaults
+// to the VRG scheduling interval, restricted to the sync windows.
+func (v *VSHandler) GetScheduleCronSpec(pvcSchedulingInterval string) (*string, error) {
+	schedulingInterval := pvcSchedulingInterval
+	if schedulingInterval == "" {
+		schedulingInterval = v.schedulingInterval
 	}
 
-	// Use default value if not specified
-	v.log.Info("Warning - scheduling interval is empty, using default Schedule for volsync",
-		"DefaultScheduleCronSpec", DefaultScheduleCronSpec)
+	scheduleCronSpec := &DefaultScheduleCronSpec
+
+	if schedulingInterval != "" {
+		var err error
 
-	return &DefaultScheduleCronSpec, nil
+		scheduleCronSpec, err = ConvertSchedulingIntervalToCronSpec(schedulingInterval)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Use default value if not specified
+		v.log.Info("Warning - scheduling interval is empty, using default Schedule for volsync",
+			"DefaultScheduleCronSpec", DefaultScheduleCronSpec)
+	}
+
+	cronSpec, err := RestrictCronSpecToSyncWindows(*scheduleCronSpec, v.syncWindows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cronSpec, nil
 }
 
 // Convert from schedulingInterval which is in the format of <num><m,h,d>
@@ -2569,7 +2759,7 @@ func (v *VSHandler) reconcileLocalRD(rdSpec ramendrv1alpha1.VolSyncReplicationDe
 			ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 				CopyMethod:       volsyncv1alpha1.CopyMethodDirect,
 				Capacity:         rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-				StorageClassName: rdSpec.ProtectedPVC.StorageClassName,
+				StorageClassName: v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				AccessModes:      pvcAccessModes,
 				DestinationPVC:   &rdSpec.ProtectedPVC.Name,
 			},
@@ -2756,7 +2946,7 @@ func (v *VSHandler) createPVCFromSnapshot(rd *volsyncv1alpha1.ReplicationDestina
 ) (*corev1.PersistentVolumeClaim, error) {
 	l := v.log.WithValues("pvcName", rd.GetName(), "snapshotRef", snapshotRef, "snapRestoreSize", snapRestoreSize)
 
-	storageClass, err := v.getStorageClass(rdSpec.ProtectedPVC.StorageClassName)
+	storageClass, err := v.getStorageClass(v.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName))
 	if err != nil {
 		return nil, err
 	}
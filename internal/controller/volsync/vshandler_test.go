@@ -73,6 +73,37 @@ var _ = Describe("VolSync Handler - utils", func() {
 			Expect(err).To((HaveOccurred()))
 		})
 	})
+
+	Context("When restricting a cronspec to sync windows", func() {
+		nightly := []ramendrv1alpha1.SyncWindow{{Start: "22:00", End: "03:00"}}
+
+		It("Should not restrict a cronspec without sync windows", func() {
+			cronSpec, err := volsync.RestrictCronSpecToSyncWindows("*/10 * * * *", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronSpec).To(Equal("*/10 * * * *"))
+		})
+		It("Should restrict an interval in minutes to the hours of a window past midnight", func() {
+			cronSpec, err := volsync.RestrictCronSpecToSyncWindows("*/10 * * * *", nightly)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronSpec).To(Equal("*/10 0,1,2,22,23 * * *"))
+		})
+		It("Should restrict an interval in hours to its hours within the windows", func() {
+			cronSpec, err := volsync.RestrictCronSpecToSyncWindows("0 */2 * * *", nightly)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronSpec).To(Equal("0 0,2,22 * * *"))
+		})
+		It("Should run an interval in days at the start of the first window", func() {
+			cronSpec, err := volsync.RestrictCronSpecToSyncWindows("0 0 */2 * *",
+				[]ramendrv1alpha1.SyncWindow{{Start: "20:00", End: "23:00"}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cronSpec).To(Equal("0 20 */2 * *"))
+		})
+		It("Should fail if a window hour is invalid", func() {
+			_, err := volsync.RestrictCronSpecToSyncWindows("*/10 * * * *",
+				[]ramendrv1alpha1.SyncWindow{{Start: "22:30", End: "03:00"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 var _ = Describe("VolSync Handler - Volume Replication Class tests", func() {
@@ -1,10 +1,11 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg
+package volsynccg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
@@ -108,6 +109,12 @@ func (c *cgHandler) CreateOrUpdateReplicationGroupDestination(
 		WithValues("ReplicationGroupDestinationName", replicationGroupDestinationName,
 			"ReplicationGroupDestinationNamespace", replicationGroupDestinationNamespace)
 
+	if err := c.finishFinalSyncIfTriggered(replicationGroupDestinationNamespace); err != nil {
+		log.Error(err, "Failed to clean up final sync before deleting ReplicationGroupSource")
+
+		return nil, err
+	}
+
 	if err := util.DeleteReplicationGroupSource(c.ctx, c.Client,
 		replicationGroupDestinationName, replicationGroupDestinationNamespace); err != nil {
 		log.Error(err, "Failed to delete ReplicationGroupSource before creating ReplicationGroupDestination")
@@ -198,6 +205,16 @@ func (c *cgHandler) CreateOrUpdateReplicationGroupSource(
 		return nil, !finalSyncComplete, err
 	}
 
+	// A final sync that is no longer to be run, as its relocation was cancelled, is rolled back
+	// before the group is synced on schedule again
+	if !runFinalSync {
+		if err := c.rollbackFinalSyncIfTriggered(replicationGroupSourceNamespace); err != nil {
+			log.Error(err, "Failed to roll back final sync of ReplicationGroupSource")
+
+			return nil, !finalSyncComplete, err
+		}
+	}
+
 	namespaces := []string{c.instance.Namespace}
 	if c.instance.Spec.ProtectedNamespaces != nil && len(*c.instance.Spec.ProtectedNamespaces) != 0 {
 		namespaces = *c.instance.Spec.ProtectedNamespaces
@@ -272,6 +289,13 @@ func (c *cgHandler) CreateOrUpdateReplicationGroupSource(
 				}
 			}
 
+			cronSpec, err := volsync.RestrictCronSpecToSyncWindows(*scheduleCronSpec, c.instance.Spec.VolSync.SyncWindows)
+			if err != nil {
+				return err
+			}
+
+			scheduleCronSpec = &cronSpec
+
 			rgs.Spec.Trigger = &ramendrv1alpha1.ReplicationSourceTriggerSpec{
 				Schedule: scheduleCronSpec,
 			}
@@ -488,14 +512,14 @@ func (c *cgHandler) GetRDInCG() ([]ramendrv1alpha1.VolSyncReplicationDestination
 	}
 
 	for _, rdSpec := range c.instance.Spec.VolSync.RDSpec {
-		pvcInCephfsCg, err := c.CheckIfPVCMatchLabel(rdSpec.ProtectedPVC.Labels)
+		pvcInCG, err := c.CheckIfPVCMatchLabel(rdSpec.ProtectedPVC.Labels)
 		if err != nil {
 			c.logger.Error(err, "Failed to check if pvc label match consistency group selector")
 
 			return nil, err
 		}
 
-		if pvcInCephfsCg {
+		if pvcInCG {
 			rdSpecs = append(rdSpecs, rdSpec)
 		}
 	}
@@ -570,8 +594,11 @@ func ToPointerSlice[T any](items []T) []*T {
 // It verifies that each application PVC belonging to the given ReplicationGroupSource
 // is in Terminating state, and if the ReplicationGroupSource is triggered for final sync,
 // it creates (or ensures readiness of) a temporary PVC used exclusively for the final sync.
+// Each PVC is set up on its own, so that a PVC that fails is retried without holding back
+// the others, and a PVC whose final sync is skipped is excluded from the final sync.
 //
-// The function returns (true, err) when reconciliation should be requeued
+// The function returns (true, err) when reconciliation should be requeued, with the errors
+// of all the PVCs that failed.
 // It returns (false, nil) when final sync setup is complete and no requeue is needed.
 func (c *cgHandler) ensureFinalSyncSetup(rgsName, rgsNamespace string, log logr.Logger,
 ) (*ramendrv1alpha1.ReplicationGroupSource, bool, error) {
@@ -579,45 +606,65 @@ func (c *cgHandler) ensureFinalSyncSetup(rgsName, rgsNamespace string, log logr.
 
 	rgs, err := GetRGS(c.ctx, c.Client, rgsName, rgsNamespace, log)
 	if err != nil {
-		return nil, true, err
+		return nil, requeue, err
 	}
 
 	requeueResult := false
 
+	var errs []error
+
 	for _, rs := range rgs.Status.ReplicationSources {
-		srcPVC, err := util.GetPVC(c.ctx, c.Client, types.NamespacedName{Namespace: rs.Namespace, Name: rs.Name})
+		result, err := c.ensureFinalSyncSetupForPVC(rgs, rs, log)
 		if err != nil {
-			if k8serrors.IsNotFound(err) {
-				log.Info("Application PVC not found, checking the next one",
-					"namespace", rs.Namespace, "name", rs.Name)
+			errs = append(errs, fmt.Errorf("PVC %s: %w", rs.Name, err))
+		}
 
-				continue
-			}
+		requeueResult = requeueResult || result
+	}
 
-			log.Error(err, "Failed to retrieve application PVC", "pvcName", rs.Name)
+	if len(errs) != 0 {
+		return rgs, requeue, errors.Join(errs...)
+	}
 
-			return rgs, requeue, err
-		}
+	// All replication sources are ready, no need to requeue
+	return rgs, requeueResult, nil
+}
+
+func (c *cgHandler) ensureFinalSyncSetupForPVC(rgs *ramendrv1alpha1.ReplicationGroupSource,
+	rs *corev1.ObjectReference, log logr.Logger,
+) (bool, error) {
+	const requeue = true
 
-		if !util.ResourceIsDeleted(srcPVC) {
-			log.Info("Final sync will not run until application PVC is deleted",
-				"namespace", srcPVC.Namespace, "name", srcPVC.Name)
+	srcPVC, err := util.GetPVC(c.ctx, c.Client, types.NamespacedName{Namespace: rs.Namespace, Name: rs.Name})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Info("Application PVC not found, checking the next one",
+				"namespace", rs.Namespace, "name", rs.Name)
 
-			return rgs, requeue, nil
+			return !requeue, nil
 		}
 
-		if IsPrepareForFinalSyncTriggered(rgs) {
-			result, err := c.ensureTmpPVCForFinalSync(srcPVC, log)
-			if err != nil {
-				return rgs, true, err
-			}
+		log.Error(err, "Failed to retrieve application PVC", "pvcName", rs.Name)
 
-			requeueResult = requeueResult || result
-		}
+		return requeue, err
 	}
 
-	// All replication sources are ready, no need to requeue
-	return rgs, requeueResult, nil
+	if IsFinalSyncSkipped(c.instance, srcPVC.Namespace, srcPVC.Name) {
+		return !requeue, c.skipFinalSync(srcPVC, log)
+	}
+
+	if !util.ResourceIsDeleted(srcPVC) {
+		log.Info("Final sync will not run until application PVC is deleted",
+			"namespace", srcPVC.Namespace, "name", srcPVC.Name)
+
+		return requeue, nil
+	}
+
+	if !IsPrepareForFinalSyncTriggered(rgs) {
+		return !requeue, nil
+	}
+
+	return c.ensureTmpPVCForFinalSync(srcPVC, log)
 }
 
 func (c *cgHandler) ensureTmpPVCForFinalSync(srcPVC *corev1.PersistentVolumeClaim, log logr.Logger) (bool, error) {
@@ -670,20 +717,26 @@ func (c *cgHandler) cleanupFinalSyncIfComplete(namespace string,
 	return rgs, !finalSyncComplete, nil
 }
 
+// ensureFinalSyncCleanup cleans up after the final sync of each PVC of the group, and returns the
+// errors of all the PVCs that failed. It is idempotent.
 func (c *cgHandler) ensureFinalSyncCleanup(rgs *ramendrv1alpha1.ReplicationGroupSource) error {
+	var errs []error
+
 	for _, rs := range rgs.Status.ReplicationSources {
 		err := c.VSHandler.UndoAfterFinalSync(rs.Name, rs.Namespace)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("PVC %s: %w", rs.Name, err))
+
+			continue
 		}
 
 		err = c.VSHandler.CleanupAfterRSFinalSync(rs.Name, rs.Namespace)
 		if err != nil {
-			return err
+			errs = append(errs, fmt.Errorf("PVC %s: %w", rs.Name, err))
 		}
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 func GetRGS(ctx context.Context,
@@ -747,9 +800,12 @@ func IsPrepareForFinalSyncTriggered(rgs *ramendrv1alpha1.ReplicationGroupSource)
 		rgs.Spec.Trigger.Manual == volsync.PrepareForFinalSyncTriggerString
 }
 
+// CleanupFinalSyncIfNeeded cleans up after the final sync of the group if it is run, and rolls back
+// its setup otherwise. Both are idempotent, so that a stuck final sync can be finished, or rolled
+// back, by reconciling until they succeed.
 func (c *cgHandler) CleanupFinalSyncIfNeeded(rgs *ramendrv1alpha1.ReplicationGroupSource, runFinalSync bool) error {
 	if !runFinalSync {
-		return nil
+		return c.rollbackFinalSync(rgs)
 	}
 
 	return c.ensureFinalSyncCleanup(rgs)
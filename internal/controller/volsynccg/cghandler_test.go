@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg_test
+package volsynccg_test
 
 import (
 	"context"
@@ -21,9 +21,9 @@ import (
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
 	internalController "github.com/ramendr/ramen/internal/controller"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 var (
@@ -32,11 +32,11 @@ var (
 )
 
 var _ = Describe("Cghandler", func() {
-	var vsCGHandler cephfscg.VSCGHandler
+	var vsCGHandler volsynccg.VSCGHandler
 
 	Describe("CreateOrUpdateReplicationGroupDestination", func() {
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      vgdName,
 					Namespace: "default",
@@ -51,7 +51,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(len(rgd.Spec.RDSpecs)).To(Equal(0))
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      vgdName,
 					Namespace: "default",
@@ -70,7 +70,7 @@ var _ = Describe("Cghandler", func() {
 				}})
 			Expect(err).To(BeNil())
 			Expect(len(rgd.Spec.RDSpecs)).To(Equal(1))
-			Expect(cephfscg.DeleteRGD(Ctx, k8sClient, vgdName, "default", testLogger)).To(BeNil())
+			Expect(volsynccg.DeleteRGD(Ctx, k8sClient, vgdName, "default", testLogger)).To(BeNil())
 			Eventually(func() bool {
 				err := k8sClient.Get(Ctx, types.NamespacedName{
 					Name:      rgdName,
@@ -171,7 +171,7 @@ var _ = Describe("Cghandler", func() {
 			}, timeout, interval).Should(BeNil())
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "default",
 					Name:      vrgName,
@@ -186,7 +186,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(finalSync).To(BeFalse())
 			Expect(rgs.Spec.Trigger.Schedule).NotTo(BeNil())
 			Expect(*rgs.Spec.Trigger.Schedule).NotTo(BeEmpty())
-			Expect(cephfscg.DeleteRGS(Ctx, k8sClient, vrgName, "default", testLogger)).To(BeNil())
+			Expect(volsynccg.DeleteRGS(Ctx, k8sClient, vrgName, "default", testLogger)).To(BeNil())
 			Eventually(func() bool {
 				err := k8sClient.Get(Ctx, types.NamespacedName{
 					Name:      rgsName,
@@ -199,7 +199,7 @@ var _ = Describe("Cghandler", func() {
 	})
 	Describe("GetLatestImageFromRGD", func() {
 		It("Should be failed", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "default",
 					Name:      vrgName,
@@ -286,7 +286,7 @@ var _ = Describe("Cghandler", func() {
 					}, timeout, interval).Should(BeNil())
 				})
 				It("Should be success", func() {
-					vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+					vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 						ObjectMeta: metav1.ObjectMeta{
 							Namespace: "default",
 							Name:      vrgName,
@@ -296,7 +296,7 @@ var _ = Describe("Cghandler", func() {
 							Async: &ramendrv1alpha1.VRGAsyncSpec{},
 						},
 					}, &metav1.LabelSelector{}, nil, "0", testLogger)
-					rgd, err := cephfscg.GetRGD(Ctx, k8sClient, rgdName, "default", testLogger)
+					rgd, err := volsynccg.GetRGD(Ctx, k8sClient, rgdName, "default", testLogger)
 					Expect(err).To(BeNil())
 
 					image, err := vsCGHandler.GetLatestImageFromRGD(rgd, "pvc1")
@@ -309,7 +309,7 @@ var _ = Describe("Cghandler", func() {
 						UpdateVS("image1")
 						CreatePVC("pvc1")
 
-						vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+						vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 							ObjectMeta: metav1.ObjectMeta{
 								Namespace: "default",
 								Name:      vrgName,
@@ -350,7 +350,7 @@ var _ = Describe("Cghandler", func() {
 				})
 				Describe("DeleteLocalRDAndRS", func() {
 					It("Should be success", func() {
-						vsCGHandler = cephfscg.NewVSCGHandler(
+						vsCGHandler = volsynccg.NewVSCGHandler(
 							Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 								ObjectMeta: metav1.ObjectMeta{
 									Namespace: "default",
@@ -383,7 +383,7 @@ var _ = Describe("Cghandler", func() {
 	})
 	Describe("CheckIfPVCMatchLabel", func() {
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(
+			vsCGHandler = volsynccg.NewVSCGHandler(
 				Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 					Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 						Async: &ramendrv1alpha1.VRGAsyncSpec{},
@@ -395,7 +395,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(match).To(BeFalse())
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(
+			vsCGHandler = volsynccg.NewVSCGHandler(
 				Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 					Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 						Async: &ramendrv1alpha1.VRGAsyncSpec{},
@@ -407,7 +407,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(match).To(BeFalse())
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 					Async: &ramendrv1alpha1.VRGAsyncSpec{},
 				},
@@ -418,7 +418,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(match).To(BeFalse())
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 					Async: &ramendrv1alpha1.VRGAsyncSpec{},
 				},
@@ -431,7 +431,7 @@ var _ = Describe("Cghandler", func() {
 	})
 	Describe("GetRDInCG", func() {
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 					Async: &ramendrv1alpha1.VRGAsyncSpec{},
 				},
@@ -442,7 +442,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(len(rdSpecs)).To(Equal(0))
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 					VolSync: ramendrv1alpha1.VolSyncSpec{
 						RDSpec: []ramendrv1alpha1.VolSyncReplicationDestinationSpec{{
@@ -459,7 +459,7 @@ var _ = Describe("Cghandler", func() {
 			Expect(len(rdSpecs)).To(Equal(0))
 		})
 		It("Should be success", func() {
-			vsCGHandler = cephfscg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
+			vsCGHandler = volsynccg.NewVSCGHandler(Ctx, k8sClient, &ramendrv1alpha1.VolumeReplicationGroup{
 				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
 					VolSync: ramendrv1alpha1.VolSyncSpec{
 						RDSpec: []ramendrv1alpha1.VolSyncReplicationDestinationSpec{{
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsynccg
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+// IsFinalSyncSkipped returns true if a PVC of a consistency group is to be relocated without a
+// final sync, as it is skipped in the VRG spec, with the data loss acknowledged.
+func IsFinalSyncSkipped(vrg *ramendrv1alpha1.VolumeReplicationGroup, pvcNamespace, pvcName string) bool {
+	for _, skip := range vrg.Spec.VolSync.FinalSyncSkips {
+		namespace := skip.Namespace
+		if namespace == "" {
+			namespace = vrg.GetNamespace()
+		}
+
+		if skip.Name == pvcName && namespace == pvcNamespace {
+			return skip.AcknowledgeDataLoss
+		}
+	}
+
+	return false
+}
+
+func IsFinalSyncTriggered(rgs *ramendrv1alpha1.ReplicationGroupSource) bool {
+	return rgs.Spec.Trigger != nil &&
+		rgs.Spec.Trigger.Manual == volsync.FinalSyncTriggerString
+}
+
+// skipFinalSync excludes an application PVC from the final sync of its group. Its CG label is moved
+// to an annotation, so that the group snapshot of the final sync does not select it, and its tmp
+// PVC, if any, is deleted and its PV returned to it, as after a final sync.
+func (c *cgHandler) skipFinalSync(srcPVC *corev1.PersistentVolumeClaim, log logr.Logger) error {
+	log.Info("Skipping final sync of application PVC, its changes since its last sync are lost",
+		"namespace", srcPVC.Namespace, "name", srcPVC.Name)
+
+	if _, ok := srcPVC.GetLabels()[util.ConsistencyGroupLabel]; ok {
+		if err := util.NewResourceUpdater(srcPVC).
+			DeleteLabel(util.ConsistencyGroupLabel).
+			AddAnnotation(util.ConsistencyGroupLabel, c.cgName).
+			Update(c.ctx, c.Client); err != nil {
+			return fmt.Errorf("failed to remove the CG label from PVC %s (%w)", srcPVC.Name, err)
+		}
+	}
+
+	if err := c.VSHandler.UndoAfterFinalSync(srcPVC.Name, srcPVC.Namespace); err != nil {
+		return err
+	}
+
+	return c.VSHandler.CleanupAfterRSFinalSync(srcPVC.Name, srcPVC.Namespace)
+}
+
+// rollbackFinalSync abandons the final sync of the group, deleting the tmp PVCs of its members,
+// while their PVs are retained. It is idempotent, and returns the errors of all the members.
+func (c *cgHandler) rollbackFinalSync(rgs *ramendrv1alpha1.ReplicationGroupSource) error {
+	c.logger.Info("Rolling back the final sync of ReplicationGroupSource", "RGS", rgs.Name)
+
+	var errs []error
+
+	for _, rs := range rgs.Status.ReplicationSources {
+		if err := c.VSHandler.RollbackFinalSync(rs.Name, rs.Namespace); err != nil {
+			errs = append(errs, fmt.Errorf("PVC %s: %w", rs.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// rollbackFinalSyncIfTriggered rolls back the final sync of the group, if it was triggered while
+// the VRG is no longer running it, for example when a relocation is cancelled.
+func (c *cgHandler) rollbackFinalSyncIfTriggered(rgsNamespace string) error {
+	rgs := &ramendrv1alpha1.ReplicationGroupSource{}
+	if err := c.Client.Get(c.ctx, types.NamespacedName{Name: c.cgName, Namespace: rgsNamespace}, rgs); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !IsFinalSyncTriggered(rgs) {
+		return nil
+	}
+
+	return c.CleanupFinalSyncIfNeeded(rgs, false)
+}
+
+// finishFinalSyncIfTriggered cleans up the final sync of the group, if it was triggered, before
+// its ReplicationGroupSource is deleted, for example when a stuck relocation is completed.
+func (c *cgHandler) finishFinalSyncIfTriggered(rgsNamespace string) error {
+	rgs := &ramendrv1alpha1.ReplicationGroupSource{}
+	if err := c.Client.Get(c.ctx, types.NamespacedName{Name: c.cgName, Namespace: rgsNamespace}, rgs); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !IsFinalSyncTriggered(rgs) {
+		return nil
+	}
+
+	return c.CleanupFinalSyncIfNeeded(rgs, true)
+}
+
+// finalSyncReplicationSources returns the ReplicationSources of the restored PVCs, except those of
+// the PVCs whose final sync is skipped, for which the final sync does not wait.
+func (m *replicationGroupSourceMachine) finalSyncReplicationSources(restoredPVCs []RestoredPVC,
+	replicationSources []*corev1.ObjectReference,
+) []*corev1.ObjectReference {
+	if m.Vrg == nil || m.ManualTag() != volsync.FinalSyncTriggerString {
+		return replicationSources
+	}
+
+	unskipped := make([]*corev1.ObjectReference, 0, len(replicationSources))
+
+	for idx, replicationSource := range replicationSources {
+		if idx < len(restoredPVCs) && IsFinalSyncSkipped(m.Vrg, replicationSource.Namespace,
+			strings.TrimSuffix(restoredPVCs[idx].SourcePVCName, util.SuffixForFinalsyncPVC)) {
+			m.Logger.Info("Not waiting for the final sync of skipped PVC", "ReplicationSource", replicationSource.Name)
+
+			continue
+		}
+
+		unskipped = append(unskipped, replicationSource)
+	}
+
+	return unskipped
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsynccg_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
+)
+
+var _ = Describe("Final sync", func() {
+	Describe("IsFinalSyncSkipped", func() {
+		vrg := &ramendrv1alpha1.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "vrg-ns"},
+			Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+				VolSync: ramendrv1alpha1.VolSyncSpec{
+					FinalSyncSkips: []ramendrv1alpha1.VolSyncFinalSyncSkip{
+						{Name: "pvc1", AcknowledgeDataLoss: true},
+						{Name: "pvc2", Namespace: "app-ns", AcknowledgeDataLoss: true},
+						{Name: "pvc3"},
+					},
+				},
+			},
+		}
+
+		It("Should skip the PVCs in the namespace of the VRG by default", func() {
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "vrg-ns", "pvc1")).To(BeTrue())
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "app-ns", "pvc1")).To(BeFalse())
+		})
+		It("Should skip the PVCs in their namespace", func() {
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "app-ns", "pvc2")).To(BeTrue())
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "vrg-ns", "pvc2")).To(BeFalse())
+		})
+		It("Should not skip the PVCs whose data loss is not acknowledged", func() {
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "vrg-ns", "pvc3")).To(BeFalse())
+			Expect(volsynccg.IsFinalSyncSkipped(vrg, "vrg-ns", "pvc4")).To(BeFalse())
+		})
+	})
+	Describe("IsFinalSyncTriggered", func() {
+		It("Should only be true for the final sync trigger", func() {
+			rgs := &ramendrv1alpha1.ReplicationGroupSource{}
+			Expect(volsynccg.IsFinalSyncTriggered(rgs)).To(BeFalse())
+
+			rgs.Spec.Trigger = &ramendrv1alpha1.ReplicationSourceTriggerSpec{
+				Manual: volsync.PrepareForFinalSyncTriggerString,
+			}
+			Expect(volsynccg.IsFinalSyncTriggered(rgs)).To(BeFalse())
+
+			rgs.Spec.Trigger.Manual = volsync.FinalSyncTriggerString
+			Expect(volsynccg.IsFinalSyncTriggered(rgs)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsynccg
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+// MoverSyncBytes returns the number of bytes an rsync-TLS mover sent, or received, in its most
+// recent synchronization, as reported in its logs, or nil if the logs do not report it.
+func MoverSyncBytes(moverStatus *volsyncv1alpha1.MoverStatus, received bool) *int64 {
+	if moverStatus == nil {
+		return nil
+	}
+
+	return volsync.RsyncTransferredBytes(moverStatus.Logs, received)
+}
+
+// FindMember returns the status of the replication of a PVC of a group, or nil if it has none
+func FindMember(members []ramendrv1alpha1.ReplicationGroupMemberStatus, pvcName string,
+) *ramendrv1alpha1.ReplicationGroupMemberStatus {
+	for idx := range members {
+		if members[idx].PVCName == pvcName {
+			return &members[idx]
+		}
+	}
+
+	return nil
+}
+
+// moverError returns the reason the most recent synchronization of a ReplicationSource or
+// ReplicationDestination failed, or an empty string if it did not.
+func moverError(conditions []metav1.Condition, moverStatus *volsyncv1alpha1.MoverStatus) string {
+	synchronizing := meta.FindStatusCondition(conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if synchronizing != nil && synchronizing.Reason == volsyncv1alpha1.SynchronizingReasonError {
+		return synchronizing.Message
+	}
+
+	if moverStatus != nil && moverStatus.Result == volsyncv1alpha1.MoverResultFailed {
+		logs := strings.Split(strings.TrimSpace(moverStatus.Logs), "\n")
+
+		return "mover failed: " + logs[len(logs)-1]
+	}
+
+	return ""
+}
+
+// replicationSourceMemberStatus returns the status of the replication of a PVC of a group by
+// its ReplicationSource
+func replicationSourceMemberStatus(restoredPVC RestoredPVC, rs *volsyncv1alpha1.ReplicationSource,
+) ramendrv1alpha1.ReplicationGroupMemberStatus {
+	member := ramendrv1alpha1.ReplicationGroupMemberStatus{
+		PVCName:         strings.TrimSuffix(restoredPVC.SourcePVCName, util.SuffixForFinalsyncPVC),
+		RestoredPVCName: restoredPVC.RestoredPVCName,
+		Name:            rs.GetName(),
+	}
+
+	if rs.Status == nil {
+		return member
+	}
+
+	member.LastSyncTime = rs.Status.LastSyncTime
+	member.LastSyncDuration = rs.Status.LastSyncDuration
+	member.LastSyncBytes = MoverSyncBytes(rs.Status.LatestMoverStatus, false)
+	member.Error = moverError(rs.Status.Conditions, rs.Status.LatestMoverStatus)
+
+	return member
+}
+
+// replicationDestinationMemberStatus returns the status of the replication of a PVC of a group
+// by its ReplicationDestination
+func replicationDestinationMemberStatus(pvcName string, rd *volsyncv1alpha1.ReplicationDestination,
+) ramendrv1alpha1.ReplicationGroupMemberStatus {
+	member := ramendrv1alpha1.ReplicationGroupMemberStatus{
+		PVCName: pvcName,
+		Name:    rd.GetName(),
+	}
+
+	if rd.Status == nil {
+		return member
+	}
+
+	member.LastSyncTime = rd.Status.LastSyncTime
+	member.LastSyncDuration = rd.Status.LastSyncDuration
+	member.LastSyncBytes = MoverSyncBytes(rd.Status.LatestMoverStatus, true)
+	member.Error = moverError(rd.Status.Conditions, rd.Status.LatestMoverStatus)
+
+	return member
+}
+
+// updateMembers sets the statuses of the replication of the PVCs of the group by the
+// ReplicationSources of their restored PVCs, in the same order
+func (m *replicationGroupSourceMachine) updateMembers(ctx context.Context, restoredPVCs []RestoredPVC,
+	replicationSources []*corev1.ObjectReference,
+) {
+	members := []ramendrv1alpha1.ReplicationGroupMemberStatus{}
+
+	for idx, replicationSource := range replicationSources {
+		if idx >= len(restoredPVCs) {
+			break
+		}
+
+		rs := &volsyncv1alpha1.ReplicationSource{}
+		if err := m.Client.Get(ctx, types.NamespacedName{
+			Name: replicationSource.Name, Namespace: replicationSource.Namespace,
+		}, rs); err != nil {
+			rs.SetName(replicationSource.Name)
+			member := replicationSourceMemberStatus(restoredPVCs[idx], rs)
+			member.Error = fmt.Sprintf("failed to get ReplicationSource: %v", err)
+			members = append(members, member)
+
+			continue
+		}
+
+		members = append(members, replicationSourceMemberStatus(restoredPVCs[idx], rs))
+	}
+
+	m.ReplicationGroupSource.Status.Members = members
+}
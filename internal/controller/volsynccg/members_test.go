@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package volsynccg_test
+
+import (
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
+)
+
+var _ = Describe("Members", func() {
+	Describe("MoverSyncBytes", func() {
+		It("Should be nil when the mover has not reported the transfer", func() {
+			Expect(volsynccg.MoverSyncBytes(nil, false)).To(BeNil())
+			Expect(volsynccg.MoverSyncBytes(&volsyncv1alpha1.MoverStatus{Logs: "rsync completed in 2s"}, false)).
+				To(BeNil())
+		})
+		It("Should sum the bytes of the transfers of the mover", func() {
+			moverStatus := &volsyncv1alpha1.MoverStatus{
+				Logs: "sent 1,003 bytes  received 5,657 bytes  13,320.00 bytes/sec\n" +
+					"total size is 1,234  speedup is 0.19\n" +
+					"sent 2K bytes  received 1.5M bytes  30.39K bytes/sec\n",
+			}
+
+			Expect(*volsynccg.MoverSyncBytes(moverStatus, false)).To(Equal(int64(1003 + 2*1024)))
+			Expect(*volsynccg.MoverSyncBytes(moverStatus, true)).To(Equal(int64(5657 + 1.5*1024*1024)))
+		})
+	})
+	Describe("FindMember", func() {
+		It("Should find the member of a PVC", func() {
+			members := []ramendrv1alpha1.ReplicationGroupMemberStatus{
+				{PVCName: "pvc1", Name: "pvc1"},
+				{PVCName: "pvc2", Name: "pvc2", Error: "mover failed"},
+			}
+
+			Expect(volsynccg.FindMember(members, "pvc2")).To(Equal(&members[1]))
+			Expect(volsynccg.FindMember(members, "pvc3")).To(BeNil())
+		})
+	})
+})
@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg
+package volsynccg
 
 import (
 	"context"
@@ -89,32 +89,11 @@ func (m *rgdMachine) Conditions() *[]metav1.Condition {
 func (m *rgdMachine) Synchronize(ctx context.Context) (mover.Result, error) {
 	m.Logger.Info("Start Synchronize")
 
-	createdRDs := []*volsyncv1alpha1.ReplicationDestination{}
-	rds := []*corev1.ObjectReference{}
-
-	for _, rdSpec := range m.ReplicationGroupDestination.Spec.RDSpecs {
-		m.Logger.Info("Create replication destination for PVC", "PVCName", rdSpec.ProtectedPVC.Name)
-
-		rd, err := m.ReconcileRD(rdSpec, m.ReplicationGroupDestination.Status.LastSyncStartTime.String())
-		if err != nil {
-			return mover.InProgress(), fmt.Errorf("failed to create replication destination: %w", err)
-		}
-
-		if rd == nil {
-			m.Logger.Info(fmt.Sprintf("ReplicationDestination for %s is not ready. We'll retry...",
-				rdSpec.ProtectedPVC.Name))
-
-			return mover.InProgress(), nil
-		}
-
-		createdRDs = append(createdRDs, rd)
-		rds = append(
-			rds,
-			&corev1.ObjectReference{APIVersion: rd.APIVersion, Kind: rd.Kind, Name: rd.GetName(), Namespace: rd.GetNamespace()},
-		)
+	createdRDs, err := m.reconcileRDs()
+	if err != nil || createdRDs == nil {
+		return mover.InProgress(), err
 	}
 
-	m.ReplicationGroupDestination.Status.ReplicationDestinations = rds
 	latestImages := make(map[string]*corev1.TypedLocalObjectReference)
 
 	for _, rd := range createdRDs {
@@ -169,6 +148,67 @@ func (m *rgdMachine) Synchronize(ctx context.Context) (mover.Result, error) {
 	return mover.Complete(), nil
 }
 
+// reconcileRDs reconciles the ReplicationDestinations of the PVCs of the group, and sets the
+// statuses of the replication of the PVCs, including of those whose ReplicationDestinations
+// failed to reconcile or are not ready. It returns the ReplicationDestinations once all are ready.
+func (m *rgdMachine) reconcileRDs() ([]*volsyncv1alpha1.ReplicationDestination, error) {
+	var reconcileErr error
+
+	createdRDs := []*volsyncv1alpha1.ReplicationDestination{}
+	rds := []*corev1.ObjectReference{}
+	members := []ramendrv1alpha1.ReplicationGroupMemberStatus{}
+	ready := true
+
+	for _, rdSpec := range m.ReplicationGroupDestination.Spec.RDSpecs {
+		m.Logger.Info("Create replication destination for PVC", "PVCName", rdSpec.ProtectedPVC.Name)
+
+		rd, err := m.ReconcileRD(rdSpec, m.ReplicationGroupDestination.Status.LastSyncStartTime.String())
+		if err != nil {
+			members = append(members, ramendrv1alpha1.ReplicationGroupMemberStatus{
+				PVCName: rdSpec.ProtectedPVC.Name,
+				Name:    util.GetReplicationDestinationName(rdSpec.ProtectedPVC.Name),
+				Error:   fmt.Sprintf("failed to reconcile ReplicationDestination: %v", err),
+			})
+
+			if reconcileErr == nil {
+				reconcileErr = fmt.Errorf("failed to create replication destination: %w", err)
+			}
+
+			continue
+		}
+
+		member := replicationDestinationMemberStatus(rdSpec.ProtectedPVC.Name, rd)
+
+		if !volsync.RDStatusReady(rd, m.Logger) {
+			m.Logger.Info(fmt.Sprintf("ReplicationDestination for %s is not ready. We'll retry...",
+				rdSpec.ProtectedPVC.Name))
+
+			if member.Error == "" {
+				member.Error = "ReplicationDestination is not ready"
+			}
+
+			ready = false
+		}
+
+		members = append(members, member)
+		createdRDs = append(createdRDs, rd)
+		rds = append(
+			rds,
+			&corev1.ObjectReference{APIVersion: rd.APIVersion, Kind: rd.Kind, Name: rd.GetName(), Namespace: rd.GetNamespace()},
+		)
+	}
+
+	m.ReplicationGroupDestination.Status.Members = members
+
+	if reconcileErr != nil || !ready {
+		return nil, reconcileErr
+	}
+
+	m.ReplicationGroupDestination.Status.ReplicationDestinations = rds
+
+	return createdRDs, nil
+}
+
 func (m *rgdMachine) Cleanup(ctx context.Context) (mover.Result, error) {
 	m.Logger.Info("Clean expired RD images")
 
@@ -183,6 +223,9 @@ func (m *rgdMachine) SetOutOfSync(bool)                     {}
 func (m *rgdMachine) IncMissedIntervals()                   {}
 func (m *rgdMachine) ObserveSyncDuration(dur time.Duration) {}
 
+// ReconcileRD creates or updates the ReplicationDestination of a PVC of the group, and returns it
+// whether it is ready or not.
+//
 //nolint:cyclop
 func (m *rgdMachine) ReconcileRD(
 	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec, manual string,
@@ -225,10 +268,6 @@ func (m *rgdMachine) ReconcileRD(
 		}
 	}
 
-	if !volsync.RDStatusReady(rd, m.Logger) {
-		return nil, nil
-	}
-
 	return rd, nil
 }
 
@@ -260,8 +299,9 @@ func (m *rgdMachine) CreateReplicationDestinations(
 	rdSpec ramendrv1alpha1.VolSyncReplicationDestinationSpec,
 	pskSecretName string, dstPVC *string, manual string,
 ) (*volsyncv1alpha1.ReplicationDestination, error) {
-	volumeSnapshotClassName, err := m.VSHandler.GetVolumeSnapshotClassFromPVCStorageClass(
-		rdSpec.ProtectedPVC.StorageClassName)
+	storageClassName := m.VSHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName)
+
+	volumeSnapshotClassName, err := m.VSHandler.GetVolumeSnapshotClassFromPVCStorageClass(storageClassName)
 	if err != nil {
 		m.Logger.Error(err, "Failed to get VolumeSnapshotClass from PVC StorageClass", "PVCName", rdSpec.ProtectedPVC.Name)
 
@@ -300,7 +340,7 @@ func (m *rgdMachine) CreateReplicationDestinations(
 				ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
 					CopyMethod:              volsyncv1alpha1.CopyMethodSnapshot,
 					Capacity:                rdSpec.ProtectedPVC.Resources.Requests.Storage(),
-					StorageClassName:        rdSpec.ProtectedPVC.StorageClassName,
+					StorageClassName:        storageClassName,
 					AccessModes:             pvcAccessModes,
 					VolumeSnapshotClassName: &volumeSnapshotClassName,
 					DestinationPVC:          dstPVC,
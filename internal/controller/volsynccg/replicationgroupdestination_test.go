@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg_test
+package volsynccg_test
 
 import (
 	"context"
@@ -20,9 +20,9 @@ import (
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
 	internalController "github.com/ramendr/ramen/internal/controller"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 var _ = Describe("Replicationgroupdestination", func() {
@@ -40,7 +40,7 @@ var _ = Describe("Replicationgroupdestination", func() {
 			},
 		}
 
-		replicationGroupDestinationMachine = cephfscg.NewRGDMachine(
+		replicationGroupDestinationMachine = volsynccg.NewRGDMachine(
 			k8sClient, rgd, volsync.NewVSHandler(context.Background(), k8sClient, testLogger, rgd,
 				&ramendrv1alpha1.VRGAsyncSpec{}, internalController.DefaultCephFSCSIDriverName,
 				"Direct", false,
@@ -61,9 +61,11 @@ var _ = Describe("Replicationgroupdestination", func() {
 			Expect(result.Completed).To(BeFalse())
 		})
 		Describe("There is RD Specs in the replicaiton group destination", func() {
+			var rgd *ramendrv1alpha1.ReplicationGroupDestination
+
 			BeforeEach(func() {
 				metaTime := metav1.NewTime(time.Now())
-				rgd := &ramendrv1alpha1.ReplicationGroupDestination{
+				rgd = &ramendrv1alpha1.ReplicationGroupDestination{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      rgdName,
 						Namespace: "default",
@@ -94,7 +96,7 @@ var _ = Describe("Replicationgroupdestination", func() {
 					},
 				}
 
-				replicationGroupDestinationMachine = cephfscg.NewRGDMachine(
+				replicationGroupDestinationMachine = volsynccg.NewRGDMachine(
 					mgrClient, rgd, volsync.NewVSHandler(context.Background(), mgrClient, testLogger, rgd,
 						&ramendrv1alpha1.VRGAsyncSpec{}, internalController.DefaultCephFSCSIDriverName,
 						"Direct", false,
@@ -112,6 +114,16 @@ var _ = Describe("Replicationgroupdestination", func() {
 				CreateVS(vsName, "", "")
 				UpdateVS(vsName)
 			})
+			It("Should report the members whose ReplicationDestinations are not ready", func() {
+				result, err := replicationGroupDestinationMachine.Synchronize(context.Background())
+				Expect(err).To(BeNil())
+				Expect(result.Completed).To(BeFalse())
+				Expect(rgd.Status.ReplicationDestinations).To(BeEmpty())
+				Expect(rgd.Status.Members).To(HaveLen(1))
+				Expect(rgd.Status.Members[0].PVCName).To(Equal(protectedPVCName))
+				Expect(rgd.Status.Members[0].Name).To(Equal(protectedPVCName))
+				Expect(rgd.Status.Members[0].Error).To(Equal("ReplicationDestination is not ready"))
+			})
 			It("Should be failed", func() {
 				Eventually(func() (bool, error) {
 					result, err := replicationGroupDestinationMachine.Synchronize(context.Background())
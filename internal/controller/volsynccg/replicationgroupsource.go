@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg
+package volsynccg
 
 import (
 	"context"
@@ -191,6 +191,8 @@ func (m *replicationGroupSourceMachine) Synchronize(ctx context.Context) (mover.
 		return mover.InProgress(), err
 	}
 
+	m.updateMembers(ctx, restoredPVCs, replicationSources)
+
 	if srcCreatedOrUpdated {
 		m.Logger.Info("Some replication sources were created or updated, need to wait until they are ready to be used")
 
@@ -201,7 +203,8 @@ func (m *replicationGroupSourceMachine) Synchronize(ctx context.Context) (mover.
 
 	m.Logger.Info("Check if all ReplicationSources are completed")
 
-	completed, err := m.VolumeGroupHandler.CheckReplicationSourceForRestoredPVCsCompleted(ctx, replicationSources)
+	completed, err := m.VolumeGroupHandler.CheckReplicationSourceForRestoredPVCsCompleted(ctx,
+		m.finalSyncReplicationSources(restoredPVCs, replicationSources))
 	if err != nil {
 		m.Logger.Error(err, "Failed to check replication sources")
 
@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg_test
+package volsynccg_test
 
 import (
 	"context"
@@ -18,9 +18,9 @@ import (
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
 	"github.com/ramendr/ramen/hack/fakes"
 	controllers "github.com/ramendr/ramen/internal/controller"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 var rgsName = "rgs"
@@ -64,7 +64,7 @@ var _ = Describe("Replicationgroupsource", func() {
 			},
 		}
 
-		replicationGroupSourceMachine = cephfscg.NewRGSMachine(
+		replicationGroupSourceMachine = volsynccg.NewRGSMachine(
 			k8sClient, rgs, vrg, volsync.NewVSHandler(context.Background(), k8sClient, testLogger, rgs,
 				&ramendrv1alpha1.VRGAsyncSpec{}, controllers.DefaultCephFSCSIDriverName,
 				controllers.DefaultVolSyncCopyMethod, false,
@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg_test
+package volsynccg_test
 
 import (
 	"context"
@@ -50,9 +50,9 @@ var (
 	Ctx         context.Context
 )
 
-func TestCephfscg(t *testing.T) {
+func TestVolsynccg(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "Cephfscg Suite")
+	RunSpecs(t, "Volsynccg Suite")
 }
 
 var _ = BeforeSuite(func() {
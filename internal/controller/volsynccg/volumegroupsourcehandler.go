@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg
+package volsynccg
 
 import (
 	"context"
@@ -294,6 +294,7 @@ func (h *volumeGroupSourceHandler) RestoreVolumesFromVolumeGroupSnapshot(
 			return nil, err
 		}
 
+		// Only CephFS restores snapshots as shallow read-only volumes, quickly
 		restoreAccessModes := []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
 		if storageClass.Provisioner != h.DefaultCephFSCSIDriverName {
 			restoreAccessModes = pvc.Spec.AccessModes
@@ -416,6 +417,7 @@ func (h *volumeGroupSourceHandler) RestoreVolumesFromSnapshot(
 		if restoredPVC.CreationTimestamp.IsZero() { // set immutable fields
 			restoredPVC.Spec.AccessModes = restoreAccessModes
 			restoredPVC.Spec.StorageClassName = pvc.Spec.StorageClassName
+			restoredPVC.Spec.VolumeMode = pvc.Spec.VolumeMode
 			restoredPVC.Spec.DataSource = &snapshotRef
 		}
 
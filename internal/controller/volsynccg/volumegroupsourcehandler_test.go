@@ -1,7 +1,7 @@
 // SPDX-FileCopyrightText: The RamenDR authors
 // SPDX-License-Identifier: Apache-2.0
 
-package cephfscg_test
+package volsynccg_test
 
 import (
 	"context"
@@ -23,9 +23,9 @@ import (
 
 	"github.com/ramendr/ramen/api/v1alpha1"
 	internalController "github.com/ramendr/ramen/internal/controller"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 var (
@@ -42,12 +42,12 @@ var (
 )
 
 var _ = Describe("Volumegroupsourcehandler", func() {
-	var volumeGroupSourceHandler cephfscg.VolumeGroupSourceHandler
+	var volumeGroupSourceHandler volsynccg.VolumeGroupSourceHandler
 
 	rgs := GenerateReplicationGroupSource(vgsName, vgscName, vgsLabel)
 
 	BeforeEach(func() {
-		volumeGroupSourceHandler = cephfscg.NewVolumeGroupSourceHandler(
+		volumeGroupSourceHandler = volsynccg.NewVolumeGroupSourceHandler(
 			k8sClient, rgs, internalController.DefaultCephFSCSIDriverName, testLogger,
 		)
 
@@ -102,7 +102,7 @@ var _ = Describe("Volumegroupsourcehandler", func() {
 				Eventually(func() bool {
 					pvc := &corev1.PersistentVolumeClaim{}
 					err := k8sClient.Get(context.Background(), types.NamespacedName{
-						Name:      fmt.Sprintf(cephfscg.RestorePVCinCGNameFormat, "test"),
+						Name:      fmt.Sprintf(volsynccg.RestorePVCinCGNameFormat, "test"),
 						Namespace: "default",
 					}, pvc)
 					if err != nil {
@@ -169,7 +169,7 @@ var _ = Describe("Volumegroupsourcehandler", func() {
 
 			rsList, srcCreatedOrUpdated, err := volumeGroupSourceHandler.CreateOrUpdateReplicationSourceForRestoredPVCs(
 				context.Background(), "maunal",
-				[]cephfscg.RestoredPVC{{
+				[]volsynccg.RestoredPVC{{
 					SourcePVCName:      "source",
 					RestoredPVCName:    "vs-source",
 					VolumeSnapshotName: "vs",
@@ -181,7 +181,7 @@ var _ = Describe("Volumegroupsourcehandler", func() {
 
 			rsList2, srcCreatedOrUpdated2, err2 := volumeGroupSourceHandler.CreateOrUpdateReplicationSourceForRestoredPVCs(
 				context.Background(), "manual",
-				[]cephfscg.RestoredPVC{{
+				[]volsynccg.RestoredPVC{{
 					SourcePVCName:      "source-for-finalsync",
 					RestoredPVCName:    "vs-source-for-finalsync",
 					VolumeSnapshotName: "vs",
@@ -330,7 +330,7 @@ func UpdateVGS(rgs *v1alpha1.ReplicationGroupSource, vsName, pvcName string) {
 func CreateRestoredPVC(pvcName string) {
 	pvc := &corev1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf(cephfscg.RestorePVCinCGNameFormat, pvcName),
+			Name:      fmt.Sprintf(volsynccg.RestorePVCinCGNameFormat, pvcName),
 			Namespace: "default",
 		},
 		Spec: corev1.PersistentVolumeClaimSpec{
@@ -23,12 +23,16 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
 	virtv1 "kubevirt.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -60,6 +64,12 @@ type VolumeReplicationGroupReconciler struct {
 	RateLimiter         *workqueue.TypedRateLimiter[reconcile.Request]
 	veleroCRsAreWatched bool
 	recipeRetries       sync.Map
+
+	// podsGetter reads the logs of the pods of the VolSync movers and verification jobs
+	podsGetter corev1client.PodsGetter
+
+	// clusterDataManifests are the cluster data manifests last uploaded to the S3 stores, by VRG
+	clusterDataManifests sync.Map
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -70,6 +80,13 @@ func (r *VolumeReplicationGroupReconciler) SetupWithManager(
 ) error {
 	r.eventRecorder = util.NewEventReporter(mgr.GetEventRecorderFor("controller_VolumeReplicationGroup"))
 
+	coreClient, err := kubernetes.NewForConfig(mgr.GetConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create core client: %w", err)
+	}
+
+	r.podsGetter = coreClient.CoreV1()
+
 	r.Log.Info("Adding VolumeReplicationGroup controller")
 
 	rateLimiter := workqueue.NewTypedMaxOfRateLimiter(
@@ -154,7 +171,19 @@ func (r *VolumeReplicationGroupReconciler) configMapFun(
 ) []reconcile.Request {
 	log := ctrl.Log.WithName("configmap").WithName("VolumeReplicationGroup")
 
-	if configmap.GetName() != DrClusterOperatorConfigMapName || configmap.GetNamespace() != RamenOperatorNamespace() {
+	if configmap.GetNamespace() != RamenOperatorNamespace() {
+		return []reconcile.Request{}
+	}
+
+	if configmap.GetLabels()[vrgDeferredCleanupLabel] == "true" {
+		if vrgNamespaceName, vrgName, ok := util.OwnerNamespaceNameAndName(configmap.GetLabels()); ok {
+			return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: vrgNamespaceName, Name: vrgName}}}
+		}
+
+		return []reconcile.Request{}
+	}
+
+	if configmap.GetName() != DrClusterOperatorConfigMapName {
 		return []reconcile.Request{}
 	}
 
@@ -236,6 +265,12 @@ func updateEventDecision(oldPVC, newPVC *corev1.PersistentVolumeClaim, log logr.
 		return !requeue
 	}
 
+	if pvcExclusionChanged(oldPVC, newPVC) {
+		predicateLog.Info("Reconciling due to exclusion from protection change")
+
+		return requeue
+	}
+
 	if added, protected, archived := pvcAnnotationAdded(oldPVC, newPVC); added {
 		predicateLog.Info("Not reconciling due to annotation addition", "protected", protected, "archived", archived)
 
@@ -295,6 +330,14 @@ func pvcFinalizerAddedOrRemoved(oldPVC, newPVC *corev1.PersistentVolumeClaim) (b
 	return added || removed, added, removed
 }
 
+// pvcExclusionChanged returns whether a PVC is excluded from protection, or its reason for it, is changed
+func pvcExclusionChanged(oldPVC, newPVC *corev1.PersistentVolumeClaim) bool {
+	oldReason, excludedBefore := util.PVCExcludedFromProtection(oldPVC)
+	newReason, excludedAfter := util.PVCExcludedFromProtection(newPVC)
+
+	return excludedBefore != excludedAfter || oldReason != newReason
+}
+
 func pvcAnnotationAdded(oldPVC, newPVC *corev1.PersistentVolumeClaim) (bool, bool, bool) {
 	before := oldPVC.GetAnnotations()
 	after := newPVC.GetAnnotations()
@@ -319,6 +362,8 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 	//   PVC's namespace.
 	// - whether the labels on pvc match the label selectors from
 	//    VolumeReplicationGroup CR.
+	// - whether the pvc is not excluded from protection, unless it is
+	//   still owned by the VolumeReplicationGroup CR, to unprotect it.
 	err := reader.List(context.TODO(), &vrgs)
 	if err != nil {
 		log.Error(err, "Failed to get list of VolumeReplicationGroup resources")
@@ -357,12 +402,13 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 		namespaceSelected := slices.Contains(pvcSelector.NamespaceNames, pvc.Namespace)
 		labelMatch := selector.Matches(labels.Set(pvc.GetLabels()))
 		ownerMatch := util.OwnerNamespacedName(pvc) == vrgNamespacedName
+		_, excluded := util.PVCExcludedFromProtection(pvc)
 
-		if labelMatch && namespaceSelected || ownerMatch {
+		if labelMatch && namespaceSelected && !excluded || ownerMatch {
 			log1.Info("Found VolumeReplicationGroup with matching labels or owner",
 				"vrg", vrgNamespacedName.String(), "selector", selector,
 				"namespaces selected", pvcSelector.NamespaceNames,
-				"label match", labelMatch, "owner match", ownerMatch)
+				"label match", labelMatch, "owner match", ownerMatch, "excluded", excluded)
 
 			req = append(req, reconcile.Request{NamespacedName: vrgNamespacedName})
 		}
@@ -382,7 +428,7 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch;create;update
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=pods,verbs=get;list;watch
-// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get;list;watch;update
 // +kubebuilder:rbac:groups=apps,resources=statefulsets,verbs=get;list;watch;update
@@ -392,15 +438,18 @@ func filterPVC(reader client.Reader, pvc *corev1.PersistentVolumeClaim, log logr
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationdestinations/finalizers,verbs=update
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationsources,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=volsync.backube,resources=replicationsources/finalizers,verbs=update
-// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;update;delete
+// +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=multicluster.x-k8s.io,resources=serviceexports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;create;patch;update
 // +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=ramendr.openshift.io,resources=recipes,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=list;watch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=list;watch;create;delete
 // +kubebuilder:rbac:groups="apiextensions.k8s.io",resources=customresourcedefinitions,verbs=get;list;watch
 // +kubebuilder:rbac:groups=core,resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups=core,resources=pods/log,verbs=get
+// +kubebuilder:rbac:groups="argoproj.io",resources=rollouts,verbs=get;list;watch;patch;update
+// +kubebuilder:rbac:groups="argoproj.io",resources=rollouts/scale,verbs=get;update
 // +kubebuilder:rbac:groups="kubevirt.io",resources=virtualmachines,verbs=get;list;watch;patch;update;delete
 // +kubebuilder:rbac:groups="kubevirt.io",resources=virtualmachineinstances,verbs=get;list;watch
 // +kubebuilder:rbac:groups="cdi.kubevirt.io",resources=datavolumes,verbs=get;list;watch
@@ -442,7 +491,7 @@ func (r *VolumeReplicationGroupReconciler) Reconcile(ctx context.Context, req ct
 		if k8serrors.IsNotFound(err) {
 			log.Info("Resource not found")
 
-			return ctrl.Result{}, nil
+			return r.processDeferredCleanup(ctx, req.NamespacedName, log)
 		}
 
 		log.Error(err, "Failed to get resource")
@@ -468,6 +517,8 @@ func (r *VolumeReplicationGroupReconciler) Reconcile(ctx context.Context, req ct
 	v.volSyncHandler = volsync.NewVSHandler(ctx, r.Client, log, v.instance,
 		v.instance.Spec.Async, cephFSCSIDriverNameOrDefault(v.ramenConfig),
 		volSyncDestinationCopyMethodOrDefault(v.ramenConfig), adminNamespaceVRG)
+	v.volSyncHandler.SetObjectStoreRepositoryGetter(v.volSyncObjectStoreRepository)
+	v.volSyncHandler.SetPodsGetter(r.podsGetter)
 
 	if v.instance.Status.ProtectedPVCs == nil {
 		v.instance.Status.ProtectedPVCs = []ramendrv1alpha1.ProtectedPVC{}
@@ -501,6 +552,8 @@ type VRGInstance struct {
 	recipeElements       util.RecipeElements
 	volRepPVCs           []corev1.PersistentVolumeClaim
 	volSyncPVCs          []corev1.PersistentVolumeClaim
+	offloadedPVCs        []corev1.PersistentVolumeClaim
+	finalSync            map[ramendrv1alpha1.ReplicationType]replicationTypeFinalSync
 	replClassList        *volrep.VolumeReplicationClassList
 	grpReplClassList     *volrep.VolumeGroupReplicationClassList
 	storageClassCache    map[string]*storagev1.StorageClass
@@ -511,7 +564,16 @@ type VRGInstance struct {
 	volSyncHandler       *volsync.VSHandler
 	objectStorers        map[string]cachedObjectStorer
 	s3StoreAccessors     []s3StoreAccessor
+	clusterDataManifest  ClusterDataManifest
 	result               ctrl.Result
+
+	// volSyncDestinationCapacities has the capacity mismatches of the VolSync destinations of a secondary, keyed
+	// by the namespaced name of their PVCs, see ReconcileDestinationCapacity
+	volSyncDestinationCapacities map[string]string
+
+	// protectOnlyArchivedPVCs is the bookkeeping of the protect only mode downloaded from the S3 stores, see
+	// protectOnlyPVCsDownload
+	protectOnlyArchivedPVCs map[string]string
 }
 
 // struct with pv with volrepclass and volsync
@@ -576,10 +638,20 @@ func (v *VRGInstance) processVRG() ctrl.Result {
 		}
 	}
 
+	if util.ResourceIsDeleted(v.instance) && v.forceDeleteRequested() {
+		v.log = v.log.WithValues("Finalize", true, "Force", true)
+
+		return v.processForForcedDeletion()
+	}
+
 	var err error
 
 	v.recipeElements, err = RecipeElementsGet(v.ctx, v.reconciler.Client, *v.instance, *v.ramenConfig, v.log)
 	if err != nil {
+		if errors.As(err, &RecipeParametersError{}) {
+			return v.recipeParametersInvalid(err)
+		}
+
 		return v.invalid(err, "Failed to get recipe", false)
 	}
 
@@ -686,18 +758,46 @@ func (v *VRGInstance) clusterDataRestore(result *ctrl.Result) (int, error) {
 }
 
 func (v *VRGInstance) listPVCsByVrgPVCSelector() (*corev1.PersistentVolumeClaimList, error) {
-	return v.listPVCsByPVCSelector(v.recipeElements.PvcSelector.LabelSelector)
+	pvcList, excludedPVCs, err := v.listPVCsAndExcludedPVCsByPVCSelector(v.recipeElements.PvcSelector.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	v.instance.Status.ExcludedPVCs = nil
+
+	for idx := range excludedPVCs {
+		pvc := &excludedPVCs[idx]
+		reason, _ := util.PVCExcludedFromProtection(pvc)
+
+		v.instance.Status.ExcludedPVCs = append(v.instance.Status.ExcludedPVCs, ramendrv1alpha1.ExcludedPVC{
+			Namespace: pvc.Namespace,
+			Name:      pvc.Name,
+			Reason:    reason,
+		})
+	}
+
+	return pvcList, nil
 }
 
+// listPVCsOwnedByVrg lists the PVCs owned by the VRG, including those that are excluded from protection,
+// so that they are unprotected once they are excluded
 func (v *VRGInstance) listPVCsOwnedByVrg() (*corev1.PersistentVolumeClaimList, error) {
 	vrg := v.instance
 
-	return v.listPVCsByPVCSelector(metav1.LabelSelector{MatchLabels: util.OwnerLabels(vrg)})
+	pvcList, excludedPVCs, err := v.listPVCsAndExcludedPVCsByPVCSelector(
+		metav1.LabelSelector{MatchLabels: util.OwnerLabels(vrg)})
+	if err != nil {
+		return nil, err
+	}
+
+	pvcList.Items = append(pvcList.Items, excludedPVCs...)
+
+	return pvcList, nil
 }
 
-func (v *VRGInstance) listPVCsByPVCSelector(labelSelector metav1.LabelSelector,
-) (*corev1.PersistentVolumeClaimList, error) {
-	return util.ListPVCsByPVCSelector(v.ctx, v.reconciler.Client, v.log,
+func (v *VRGInstance) listPVCsAndExcludedPVCsByPVCSelector(labelSelector metav1.LabelSelector,
+) (*corev1.PersistentVolumeClaimList, []corev1.PersistentVolumeClaim, error) {
+	return util.ListPVCsAndExcludedPVCsByPVCSelector(v.ctx, v.reconciler.Client, v.log,
 		labelSelector,
 		v.recipeElements.PvcSelector.NamespaceNames,
 		v.instance.Spec.VolSync.Disabled,
@@ -745,12 +845,12 @@ func (v *VRGInstance) updateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimList)
 		return nil
 	}
 
-	offloaded, err := v.processOffloadedPVCs(pvcList)
+	pvcList, err := v.processOffloadedPVCs(pvcList)
 	if err != nil {
 		return err
 	}
 
-	if offloaded {
+	if len(pvcList.Items) == 0 {
 		return nil
 	}
 
@@ -758,8 +858,10 @@ func (v *VRGInstance) updateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimList)
 	return v.separateAsyncPVCs(pvcList)
 }
 
-func isOffloadedByPeerClass(scName, sID string, peerClass *ramendrv1alpha1.PeerClass) bool {
-	if scName != peerClass.StorageClassName {
+func isOffloadedByPeerClass(scName, sID string, peerClass *ramendrv1alpha1.PeerClass,
+	scMapping map[string]string,
+) bool {
+	if !peerClassMatchesStorageClassName(peerClass, scName, scMapping) {
 		return false
 	}
 
@@ -782,7 +884,8 @@ func (v *VRGInstance) isOffloadedByPeerClasses(sc *storagev1.StorageClass) (bool
 	sID := sc.GetLabels()[StorageIDLabel]
 
 	for idx := range v.instance.Spec.Async.PeerClasses {
-		if isOffloadedByPeerClass(sc.GetName(), sID, &v.instance.Spec.Async.PeerClasses[idx]) {
+		if isOffloadedByPeerClass(sc.GetName(), sID, &v.instance.Spec.Async.PeerClasses[idx],
+			v.instance.Spec.StorageClassMapping) {
 			return true, nil
 		}
 	}
@@ -790,34 +893,36 @@ func (v *VRGInstance) isOffloadedByPeerClasses(sc *storagev1.StorageClass) (bool
 	return false, nil
 }
 
-// processOffloadedPVCs ensures either all PVCs for the VRG are offloaded, or none are. IF all are offloaded then
-// the VR PVC list is updated with the PVC list
+// processOffloadedPVCs separates the offloaded PVCs for the VRG into the VR PVC list, labeling them for their
+// consistency group, and returns the PVCs that are not offloaded, to be separated by separateAsyncPVCs. The final
+// sync of a VRG that protects both is coordinated by replication type, see updateFinalSyncStatus.
 //
 //nolint:gocognit,cyclop,funlen
-func (v *VRGInstance) processOffloadedPVCs(pvcList *corev1.PersistentVolumeClaimList) (bool, error) {
+func (v *VRGInstance) processOffloadedPVCs(pvcList *corev1.PersistentVolumeClaimList,
+) (*corev1.PersistentVolumeClaimList, error) {
 	if len(v.instance.Spec.Async.PeerClasses) == 0 {
-		return false, nil
+		return pvcList, nil
 	}
 
-	offloaded := false
+	notOffloaded := &corev1.PersistentVolumeClaimList{}
 
 	for idx := range pvcList.Items {
 		pvc := &pvcList.Items[idx]
 
 		storageClass, err := v.validateAndGetStorageClass(pvc.Spec.StorageClassName, pvc)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
 		pvcOffloadedBySC := util.HasLabel(storageClass, StorageOffloadedLabel)
 
 		pvcOffloadedByPeer, err := v.isOffloadedByPeerClasses(storageClass)
 		if err != nil {
-			return false, err
+			return nil, err
 		}
 
 		if pvcOffloadedByPeer != pvcOffloadedBySC {
-			return false, fmt.Errorf(
+			return nil, fmt.Errorf(
 				"mismatched peerClass offload support (%t) with current StorageClass "+
 					"(%s) offload support (%t) for PVC (%s/%s)",
 				pvcOffloadedByPeer,
@@ -828,40 +933,43 @@ func (v *VRGInstance) processOffloadedPVCs(pvcList *corev1.PersistentVolumeClaim
 			)
 		}
 
-		if idx == 0 {
-			if pvcOffloadedBySC {
-				offloaded = true
-			}
+		if !pvcOffloadedBySC {
+			notOffloaded.Items = append(notOffloaded.Items, *pvc)
 
 			continue
 		}
 
-		if pvcOffloadedBySC == offloaded {
-			continue
-		}
+		v.offloadedPVCs = append(v.offloadedPVCs, *pvc)
+	}
 
-		return false, fmt.Errorf("invalid list of PVCs to protect, found a mix of offloaded and non-offloaded PVCs")
+	if len(v.offloadedPVCs) == 0 {
+		return notOffloaded, nil
 	}
 
-	if !offloaded {
-		return offloaded, nil
+	if v.protectOnly() {
+		return nil, fmt.Errorf("offloaded protection requires consistency group labels on PVCs, " +
+			"which are not added in the protect only mode")
 	}
 
-	for idx := range pvcList.Items {
-		pvc := &pvcList.Items[idx]
+	for idx := range v.offloadedPVCs {
+		pvc := &v.offloadedPVCs[idx]
 
 		if err := v.addVolRepConsistencyGroupLabel(pvc); err != nil {
-			return offloaded, fmt.Errorf("failed to label offloaded PVC %s/%s for consistency group (%w)",
+			return nil, fmt.Errorf("failed to label offloaded PVC %s/%s for consistency group (%w)",
 				pvc.GetNamespace(), pvc.GetName(), err)
 		}
 	}
 
-	v.volRepPVCs = make([]corev1.PersistentVolumeClaim, len(pvcList.Items))
-	copy(v.volRepPVCs, pvcList.Items)
+	v.volRepPVCs = append(v.volRepPVCs, v.offloadedPVCs...)
 
-	v.log.Info(fmt.Sprintf("Found %d PVCs targeted for offloaded protection", len(v.volRepPVCs)))
+	v.log.Info(fmt.Sprintf("Found %d PVCs targeted for offloaded protection", len(v.offloadedPVCs)))
 
-	return offloaded, nil
+	if len(notOffloaded.Items) != 0 {
+		v.log.Info(fmt.Sprintf("Found %d PVCs that are not offloaded, protecting them with mixed replication types",
+			len(notOffloaded.Items)))
+	}
+
+	return notOffloaded, nil
 }
 
 // addVolRepConsistencyGroupLabel ensures that the given PVC is labeled as part of a consistency group.
@@ -1051,8 +1159,8 @@ func (v *VRGInstance) separatePVCUsingPeerClassAndSC(peerClasses []ramendrv1alph
 		if peerClass.ReplicationID != "" {
 			replicationClass := v.findReplicationClassUsingPeerClass(peerClass, storageClass)
 			if replicationClass != nil {
-				// label VolRep PVCs if peerClass.grouping is enabled
-				if peerClass.Grouping {
+				// label VolRep PVCs if peerClass.grouping is enabled, unless they are not to be modified
+				if peerClass.Grouping && !v.protectOnly() {
 					if err := v.addVolRepConsistencyGroupLabel(pvc); err != nil {
 						return fmt.Errorf("failed to label PVC %s/%s for consistency group (%w)",
 							pvc.GetNamespace(), pvc.GetName(), err)
@@ -1082,9 +1190,18 @@ func (v *VRGInstance) separatePVCUsingPeerClassAndSC(peerClasses []ramendrv1alph
 		return fmt.Errorf("failed to find snapshotClass for PVC %s/%s", pvc.Namespace, pvc.Name)
 	}
 
-	// label VolSync PVCs if peerClass.grouping is enabled
-	if peerClass.Grouping && !v.instance.Spec.RunFinalSync {
-		if err := v.addConsistencyGroupLabel(pvc); err != nil {
+	// label VolSync PVCs if peerClass.grouping is enabled, and their storage can snapshot them as a group,
+	// unless they are not to be modified
+	if peerClass.Grouping && !v.instance.Spec.RunFinalSync && !v.protectOnly() {
+		groupSnapshotClassFound, err := v.findVolGroupSnapClass(storageClass)
+		if err != nil {
+			return err
+		}
+
+		if !groupSnapshotClassFound {
+			v.log.Info("No VolumeGroupSnapshotClass for the storage of the PVC, protecting it individually",
+				"pvc", pvc.GetNamespace()+"/"+pvc.GetName(), "provisioner", storageClass.Provisioner)
+		} else if err := v.addConsistencyGroupLabel(pvc); err != nil {
 			return fmt.Errorf("failed to label PVC %s/%s for consistency group (%w)",
 				pvc.GetNamespace(), pvc.GetName(), err)
 		}
@@ -1119,9 +1236,9 @@ func (v *VRGInstance) separateAsyncPVCs(pvcList *corev1.PersistentVolumeClaimLis
 	}
 
 	v.log.Info(fmt.Sprintf("Found %d PVCs targeted for VolRep and %d targeted for VolSync",
-		len(v.volRepPVCs), len(v.volSyncPVCs)))
+		len(v.volRepPVCs)-len(v.offloadedPVCs), len(v.volSyncPVCs)))
 
-	if len(pvcList.Items) != (len(v.volRepPVCs) + len(v.volSyncPVCs)) {
+	if len(pvcList.Items)+len(v.offloadedPVCs) != (len(v.volRepPVCs) + len(v.volSyncPVCs)) {
 		return fmt.Errorf("no PVCs are procted")
 	}
 
@@ -1203,6 +1320,30 @@ func (v *VRGInstance) findVolSnapClass(storageClass *storagev1.StorageClass,
 	return nil, nil
 }
 
+// findVolGroupSnapClass returns true if a VolumeGroupSnapshotClass selected by the VRG has the
+// provisioner of a storage class as its driver, so that VolSync can replicate its PVCs as a
+// consistency group.
+func (v *VRGInstance) findVolGroupSnapClass(storageClass *storagev1.StorageClass) (bool, error) {
+	if !v.volSyncCGEnabled() {
+		return false, nil
+	}
+
+	volumeGroupSnapshotClasses, err := util.GetVolumeGroupSnapshotClasses(v.ctx, v.reconciler.Client,
+		v.instance.Spec.Async.VolumeGroupSnapshotClassSelector)
+	if err != nil {
+		return false, err
+	}
+
+	for _, volumeGroupSnapshotClass := range volumeGroupSnapshotClasses {
+		if util.VolumeGroupSnapshotClassMatchStorageProviders(volumeGroupSnapshotClass,
+			[]string{storageClass.Provisioner}) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func (v *VRGInstance) validateAndGetStorageClass(scName *string, pvc *corev1.PersistentVolumeClaim,
 ) (*storagev1.StorageClass, error) {
 	if scName == nil || *scName == "" {
@@ -1217,6 +1358,15 @@ func (v *VRGInstance) validateAndGetStorageClass(scName *string, pvc *corev1.Per
 	return storageClass, nil
 }
 
+// peerClassMatchesStorageClassName returns true if the StorageClass of the passed in peerClass is named scName on
+// the cluster, as the peerClass carries the name of the StorageClass on the first cluster of its peers, which scMapping
+// maps to the name of the StorageClass of the same storage on the cluster
+func peerClassMatchesStorageClassName(peerClass *ramendrv1alpha1.PeerClass, scName string,
+	scMapping map[string]string,
+) bool {
+	return *util.MappedStorageClassName(scMapping, &peerClass.StorageClassName) == scName
+}
+
 func (v *VRGInstance) findPeerClassMatchingSC(
 	storageClass *storagev1.StorageClass,
 	peerClasses []ramendrv1alpha1.PeerClass,
@@ -1229,7 +1379,8 @@ func (v *VRGInstance) findPeerClassMatchingSC(
 	}
 
 	for idx := range peerClasses {
-		if storageClass.GetName() == peerClasses[idx].StorageClassName {
+		if peerClassMatchesStorageClassName(&peerClasses[idx], storageClass.GetName(),
+			v.instance.Spec.StorageClassMapping) {
 			peerClass = &peerClasses[idx]
 		}
 	}
@@ -1570,12 +1721,11 @@ func (v *VRGInstance) reconcileAsPrimary() {
 		volSync bool
 	}
 
-	vrg := v.instance
 	v.result.Requeue = v.reconcileVolSyncAsPrimary(&finalSyncPrepared.volSync)
 	v.reconcileVolRepsAsPrimary()
 
-	if vrg.Spec.PrepareForFinalSync {
-		vrg.Status.PrepareForFinalSyncComplete = finalSyncPrepared.volSync
+	if !v.updateFinalSyncStatus(finalSyncPrepared.volSync) {
+		v.result.Requeue = true
 	}
 
 	if !v.result.Requeue && v.isVMRecipeProtection() {
@@ -1585,6 +1735,107 @@ func (v *VRGInstance) reconcileAsPrimary() {
 	}
 }
 
+// updateFinalSyncStatus records the final sync progress of each replication type, and completes the final sync of
+// the VRG only once the PVCs of all its replication types are ready for it, so that the PVCs of a VRG with mixed
+// replication types are demoted to secondary together. It returns false if the final sync of the VolSync PVCs is
+// complete, but it waits for the PVCs of the other replication types.
+func (v *VRGInstance) updateFinalSyncStatus(volSyncFinalSyncPrepared bool) bool {
+	vrg := v.instance
+
+	v.finalSync = map[ramendrv1alpha1.ReplicationType]replicationTypeFinalSync{
+		ramendrv1alpha1.ReplicationTypeVolSync: {
+			prepared: (vrg.Spec.PrepareForFinalSync || vrg.Spec.RunFinalSync) && volSyncFinalSyncPrepared,
+			complete: vrg.Spec.RunFinalSync && vrg.Status.FinalSyncComplete,
+		},
+	}
+	volRepFinalSyncReady := v.volRepFinalSyncReady()
+
+	if vrg.Spec.PrepareForFinalSync {
+		vrg.Status.PrepareForFinalSyncComplete = volSyncFinalSyncPrepared
+	}
+
+	if vrg.Status.FinalSyncComplete && !volRepFinalSyncReady {
+		vrg.Status.FinalSyncComplete = false
+
+		return false
+	}
+
+	return true
+}
+
+// replicationTypeFinalSync is the final sync progress of the PVCs of a replication type
+type replicationTypeFinalSync struct {
+	prepared bool
+	complete bool
+	message  string
+}
+
+// volRepFinalSyncReady returns whether the PVCs protected by VolRep, including the offloaded ones, are ready for
+// their final sync, which is taken by their demotion to secondary once they are no longer in use, and records the
+// final sync progress of their replication types
+func (v *VRGInstance) volRepFinalSyncReady() bool {
+	inUse := map[ramendrv1alpha1.ReplicationType]int{}
+
+	if v.instance.Spec.RunFinalSync {
+		for idx := range v.volRepPVCs {
+			pvc := &v.volRepPVCs[idx]
+
+			if v.pvcInUseForFinalSync(pvc) {
+				inUse[v.volRepPVCReplicationType(pvc.GetNamespace(), pvc.GetName())]++
+			}
+		}
+	}
+
+	for _, replicationType := range []ramendrv1alpha1.ReplicationType{
+		ramendrv1alpha1.ReplicationTypeVolRep,
+		ramendrv1alpha1.ReplicationTypeOffloaded,
+	} {
+		finalSync := replicationTypeFinalSync{
+			prepared: v.instance.Spec.PrepareForFinalSync || v.instance.Spec.RunFinalSync,
+			complete: v.instance.Spec.RunFinalSync && inUse[replicationType] == 0,
+		}
+
+		if inUse[replicationType] != 0 {
+			finalSync.message = fmt.Sprintf("waiting for %d PVCs to be no longer in use", inUse[replicationType])
+		}
+
+		v.finalSync[replicationType] = finalSync
+	}
+
+	return len(inUse) == 0
+}
+
+func (v *VRGInstance) pvcInUseForFinalSync(pvc *corev1.PersistentVolumeClaim) bool {
+	log := v.log.WithValues("pvc", client.ObjectKeyFromObject(pvc).String())
+
+	inUseByPod, err := util.IsPVCInUseByPod(v.ctx, v.reconciler.Client, log, client.ObjectKeyFromObject(pvc), false)
+	if err != nil || inUseByPod {
+		log.Info("Final sync waits for PVC no longer in use by a pod", "errorValue", err)
+
+		return true
+	}
+
+	attached, err := util.IsPVAttachedToNode(v.ctx, v.reconciler.Client, log, pvc)
+	if err != nil || attached {
+		log.Info("Final sync waits for PersistentVolume of PVC no longer attached to node(s)", "errorValue", err)
+
+		return true
+	}
+
+	return false
+}
+
+// volRepPVCReplicationType returns the replication type of a PVC protected by VolRep
+func (v *VRGInstance) volRepPVCReplicationType(namespace, name string) ramendrv1alpha1.ReplicationType {
+	for idx := range v.offloadedPVCs {
+		if v.offloadedPVCs[idx].GetNamespace() == namespace && v.offloadedPVCs[idx].GetName() == name {
+			return ramendrv1alpha1.ReplicationTypeOffloaded
+		}
+	}
+
+	return ramendrv1alpha1.ReplicationTypeVolRep
+}
+
 func (v *VRGInstance) pvcsDeselectedUnprotect() error {
 	log := v.log.WithName("PvcsDeselectedUnprotect")
 
@@ -1778,6 +2029,16 @@ func (v *VRGInstance) invalid(err error, msg string, requeue bool) ctrl.Result {
 	return v.dataError(err, msg, requeue)
 }
 
+// recipeParametersInvalid reports recipe parameters that do not match the recipe parameters
+// declaration. No requeue, as a change to the VRG or the recipe triggers a reconcile.
+func (v *VRGInstance) recipeParametersInvalid(err error) ctrl.Result {
+	util.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+		util.EventReasonValidationFailed, err.Error())
+	v.errorConditionLogAndSet(err, "Recipe parameters are invalid", setVRGDataRecipeParametersInvalidCondition)
+
+	return v.updateVRGStatus(ctrl.Result{})
+}
+
 func (v *VRGInstance) dataError(err error, msg string, requeue bool) ctrl.Result {
 	v.errorConditionLogAndSet(err, msg, setVRGDataErrorCondition)
 
@@ -1823,7 +2084,7 @@ func (v *VRGInstance) updateVRGStatus(result ctrl.Result) ctrl.Result {
 		}
 	}
 
-	if util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
+	if v.volSyncCGEnabled() {
 		if err := v.updateProtectedCGsForVolSync(&pvcGroups); err != nil {
 			v.log.Info(fmt.Sprintf("Failed to update protected by VolSync PVC groups (%v/%s)",
 				err, v.instance.Name))
@@ -2106,9 +2367,92 @@ func (v *VRGInstance) updateVRGConditions() {
 	v.logAndSetConditions(VRGConditionTypeAutoCleanup,
 		v.aggregateVRGAutoCleanupCondition())
 
+	v.updateVRGDataVerifiedCondition()
+	v.updateVRGCapacityMatchedCondition()
+	v.updateVRGProtectOnlyCondition()
+	v.updateVRGReplicationDegradedCondition()
+	v.updateVRGReplicationTypes()
+
 	v.updateVRGLastGroupSyncTime()
 	v.updateVRGLastGroupSyncDuration()
 	v.updateLastGroupSyncBytes()
+	v.updateVRGSyncDeferredUntil()
+	v.updateVRGGroupSyncProgress()
+	v.updateVRGVolSyncPSKIdentities()
+}
+
+// updateVRGDataVerifiedCondition sets the DataVerified condition, which is only present when the
+// VolSync destinations are verified.
+func (v *VRGInstance) updateVRGDataVerifiedCondition() {
+	var dataVerified *metav1.Condition
+	if v.instance.Spec.Sync == nil {
+		dataVerified = v.aggregateVolSyncDataVerifiedCondition()
+	}
+
+	if dataVerified == nil {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeDataVerified)
+
+		return
+	}
+
+	util.SetStatusCondition(&v.instance.Status.Conditions, *dataVerified)
+}
+
+// updateVRGReplicationTypes sets the progress of the PVCs by replication type, for a VRG that protects PVCs with
+// more than one
+func (v *VRGInstance) updateVRGReplicationTypes() {
+	pvcCounts := map[ramendrv1alpha1.ReplicationType]int{
+		ramendrv1alpha1.ReplicationTypeVolRep:    len(v.volRepPVCs) - len(v.offloadedPVCs),
+		ramendrv1alpha1.ReplicationTypeVolSync:   len(v.volSyncPVCs),
+		ramendrv1alpha1.ReplicationTypeOffloaded: len(v.offloadedPVCs),
+	}
+	dataProtectedPVCCounts := map[ramendrv1alpha1.ReplicationType]int{}
+
+	for idx := range v.instance.Status.ProtectedPVCs {
+		protectedPVC := &v.instance.Status.ProtectedPVCs[idx]
+
+		if protectedPVC.ProtectedByVolSync {
+			condition := util.FindCondition(protectedPVC.Conditions, VRGConditionTypeVolSyncRepSourceSetup)
+			if condition != nil && condition.Status == metav1.ConditionTrue && protectedPVC.LastSyncTime != nil {
+				dataProtectedPVCCounts[ramendrv1alpha1.ReplicationTypeVolSync]++
+			}
+
+			continue
+		}
+
+		condition := util.FindCondition(protectedPVC.Conditions, VRGConditionTypeDataProtected)
+		if condition != nil && condition.Status == metav1.ConditionTrue {
+			dataProtectedPVCCounts[v.volRepPVCReplicationType(protectedPVC.Namespace, protectedPVC.Name)]++
+		}
+	}
+
+	var replicationTypes []ramendrv1alpha1.ReplicationTypeStatus
+
+	for _, replicationType := range []ramendrv1alpha1.ReplicationType{
+		ramendrv1alpha1.ReplicationTypeVolRep,
+		ramendrv1alpha1.ReplicationTypeVolSync,
+		ramendrv1alpha1.ReplicationTypeOffloaded,
+	} {
+		if pvcCounts[replicationType] == 0 {
+			continue
+		}
+
+		finalSync := v.finalSync[replicationType]
+		replicationTypes = append(replicationTypes, ramendrv1alpha1.ReplicationTypeStatus{
+			Type:                  replicationType,
+			PVCCount:              pvcCounts[replicationType],
+			DataProtectedPVCCount: dataProtectedPVCCounts[replicationType],
+			FinalSyncPrepared:     finalSync.prepared,
+			FinalSyncComplete:     finalSync.complete,
+			Message:               finalSync.message,
+		})
+	}
+
+	if len(replicationTypes) < 2 {
+		replicationTypes = nil
+	}
+
+	v.instance.Status.ReplicationTypes = replicationTypes
 }
 
 func (v *VRGInstance) vrgReadyStatus(reason string) *metav1.Condition {
@@ -2204,6 +2548,97 @@ func (v *VRGInstance) updateLastGroupSyncBytes() {
 	v.instance.Status.LastGroupSyncBytes = totalLastSyncBytes
 }
 
+// updateVRGSyncDeferredUntil reports the latest time until which the syncs of a protected PVC
+// are deferred by the sync windows
+func (v *VRGInstance) updateVRGSyncDeferredUntil() {
+	var latestSyncDeferredUntil *metav1.Time
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		if protectedPVC.SyncDeferredUntil == nil {
+			continue
+		}
+
+		if latestSyncDeferredUntil == nil || latestSyncDeferredUntil.Before(protectedPVC.SyncDeferredUntil) {
+			latestSyncDeferredUntil = protectedPVC.SyncDeferredUntil
+		}
+	}
+
+	v.instance.Status.SyncDeferredUntil = latestSyncDeferredUntil
+}
+
+// updateVRGGroupSyncProgress reports the progress of the syncs in progress of the protected PVCs
+// in total. The group is in the earliest phase of its PVCs, and completes when its last PVC does.
+// Bytes are totaled over the PVCs reporting them.
+func (v *VRGInstance) updateVRGGroupSyncProgress() {
+	var groupProgress *ramendrv1alpha1.VolSyncSyncProgress
+
+	phases := []ramendrv1alpha1.VolSyncSyncPhase{
+		ramendrv1alpha1.VolSyncSyncPhasePreparing,
+		ramendrv1alpha1.VolSyncSyncPhaseTransferring,
+		ramendrv1alpha1.VolSyncSyncPhaseCleaningUp,
+	}
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		progress := protectedPVC.SyncProgress
+		if progress == nil {
+			continue
+		}
+
+		if groupProgress == nil {
+			groupProgress = progress.DeepCopy()
+
+			continue
+		}
+
+		if slices.Index(phases, progress.Phase) < slices.Index(phases, groupProgress.Phase) {
+			groupProgress.Phase = progress.Phase
+		}
+
+		if progress.StartTime != nil &&
+			(groupProgress.StartTime == nil || progress.StartTime.Before(groupProgress.StartTime)) {
+			groupProgress.StartTime = progress.StartTime
+		}
+
+		groupProgress.BytesTransferred = addInt64s(groupProgress.BytesTransferred, progress.BytesTransferred)
+		groupProgress.TotalBytes = addInt64s(groupProgress.TotalBytes, progress.TotalBytes)
+
+		if progress.EstimatedCompletionTime != nil && (groupProgress.EstimatedCompletionTime == nil ||
+			groupProgress.EstimatedCompletionTime.Before(progress.EstimatedCompletionTime)) {
+			groupProgress.EstimatedCompletionTime = progress.EstimatedCompletionTime
+		}
+	}
+
+	v.instance.Status.GroupSyncProgress = groupProgress
+}
+
+func addInt64s(a, b *int64) *int64 {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	default:
+		return ptr.To(*a + *b)
+	}
+}
+
+// updateVRGVolSyncPSKIdentities reports the identities of the keys of the VolSync replication
+// secret, for the hub to pace the rotation of the key
+func (v *VRGInstance) updateVRGVolSyncPSKIdentities() {
+	if v.instance.Spec.Sync != nil {
+		return
+	}
+
+	identities, err := v.volSyncHandler.GetPSKIdentities()
+	if err != nil {
+		v.log.Info("Failed to get VolSync psk identities", "error", err.Error())
+
+		return
+	}
+
+	v.instance.Status.VolSyncPSKIdentities = identities
+}
+
 // isVRGReasonError returns true if the passed in VRG condition reason matches any errors reported as the Reason
 func isVRGReasonError(condition *metav1.Condition) bool {
 	return condition.Reason == VRGConditionReasonError ||
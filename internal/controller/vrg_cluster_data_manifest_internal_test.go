@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+func TestClusterDataManifestUploaded(t *testing.T) {
+	manifest := ClusterDataManifest{PVKeys: []string{"pv"}, PVCKeys: []string{"pvc"}}
+	uploaded := uploadedClusterDataManifest{
+		uid:              "uid",
+		generation:       1,
+		replicationState: ramendrv1alpha1.Primary,
+		manifest:         manifest,
+	}
+
+	tests := []struct {
+		name             string
+		uid              types.UID
+		generation       int64
+		replicationState ramendrv1alpha1.ReplicationState
+		manifest         ClusterDataManifest
+		cached           bool
+		uploaded         bool
+	}{
+		{
+			name: "not cached", uid: "uid", generation: 1, replicationState: ramendrv1alpha1.Primary,
+			manifest: manifest,
+		},
+		{
+			name: "unchanged", uid: "uid", generation: 1, replicationState: ramendrv1alpha1.Primary,
+			manifest: manifest, cached: true, uploaded: true,
+		},
+		{
+			name: "manifest changed", uid: "uid", generation: 1, replicationState: ramendrv1alpha1.Primary,
+			manifest: ClusterDataManifest{PVKeys: []string{"pv", "pv2"}, PVCKeys: []string{"pvc", "pvc2"}},
+			cached:   true,
+		},
+		{
+			name: "generation changed", uid: "uid", generation: 2, replicationState: ramendrv1alpha1.Primary,
+			manifest: manifest, cached: true,
+		},
+		{
+			name: "replication state changed", uid: "uid", generation: 1,
+			replicationState: ramendrv1alpha1.Secondary, manifest: manifest, cached: true,
+		},
+		{
+			name: "recreated", uid: "uid2", generation: 1, replicationState: ramendrv1alpha1.Primary,
+			manifest: manifest, cached: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrg := &ramendrv1alpha1.VolumeReplicationGroup{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "vrg", Namespace: "app", UID: tt.uid, Generation: tt.generation,
+				},
+				Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{ReplicationState: tt.replicationState},
+			}
+			v := &VRGInstance{
+				reconciler:     &VolumeReplicationGroupReconciler{},
+				instance:       vrg,
+				namespacedName: "app/vrg",
+			}
+
+			if tt.cached {
+				v.reconciler.clusterDataManifests.Store(v.namespacedName, uploaded)
+			}
+
+			assert.Equal(t, tt.uploaded, v.clusterDataManifestUploaded(tt.manifest))
+		})
+	}
+}
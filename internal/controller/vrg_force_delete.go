@@ -0,0 +1,462 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+const (
+	// VRGForceDeleteAnnotation, when set to "true" on a VRG, forces its deletion past the cleanup steps that fail
+	VRGForceDeleteAnnotation = "volumereplicationgroups.ramendr.openshift.io/force-delete"
+
+	// vrgDeferredCleanupLabel labels the ConfigMap that holds the deferred cleanup of a forcibly deleted VRG
+	vrgDeferredCleanupLabel = "volumereplicationgroups.ramendr.openshift.io/deferred-cleanup"
+
+	vrgDeferredCleanupNamePrefix    = "vrg-deferred-cleanup-"
+	vrgDeferredCleanupDataKey       = "cleanup"
+	vrgDeferredCleanupRetryInterval = time.Minute
+)
+
+// vrgDeferredCleanup is the cleanup of a forcibly deleted VRG that did not complete before its finalizer was
+// removed. It is kept in a ConfigMap in the namespace of the ramen operator, which unlike the namespace of the VRG
+// is not writable by the users of the workload, and retried until it completes.
+type vrgDeferredCleanup struct {
+	// VRG is the deleted VRG, as it was when its finalizer was removed
+	VRG ramendrv1alpha1.VolumeReplicationGroup `json:"vrg"`
+
+	// PVCs are the namespaced names of the PVCs whose finalizers and PV retention are yet to be undone
+	PVCs []string `json:"pvcs,omitempty"`
+
+	// S3Profiles are the names of the S3 profiles whose key prefix of the VRG is yet to be deleted
+	S3Profiles []string `json:"s3Profiles,omitempty"`
+
+	// Skipped are the cleanup steps that failed, and were skipped by the forced deletion
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+func (v *VRGInstance) forceDeleteRequested() bool {
+	return v.instance.GetAnnotations()[VRGForceDeleteAnnotation] == "true"
+}
+
+// vrgDeferredCleanupName returns the name of the deferred cleanup ConfigMap of a VRG, which is unique across the
+// namespaces of the VRGs, and across the VRGs recreated with a name
+func vrgDeferredCleanupName(vrg *ramendrv1alpha1.VolumeReplicationGroup) string {
+	return vrgDeferredCleanupNamePrefix + string(vrg.UID)
+}
+
+// vrgDeferredCleanupLabels returns the labels of the deferred cleanup ConfigMap of a VRG, which mark the VRG as its
+// owner
+func vrgDeferredCleanupLabels(vrgNamespacedName types.NamespacedName) map[string]string {
+	return map[string]string{
+		vrgDeferredCleanupLabel:      "true",
+		util.LabelOwnerNamespaceName: vrgNamespacedName.Namespace,
+		util.LabelOwnerName:          vrgNamespacedName.Name,
+	}
+}
+
+// processForForcedDeletion deletes a VRG whose deletion is forced. The blocking checks of the VolumeReplication
+// state and the PVC use are skipped, and each cleanup step is attempted once. The steps that fail are reported in
+// an event and, for the PV retention undo and the S3 key prefix deletion, deferred to after the VRG is deleted.
+//
+//nolint:funlen,cyclop
+func (v *VRGInstance) processForForcedDeletion() ctrl.Result {
+	v.log.Info("Entering processing VolumeReplicationGroup for forced deletion")
+
+	defer v.log.Info("Exiting processing VolumeReplicationGroup for forced deletion")
+
+	if !slices.Contains(v.instance.ObjectMeta.Finalizers, vrgFinalizerName) {
+		v.log.Info("Finalizer missing from resource", "finalizer", vrgFinalizerName)
+
+		return ctrl.Result{}
+	}
+
+	cleanup := vrgDeferredCleanup{}
+	skip := func(step string, err error) {
+		v.log.Info("Forced deletion skipped a cleanup step", "step", step, "error", err)
+		cleanup.Skipped = append(cleanup.Skipped, fmt.Sprintf("%s: %v", step, err))
+	}
+
+	// PVCs are listed only with the recipe, as its PVC selector may select fewer PVCs than an empty one
+	pvcsListed := false
+
+	recipeElements, err := RecipeElementsGet(v.ctx, v.reconciler.Client, *v.instance, *v.ramenConfig, v.log)
+	if err != nil {
+		skip("get recipe", err)
+	} else {
+		v.recipeElements = recipeElements
+
+		if err := v.updatePVCList(); err != nil {
+			skip("list PVCs", err)
+		} else {
+			pvcsListed = true
+		}
+	}
+
+	v.s3StoreAccessorsGet()
+
+	if err := v.disownPVCs(); err != nil {
+		skip("disown PVCs", err)
+	}
+
+	// Without the list of selected PVCs, every PVC owned by the VRG would be unprotected as deselected
+	if pvcsListed {
+		if err := v.pvcsDeselectedUnprotect(); err != nil {
+			skip("unprotect deselected PVCs", err)
+		}
+	}
+
+	if err := v.cleanupResources(); err != nil {
+		skip("cleanup VolSync resources", err)
+	}
+
+	cleanup.PVCs = v.forcedVolRepPVCsUnprotect()
+	if len(cleanup.PVCs) != 0 {
+		skip("undo PVC finalizers and PV retention", fmt.Errorf("deferred for PVCs %v", cleanup.PVCs))
+	}
+
+	result := ctrl.Result{}
+	if err := v.kubeObjectsProtectionDelete(&result); err != nil {
+		skip("delete kube objects protection requests", err)
+	}
+
+	if v.instance.Spec.ReplicationState == ramendrv1alpha1.Primary {
+		if err := v.deleteClusterDataInS3Stores(v.log); err != nil {
+			cleanup.S3Profiles = v.instance.Spec.S3Profiles
+			skip("delete cluster data in S3 stores", err)
+		}
+	}
+
+	if len(cleanup.PVCs) != 0 || len(cleanup.S3Profiles) != 0 {
+		if err := v.deferredCleanupCreate(&cleanup); err != nil {
+			v.log.Info("Failed to defer cleanup", "errorValue", err)
+
+			return ctrl.Result{Requeue: true}
+		}
+	}
+
+	if len(cleanup.Skipped) != 0 {
+		util.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+			util.EventReasonForceDeleteSkipped, "Forced deletion skipped: "+strings.Join(cleanup.Skipped, "; "))
+	}
+
+	if err := v.removeFinalizer(vrgFinalizerName); err != nil {
+		v.log.Info("Failed to remove finalizer", "finalizer", vrgFinalizerName, "errorValue", err)
+
+		return ctrl.Result{Requeue: true}
+	}
+
+	util.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeNormal,
+		util.EventReasonDeleteSuccess, "Deletion Success")
+
+	return ctrl.Result{}
+}
+
+// forcedVolRepPVCsUnprotect unprotects the PVCs protected by VolumeReplication the way a deletion does, with their
+// VolumeReplications or VolumeGroupReplications, except for the PVCs owned by another VRG. The PVCs it fails to
+// unprotect then have their finalizers and PV retention undone without the checks of the VolumeReplication state,
+// and it returns the namespaced names of those for which this fails too.
+func (v *VRGInstance) forcedVolRepPVCsUnprotect() []string {
+	var failed []string
+
+	pvcs := make([]corev1.PersistentVolumeClaim, 0, len(v.volRepPVCs))
+
+	for idx := range v.volRepPVCs {
+		pvc := &v.volRepPVCs[idx]
+
+		if !v.pvcVRProtected(pvc) {
+			continue
+		}
+
+		ownedByAnother, err := v.pvcOwnedByAnotherVRG(pvc)
+		if err != nil {
+			v.log.Info("Failed to get owner of PVC", "pvc", client.ObjectKeyFromObject(pvc), "errorValue", err)
+
+			failed = append(failed, client.ObjectKeyFromObject(pvc).String())
+
+			continue
+		}
+
+		if !ownedByAnother {
+			pvcs = append(pvcs, *pvc)
+		}
+	}
+
+	v.volRepPVCs = pvcs
+	v.result.Requeue = false
+
+	if v.deleteVRGHandleMode(); !v.result.Requeue {
+		return failed
+	}
+
+	v.log.Info("Reconciling VolumeReplication for deletion failed, undoing PVC finalizers and PV retention")
+
+	for idx := range v.volRepPVCs {
+		pvc := &v.volRepPVCs[idx]
+
+		if !v.pvcVRProtected(pvc) {
+			continue
+		}
+
+		if v.undoPVCFinalizersAndPVRetention(pvc, logWithPvcName(v.log, pvc)) {
+			failed = append(failed, client.ObjectKeyFromObject(pvc).String())
+		}
+	}
+
+	return failed
+}
+
+func (v *VRGInstance) deferredCleanupCreate(cleanup *vrgDeferredCleanup) error {
+	v.instance.DeepCopyInto(&cleanup.VRG)
+	cleanup.VRG.ManagedFields = nil
+
+	data, err := json.Marshal(cleanup)
+	if err != nil {
+		return fmt.Errorf("failed to marshal deferred cleanup, %w", err)
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vrgDeferredCleanupName(v.instance),
+			Namespace: RamenOperatorNamespace(),
+			Labels:    vrgDeferredCleanupLabels(client.ObjectKeyFromObject(v.instance)),
+		},
+		Data: map[string]string{vrgDeferredCleanupDataKey: string(data)},
+	}
+
+	// A ConfigMap left by a prior attempt whose finalizer removal failed is replaced, as it may defer other steps
+	err = v.reconciler.Create(v.ctx, configMap)
+	if k8serrors.IsAlreadyExists(err) {
+		if err = v.reconciler.Delete(v.ctx, configMap); err == nil || k8serrors.IsNotFound(err) {
+			err = v.reconciler.Create(v.ctx, configMap)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to create deferred cleanup ConfigMap %s/%s, %w",
+			configMap.Namespace, configMap.Name, err)
+	}
+
+	v.log.Info("Deferred cleanup", "configMap", client.ObjectKeyFromObject(configMap).String(),
+		"pvcs", cleanup.PVCs, "s3Profiles", cleanup.S3Profiles)
+
+	return nil
+}
+
+// processDeferredCleanup retries the deferred cleanups of a forcibly deleted VRG, if any, until they complete. The
+// cleanup steps are idempotent, so a cleanup that is incomplete is retried whole.
+func (r *VolumeReplicationGroupReconciler) processDeferredCleanup(
+	ctx context.Context, vrgNamespacedName types.NamespacedName, log logr.Logger,
+) (ctrl.Result, error) {
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.APIReader.List(ctx, configMaps, client.InNamespace(RamenOperatorNamespace()),
+		client.MatchingLabels(vrgDeferredCleanupLabels(vrgNamespacedName))); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to list deferred cleanup ConfigMaps of VolumeReplicationGroup %v, %w",
+			vrgNamespacedName, err)
+	}
+
+	if len(configMaps.Items) == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	_, ramenConfig, err := ConfigMapGet(ctx, r.APIReader)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to get Ramen configmap: %w", err)
+	}
+
+	result := ctrl.Result{}
+
+	for idx := range configMaps.Items {
+		configMap := &configMaps.Items[idx]
+		log := log.WithName("DeferredCleanup").WithValues("configMap", configMap.Name)
+
+		complete, err := r.deferredCleanupProcess(ctx, configMap, vrgNamespacedName, ramenConfig, log)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		if !complete {
+			result.RequeueAfter = vrgDeferredCleanupRetryInterval
+		}
+	}
+
+	return result, nil
+}
+
+// deferredCleanupProcess retries a deferred cleanup, and deletes its ConfigMap once it completes
+func (r *VolumeReplicationGroupReconciler) deferredCleanupProcess(
+	ctx context.Context,
+	configMap *corev1.ConfigMap,
+	vrgNamespacedName types.NamespacedName,
+	ramenConfig *ramendrv1alpha1.RamenConfig,
+	log logr.Logger,
+) (bool, error) {
+	cleanup := vrgDeferredCleanup{}
+	if err := json.Unmarshal([]byte(configMap.Data[vrgDeferredCleanupDataKey]), &cleanup); err != nil ||
+		client.ObjectKeyFromObject(&cleanup.VRG) != vrgNamespacedName {
+		log.Info("Deleting deferred cleanup of another or no VolumeReplicationGroup", "errorValue", err)
+
+		return true, client.IgnoreNotFound(r.Delete(ctx, configMap))
+	}
+
+	v := VRGInstance{
+		reconciler:     r,
+		ctx:            ctx,
+		log:            log,
+		instance:       &cleanup.VRG,
+		ramenConfig:    ramenConfig,
+		namespacedName: vrgNamespacedName.String(),
+		objectStorers:  make(map[string]cachedObjectStorer),
+	}
+
+	pvcs := v.deferredPVCsCleanup(cleanup.PVCs)
+	s3Profiles := v.deferredS3KeyPrefixDelete(cleanup.S3Profiles)
+
+	if len(pvcs) != 0 || len(s3Profiles) != 0 {
+		log.Info("Deferred cleanup incomplete", "pvcs", pvcs, "s3Profiles", s3Profiles)
+
+		return false, nil
+	}
+
+	log.Info("Deferred cleanup complete")
+
+	return true, client.IgnoreNotFound(r.Delete(ctx, configMap))
+}
+
+// deferredPVCsCleanup undoes the finalizers and PV retention of the PVCs of a deleted VRG, unless they are gone,
+// or protected by another VRG, and returns those for which it failed
+func (v *VRGInstance) deferredPVCsCleanup(pvcNames []string) []string {
+	var remaining []string
+
+	for _, pvcName := range pvcNames {
+		namespace, name, _ := strings.Cut(pvcName, "/")
+		pvc := &corev1.PersistentVolumeClaim{}
+		log := v.log.WithValues("pvc", pvcName)
+
+		if err := v.reconciler.APIReader.Get(v.ctx, types.NamespacedName{Namespace: namespace, Name: name}, pvc); err != nil {
+			if !k8serrors.IsNotFound(err) {
+				log.Info("Failed to get PVC", "errorValue", err)
+
+				remaining = append(remaining, pvcName)
+			}
+
+			continue
+		}
+
+		ownedByAnother, err := v.pvcOwnedByAnotherVRG(pvc)
+		if err != nil {
+			log.Info("Failed to get owner of PVC", "errorValue", err)
+
+			remaining = append(remaining, pvcName)
+
+			continue
+		}
+
+		if ownedByAnother || !v.pvcVRProtected(pvc) {
+			continue
+		}
+
+		if v.undoPVCFinalizersAndPVRetention(pvc, log) {
+			remaining = append(remaining, pvcName)
+		}
+	}
+
+	return remaining
+}
+
+// pvcOwnedByAnotherVRG returns whether a PVC is owned, as its owner labels mark it, by another VRG than this one,
+// which is a VRG of another name, or a VRG that is recreated with the name of this one once it is deleted, and so
+// has another UID
+func (v *VRGInstance) pvcOwnedByAnotherVRG(pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	ownerNamespaceName, ownerName, ok := util.OwnerNamespaceNameAndName(pvc.GetLabels())
+	if !ok {
+		return false, nil
+	}
+
+	owner := types.NamespacedName{Namespace: ownerNamespaceName, Name: ownerName}
+	if owner != client.ObjectKeyFromObject(v.instance) {
+		return true, nil
+	}
+
+	vrg := &ramendrv1alpha1.VolumeReplicationGroup{}
+	if err := v.reconciler.APIReader.Get(v.ctx, owner, vrg); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("failed to get VolumeReplicationGroup %v owning PVC %v, %w", owner,
+			client.ObjectKeyFromObject(pvc), err)
+	}
+
+	return vrg.UID != v.instance.UID, nil
+}
+
+// deferredS3KeyPrefixDelete deletes the key prefix of a deleted VRG in S3 stores, and returns the S3 profiles for
+// which it failed. The key prefix is kept in a store whose VRG object is uploaded by another VRG, such as the VRG
+// of a peer cluster that the workload failed over to, as the objects under it are no longer of the deleted VRG.
+func (v *VRGInstance) deferredS3KeyPrefixDelete(s3ProfileNames []string) []string {
+	var remaining []string
+
+	keyPrefix := v.s3KeyPrefix()
+
+	for _, s3ProfileName := range s3ProfileNames {
+		if s3ProfileName == NoS3StoreAvailable {
+			continue
+		}
+
+		err := v.s3StoreDo(func(s ObjectStorer) error {
+			superseded, err := vrgObjectSuperseded(s, keyPrefix, v.instance)
+			if err != nil || superseded {
+				return err
+			}
+
+			return s.DeleteObjectsWithKeyPrefix(keyPrefix)
+		}, fmt.Sprintf("delete objects with key prefix %s", keyPrefix), s3ProfileName)
+		if err != nil {
+			v.log.Info("Failed to delete key prefix", "s3Profile", s3ProfileName, "errorValue", err)
+
+			remaining = append(remaining, s3ProfileName)
+		}
+	}
+
+	return remaining
+}
+
+// vrgObjectSuperseded returns whether the VRG object in an S3 store is uploaded by another VRG than the given one
+func vrgObjectSuperseded(s ObjectStorer, keyPrefix string, vrg *ramendrv1alpha1.VolumeReplicationGroup,
+) (bool, error) {
+	key := TypedObjectKey(keyPrefix, vrgS3ObjectNameSuffix, ramendrv1alpha1.VolumeReplicationGroup{})
+
+	keys, err := s.ListKeys(key)
+	if err != nil {
+		return false, fmt.Errorf("unable to ListKeys of VRG object %s, %w", key, err)
+	}
+
+	if !slices.Contains(keys, key) {
+		return false, nil
+	}
+
+	s3VRG := &ramendrv1alpha1.VolumeReplicationGroup{}
+	if err := vrgObjectDownload(s, keyPrefix, s3VRG); err != nil {
+		return false, fmt.Errorf("unable to download VRG object %s, %w", key, err)
+	}
+
+	return s3VRG.UID != vrg.UID, nil
+}
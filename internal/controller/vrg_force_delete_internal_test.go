@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// memoryObjectStoreGetter gets the in memory object storer for every S3 profile but the unreachable one
+type memoryObjectStoreGetter struct {
+	objectStorer memoryObjectStorer
+}
+
+const (
+	unreachableS3ProfileName = "unreachable"
+	forceDeleteOperatorNS    = "ramen-system"
+)
+
+func (g memoryObjectStoreGetter) ObjectStore(_ context.Context, _ client.Reader, s3ProfileName string, _ string,
+	_ logr.Logger,
+) (ObjectStorer, ramendrv1alpha1.S3StoreProfile, error) {
+	if s3ProfileName == unreachableS3ProfileName {
+		return nil, ramendrv1alpha1.S3StoreProfile{}, errors.New("S3 store unreachable")
+	}
+
+	return g.objectStorer, ramendrv1alpha1.S3StoreProfile{S3ProfileName: s3ProfileName}, nil
+}
+
+func forceDeleteVRG(uid types.UID) *ramendrv1alpha1.VolumeReplicationGroup {
+	return &ramendrv1alpha1.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app", UID: uid},
+		Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+			ReplicationState: ramendrv1alpha1.Primary,
+			Async:            &ramendrv1alpha1.VRGAsyncSpec{},
+			S3Profiles:       []string{"s3"},
+		},
+	}
+}
+
+func forceDeletePVC(ownerName string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "pvc",
+			Namespace:  "app",
+			Finalizers: []string{PvcVRFinalizerProtected},
+			Labels: map[string]string{
+				util.LabelOwnerNamespaceName: "app",
+				util.LabelOwnerName:          ownerName,
+			},
+			Annotations: map[string]string{pvcVRAnnotationProtectedKey: pvcVRAnnotationProtectedValue},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{VolumeName: "pv"},
+	}
+}
+
+func forceDeletePV() *corev1.PersistentVolume {
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pv",
+			Annotations: map[string]string{pvVRAnnotationRetentionKey: pvVRAnnotationRetentionValue},
+		},
+		Spec: corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain},
+	}
+}
+
+// forceDeleteVRGInstance returns the VRGInstance of the deleted VRG, with a fake client with the given objects, the
+// ramen config map and the PV of the PVC
+func forceDeleteVRGInstance(t *testing.T, objectStorer memoryObjectStorer, objects ...client.Object) *VRGInstance {
+	t.Helper()
+	t.Setenv("POD_NAMESPACE", forceDeleteOperatorNS)
+
+	configMapName := DrClusterOperatorConfigMapName
+	if ControllerType == ramendrv1alpha1.DRHubType {
+		configMapName = HubOperatorConfigMapName
+	}
+
+	k8sClient := newFakeClient(t, append(objects, forceDeletePV(), &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName, Namespace: forceDeleteOperatorNS},
+	})...)
+	vrg := forceDeleteVRG("deleted")
+
+	return &VRGInstance{
+		reconciler: &VolumeReplicationGroupReconciler{
+			Client:         k8sClient,
+			APIReader:      k8sClient,
+			ObjStoreGetter: memoryObjectStoreGetter{objectStorer: objectStorer},
+		},
+		ctx:            context.TODO(),
+		log:            logr.Discard(),
+		instance:       vrg,
+		namespacedName: client.ObjectKeyFromObject(vrg).String(),
+		objectStorers:  make(map[string]cachedObjectStorer),
+	}
+}
+
+func TestForceDeleteRequested(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		requested   bool
+	}{
+		{name: "true", annotations: map[string]string{VRGForceDeleteAnnotation: "true"}, requested: true},
+		{name: "false", annotations: map[string]string{VRGForceDeleteAnnotation: "false"}},
+		{name: "absent"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vrg := forceDeleteVRG("deleted")
+			vrg.Annotations = tt.annotations
+
+			assert.Equal(t, tt.requested, (&VRGInstance{instance: vrg}).forceDeleteRequested())
+		})
+	}
+}
+
+func TestPVCOwnedByAnotherVRG(t *testing.T) {
+	tests := []struct {
+		name           string
+		pvc            *corev1.PersistentVolumeClaim
+		currentVRGUID  types.UID
+		ownedByAnother bool
+	}{
+		{name: "not labeled", pvc: &corev1.PersistentVolumeClaim{}, currentVRGUID: "deleted"},
+		{name: "labeled by another VRG", pvc: forceDeletePVC("other"), currentVRGUID: "deleted", ownedByAnother: true},
+		{name: "labeled by this VRG", pvc: forceDeletePVC("vrg"), currentVRGUID: "deleted"},
+		{name: "labeled by this VRG, deleted", pvc: forceDeletePVC("vrg")},
+		{
+			name:           "labeled by a VRG recreated with the name of this VRG",
+			pvc:            forceDeletePVC("vrg"),
+			currentVRGUID:  "recreated",
+			ownedByAnother: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []client.Object{}
+			if tt.currentVRGUID != "" {
+				objects = append(objects, forceDeleteVRG(tt.currentVRGUID))
+			}
+
+			ownedByAnother, err := forceDeleteVRGInstance(t, memoryObjectStorer{}, objects...).pvcOwnedByAnotherVRG(tt.pvc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.ownedByAnother, ownedByAnother)
+		})
+	}
+}
+
+func TestDeferredCleanupConfigMapFun(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		labels    map[string]string
+		requests  []reconcile.Request
+	}{
+		{
+			name:      "deferred cleanup in the operator namespace",
+			namespace: forceDeleteOperatorNS,
+			labels:    vrgDeferredCleanupLabels(types.NamespacedName{Namespace: "app", Name: "vrg"}),
+			requests: []reconcile.Request{
+				{NamespacedName: types.NamespacedName{Namespace: "app", Name: "vrg"}},
+			},
+		},
+		{
+			name:      "deferred cleanup in the namespace of the VRG",
+			namespace: "app",
+			labels:    vrgDeferredCleanupLabels(types.NamespacedName{Namespace: "app", Name: "vrg"}),
+			requests:  []reconcile.Request{},
+		},
+		{
+			name:      "deferred cleanup without owner",
+			namespace: forceDeleteOperatorNS,
+			labels:    map[string]string{vrgDeferredCleanupLabel: "true"},
+			requests:  []reconcile.Request{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("POD_NAMESPACE", forceDeleteOperatorNS)
+
+			configMap := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "cleanup", Namespace: tt.namespace, Labels: tt.labels},
+			}
+
+			assert.Equal(t, tt.requests,
+				(&VolumeReplicationGroupReconciler{}).configMapFun(context.TODO(), configMap))
+		})
+	}
+}
+
+func TestProcessDeferredCleanup(t *testing.T) {
+	tests := []struct {
+		name            string
+		objects         []client.Object
+		pvcs            []string
+		s3Profiles      []string
+		supersedingVRG  bool
+		result          ctrl.Result
+		finalizers      []string
+		reclaimPolicy   corev1.PersistentVolumeReclaimPolicy
+		objectsInStores int
+	}{
+		{
+			name:          "PVC finalizer, PV retention and S3 key prefix",
+			objects:       []client.Object{forceDeletePVC("vrg")},
+			pvcs:          []string{"app/pvc"},
+			s3Profiles:    []string{"s3"},
+			reclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+		{
+			name:          "unreachable S3 store",
+			objects:       []client.Object{forceDeletePVC("vrg")},
+			pvcs:          []string{"app/pvc"},
+			s3Profiles:    []string{"s3", unreachableS3ProfileName},
+			result:        ctrl.Result{RequeueAfter: vrgDeferredCleanupRetryInterval},
+			reclaimPolicy: corev1.PersistentVolumeReclaimDelete,
+		},
+		{
+			name:            "PVC protected by a VRG recreated with the same name",
+			objects:         []client.Object{forceDeletePVC("vrg"), forceDeleteVRG("recreated")},
+			pvcs:            []string{"app/pvc"},
+			finalizers:      []string{PvcVRFinalizerProtected},
+			reclaimPolicy:   corev1.PersistentVolumeReclaimRetain,
+			objectsInStores: 2,
+		},
+		{
+			name:            "S3 key prefix whose VRG object is uploaded by another VRG",
+			s3Profiles:      []string{"s3"},
+			supersedingVRG:  true,
+			reclaimPolicy:   corev1.PersistentVolumeReclaimRetain,
+			objectsInStores: 2,
+		},
+		{
+			name:            "PVC that is gone",
+			pvcs:            []string{"app/pvc"},
+			reclaimPolicy:   corev1.PersistentVolumeReclaimRetain,
+			objectsInStores: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objectStorer := memoryObjectStorer{}
+			v := forceDeleteVRGInstance(t, objectStorer, tt.objects...)
+			k8sClient := v.reconciler.Client
+			vrgNamespacedName := client.ObjectKeyFromObject(v.instance)
+
+			require.NoError(t, VrgObjectProtect(objectStorer, *v.instance))
+			require.NoError(t, uploadTypedObject(objectStorer, S3KeyPrefix(vrgNamespacedName.String()), "pvc",
+				corev1.PersistentVolumeClaim{}))
+
+			if tt.supersedingVRG {
+				require.NoError(t, VrgObjectProtect(objectStorer, *forceDeleteVRG("failed-over")))
+			}
+
+			require.NoError(t, v.deferredCleanupCreate(&vrgDeferredCleanup{PVCs: tt.pvcs, S3Profiles: tt.s3Profiles}))
+
+			result, err := v.reconciler.processDeferredCleanup(context.TODO(), vrgNamespacedName, logr.Discard())
+			require.NoError(t, err)
+			assert.Equal(t, tt.result, result)
+
+			configMaps := &corev1.ConfigMapList{}
+			require.NoError(t, k8sClient.List(context.TODO(), configMaps, client.InNamespace(forceDeleteOperatorNS),
+				client.MatchingLabels{vrgDeferredCleanupLabel: "true"}))
+
+			if tt.result.IsZero() {
+				assert.Empty(t, configMaps.Items, "deferred cleanup of the VRG")
+			} else {
+				assert.Len(t, configMaps.Items, 1, "deferred cleanup of the VRG")
+			}
+
+			if len(tt.objects) != 0 {
+				assert.Equal(t, tt.finalizers, getObject(t, k8sClient, forceDeletePVC("vrg")).Finalizers)
+			}
+
+			assert.Equal(t, tt.reclaimPolicy,
+				getObject(t, k8sClient, forceDeletePV()).Spec.PersistentVolumeReclaimPolicy)
+
+			assert.Len(t, objectStorer, tt.objectsInStores)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package controllers
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -296,11 +297,12 @@ func (v *VRGInstance) executeCaptureSteps(result *ctrl.Result, pathName, capture
 			isEssentialStep = cg.Hook.Essential != nil && *cg.Hook.Essential
 
 			hookCtx := hooks.HookContext{
-				Hook:           cg.Hook,
-				Client:         v.reconciler.Client,
-				Reader:         v.reconciler.APIReader,
-				Scheme:         v.reconciler.Scheme,
-				RecipeElements: v.recipeElements,
+				Hook:            cg.Hook,
+				Client:          v.reconciler.Client,
+				Reader:          v.reconciler.APIReader,
+				Scheme:          v.reconciler.Scheme,
+				RecipeElements:  v.recipeElements,
+				ExecConcurrency: v.ramenConfig.KubeObjectProtection.ExecHookConcurrency,
 			}
 
 			executor, err1 := hooks.GetHookExecutor(hookCtx)
@@ -648,6 +650,20 @@ func (v *VRGInstance) getRecoverOrProtectRequest(
 ) (kubeobjects.Request, bool, func() (kubeobjects.Request, error), func(kubeobjects.Request)) {
 	vrg := v.instance
 	annotations := map[string]string{}
+	recoverGroup.StorageClassMapping = vrg.Spec.StorageClassMapping
+	recoverGroup.NamespaceMapping = vrg.Spec.NamespaceMapping
+
+	// The namespaces of the capture of a peer cluster are named as on it
+	includedNamespaces := slices.Clone(recoverGroup.IncludedNamespaces)
+
+	for _, nsName := range recoverGroup.IncludedNamespaces {
+		peerNSName := util.PeerNamespaceName(vrg.Spec.NamespaceMapping, nsName)
+		if !slices.Contains(includedNamespaces, peerNSName) {
+			includedNamespaces = append(includedNamespaces, peerNSName)
+		}
+	}
+
+	recoverGroup.IncludedNamespaces = includedNamespaces
 
 	recoverNamePrefix := kubeObjectsRecoverNamePrefix(vrg.Namespace, vrg.Name)
 	recoverName := kubeObjectsRecoverName(recoverNamePrefix, groupNumber)
@@ -764,11 +780,12 @@ func (v *VRGInstance) executeRecoverSteps(result *ctrl.Result, s3StoreAccessor s
 			isEssentialStep = rg.Hook.Essential != nil && *rg.Hook.Essential
 
 			hookCtx := hooks.HookContext{
-				Hook:           rg.Hook,
-				Client:         v.reconciler.Client,
-				Reader:         v.reconciler.APIReader,
-				Scheme:         v.reconciler.Scheme,
-				RecipeElements: v.recipeElements,
+				Hook:            rg.Hook,
+				Client:          v.reconciler.Client,
+				Reader:          v.reconciler.APIReader,
+				Scheme:          v.reconciler.Scheme,
+				RecipeElements:  v.recipeElements,
+				ExecConcurrency: v.ramenConfig.KubeObjectProtection.ExecHookConcurrency,
 			}
 
 			executor, err1 := hooks.GetHookExecutor(hookCtx)
@@ -1035,7 +1052,7 @@ func getResourceAndConvertToCaptureGroup(
 			}
 		}
 
-		if name == recipe.Spec.Volumes.Name {
+		if recipe.Spec.Volumes != nil && name == recipe.Spec.Volumes.Name {
 			return nil, ErrVolumeCaptureNotSupported
 		}
 
@@ -1070,7 +1087,7 @@ func getResourceAndConvertToRecoverGroup(
 			}
 		}
 
-		if name == recipe.Spec.Volumes.Name {
+		if recipe.Spec.Volumes != nil && name == recipe.Spec.Volumes.Name {
 			return nil, ErrVolumeRecoverNotSupported
 		}
 
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// mixedReplicationVRGInstance returns a primary VRG that protects a PVC with VolRep and another with VolSync, and a
+// pod that uses the PVC protected by VolRep, if in use
+func mixedReplicationVRGInstance(t *testing.T, inUse bool) *VRGInstance {
+	t.Helper()
+
+	pvc := func(name string) corev1.PersistentVolumeClaim {
+		return corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"}}
+	}
+
+	objects := []client.Object{}
+	if inUse {
+		objects = append(objects, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "app"},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: "volume",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "volrep"},
+				},
+			}}},
+		})
+	}
+
+	k8sClient := fakeClientBuilder(t).WithObjects(objects...).
+		WithIndex(&corev1.Pod{}, util.PodVolumePVCClaimIndexName, func(o client.Object) []string {
+			claimNames := []string{}
+
+			for _, volume := range o.(*corev1.Pod).Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil {
+					claimNames = append(claimNames, volume.PersistentVolumeClaim.ClaimName)
+				}
+			}
+
+			return claimNames
+		}).Build()
+
+	return &VRGInstance{
+		reconciler: &VolumeReplicationGroupReconciler{Client: k8sClient},
+		ctx:        context.TODO(),
+		log:        logr.Discard(),
+		instance: &ramendrv1alpha1.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app"},
+			Spec:       ramendrv1alpha1.VolumeReplicationGroupSpec{ReplicationState: ramendrv1alpha1.Primary},
+		},
+		volRepPVCs:  []corev1.PersistentVolumeClaim{pvc("volrep")},
+		volSyncPVCs: []corev1.PersistentVolumeClaim{pvc("volsync")},
+	}
+}
+
+func TestUpdateFinalSyncStatusMixedReplication(t *testing.T) {
+	tests := []struct {
+		name             string
+		inUse            bool
+		volSyncComplete  bool
+		runFinalSync     bool
+		ready            bool
+		complete         bool
+		replicationTypes []ramendrv1alpha1.ReplicationTypeStatus
+	}{
+		{
+			name:            "final sync with a PVC protected by VolRep in use",
+			inUse:           true,
+			volSyncComplete: true,
+			runFinalSync:    true,
+			replicationTypes: []ramendrv1alpha1.ReplicationTypeStatus{
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolRep,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+					Message:           "waiting for 1 PVCs to be no longer in use",
+				},
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolSync,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+					FinalSyncComplete: true,
+				},
+			},
+		},
+		{
+			name:            "final sync with the PVCs of all replication types ready",
+			volSyncComplete: true,
+			runFinalSync:    true,
+			ready:           true,
+			complete:        true,
+			replicationTypes: []ramendrv1alpha1.ReplicationTypeStatus{
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolRep,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+					FinalSyncComplete: true,
+				},
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolSync,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+					FinalSyncComplete: true,
+				},
+			},
+		},
+		{
+			name:         "final sync before the final sync of the PVCs protected by VolSync",
+			runFinalSync: true,
+			ready:        true,
+			replicationTypes: []ramendrv1alpha1.ReplicationTypeStatus{
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolRep,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+					FinalSyncComplete: true,
+				},
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolSync,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+				},
+			},
+		},
+		{
+			name:  "preparation for the final sync",
+			inUse: true,
+			ready: true,
+			replicationTypes: []ramendrv1alpha1.ReplicationTypeStatus{
+				{
+					Type:              ramendrv1alpha1.ReplicationTypeVolRep,
+					PVCCount:          1,
+					FinalSyncPrepared: true,
+				},
+				{
+					Type:     ramendrv1alpha1.ReplicationTypeVolSync,
+					PVCCount: 1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := mixedReplicationVRGInstance(t, tt.inUse)
+			v.instance.Spec.RunFinalSync = tt.runFinalSync
+			v.instance.Spec.PrepareForFinalSync = !tt.runFinalSync
+			v.instance.Status.FinalSyncComplete = tt.volSyncComplete
+
+			assert.Equal(t, tt.ready, v.updateFinalSyncStatus(tt.runFinalSync))
+
+			if tt.runFinalSync {
+				assert.Equal(t, tt.complete, v.instance.Status.FinalSyncComplete)
+			} else {
+				assert.Equal(t, tt.complete, v.instance.Status.PrepareForFinalSyncComplete)
+			}
+
+			v.updateVRGReplicationTypes()
+
+			assert.ElementsMatch(t, tt.replicationTypes, v.instance.Status.ReplicationTypes)
+		})
+	}
+}
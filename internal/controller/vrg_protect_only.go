@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// protectOnlyLostGuarantees are the guarantees that are lost by not modifying the PVCs and their PVs
+const protectOnlyLostGuarantees = "PVCs are not protected from deletion by finalizers, " +
+	"PVs are deleted with their PVCs if their reclaim policy is Delete, " +
+	"and PVCs are protected individually instead of in consistency groups"
+
+func (v *VRGInstance) protectOnly() bool {
+	return v.instance.Spec.ProtectOnly
+}
+
+// pvcVRProtected returns whether a PVC is protected by VolumeReplication, as marked by its finalizer,
+// or by its entry in the status in the protect only mode
+func (v *VRGInstance) pvcVRProtected(pvc *corev1.PersistentVolumeClaim) bool {
+	if slices.Contains(pvc.Finalizers, PvcVRFinalizerProtected) {
+		return true
+	}
+
+	return v.protectOnly() && v.protectOnlyProtectedPVC(pvc) != nil
+}
+
+// protectOnlyPVCs is the bookkeeping of the protect only mode that is uploaded to the S3 stores alongside the VRG,
+// so that the PVCs protected without finalizers and annotations are still known when the VRG status is lost, e.g.
+// when the VRG is recreated on a cluster before it is relocated or failed over from it
+type protectOnlyPVCs struct {
+	// ArchivedClusterData of the protected PVCs, keyed by their namespaced names
+	ArchivedClusterData map[string]string `json:"archivedClusterData,omitempty"`
+}
+
+const protectOnlyPVCsS3ObjectNameSuffix = "a"
+
+// protectOnlyPVCsProtect uploads the bookkeeping of the protect only mode in the status of the VRG
+func protectOnlyPVCsProtect(objectStorer ObjectStorer, vrg ramen.VolumeReplicationGroup) error {
+	pvcs := protectOnlyPVCs{ArchivedClusterData: map[string]string{}}
+
+	for idx := range vrg.Status.ProtectedPVCs {
+		protectedPVC := &vrg.Status.ProtectedPVCs[idx]
+		if protectedPVC.ProtectedByVolSync {
+			continue
+		}
+
+		key := types.NamespacedName{Namespace: protectedPVC.Namespace, Name: protectedPVC.Name}.String()
+		pvcs.ArchivedClusterData[key] = protectedPVC.ArchivedClusterData
+	}
+
+	return uploadTypedObject(objectStorer, s3PathNamePrefix(vrg.Namespace, vrg.Name),
+		protectOnlyPVCsS3ObjectNameSuffix, pvcs)
+}
+
+// protectOnlyPVCsDownload returns the bookkeeping of the protect only mode from the first S3 store that has it,
+// downloading it once per reconcile
+func (v *VRGInstance) protectOnlyPVCsDownload() map[string]string {
+	if v.protectOnlyArchivedPVCs != nil {
+		return v.protectOnlyArchivedPVCs
+	}
+
+	v.protectOnlyArchivedPVCs = map[string]string{}
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		pvcs := protectOnlyPVCs{}
+
+		err := DownloadTypedObject(s3StoreAccessor.ObjectStorer, v.s3KeyPrefix(), protectOnlyPVCsS3ObjectNameSuffix,
+			&pvcs)
+		if err != nil {
+			v.log.Info("Protect only PVCs not downloaded", "profile", s3StoreAccessor.S3ProfileName, "error", err)
+
+			continue
+		}
+
+		if pvcs.ArchivedClusterData != nil {
+			v.protectOnlyArchivedPVCs = pvcs.ArchivedClusterData
+		}
+
+		break
+	}
+
+	return v.protectOnlyArchivedPVCs
+}
+
+// protectOnlyProtectedPVC returns the entry of a PVC in the status in the protect only mode, restoring it from the
+// bookkeeping in the S3 stores if the PVC was protected before the status was lost
+func (v *VRGInstance) protectOnlyProtectedPVC(pvc *corev1.PersistentVolumeClaim) *ramen.ProtectedPVC {
+	protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name)
+	if protectedPVC != nil {
+		return protectedPVC
+	}
+
+	archivedClusterData, ok := v.protectOnlyPVCsDownload()[types.NamespacedName{
+		Namespace: pvc.Namespace, Name: pvc.Name,
+	}.String()]
+	if !ok {
+		return nil
+	}
+
+	v.log.Info("Protected PVC restored to status from S3 store", "pvc", pvc.Namespace+"/"+pvc.Name)
+
+	protectedPVC = v.addProtectedPVC(pvc.Namespace, pvc.Name)
+	protectedPVC.ArchivedClusterData = archivedClusterData
+
+	return protectedPVC
+}
+
+// pvcClusterDataArchive identifies the version of the PVC and its PV that is uploaded to the S3 stores
+func (v *VRGInstance) pvcClusterDataArchive(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume) string {
+	return fmt.Sprintf("%s/%s", rmnutil.HashPVC(pvc), v.generateArchiveAnnotation(pv.Generation))
+}
+
+// isArchivedAlreadyInStatus returns whether the PVC and its PV are uploaded to the S3 stores as they
+// are, as kept track of in the status, and in the S3 stores, in the protect only mode
+func (v *VRGInstance) isArchivedAlreadyInStatus(pvc *corev1.PersistentVolumeClaim, pv *corev1.PersistentVolume,
+) bool {
+	protectedPVC := v.protectOnlyProtectedPVC(pvc)
+
+	return protectedPVC != nil && protectedPVC.ArchivedClusterData == v.pvcClusterDataArchive(pvc, pv)
+}
+
+// setArchivedInStatus keeps track of the upload of the PVC and its PV in the status in the protect only mode
+func (v *VRGInstance) setArchivedInStatus(pvc *corev1.PersistentVolumeClaim) error {
+	pv, err := v.getPVFromPVC(pvc)
+	if err != nil {
+		return fmt.Errorf("failed to get PersistentVolume (%s) belonging to VolumeReplicationGroup (%s/%s), %w",
+			pvc.Spec.VolumeName, v.instance.Namespace, v.instance.Name, err)
+	}
+
+	protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name)
+	if protectedPVC == nil {
+		protectedPVC = v.addProtectedPVC(pvc.Namespace, pvc.Name)
+	}
+
+	protectedPVC.ArchivedClusterData = v.pvcClusterDataArchive(pvc, &pv)
+
+	return nil
+}
+
+// updateVRGProtectOnlyCondition sets the ProtectOnly condition, which reports the guarantees that are lost
+// in the protect only mode, and is only present in it
+func (v *VRGInstance) updateVRGProtectOnlyCondition() {
+	if !v.protectOnly() {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeProtectOnly)
+
+		return
+	}
+
+	rmnutil.SetStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+		Type:               VRGConditionTypeProtectOnly,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: v.instance.Generation,
+		Reason:             VRGConditionReasonResourcesUnmodified,
+		Message:            protectOnlyLostGuarantees,
+	})
+}
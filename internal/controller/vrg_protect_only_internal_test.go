@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// protectOnlyFixture is a PVC and its PV, and the S3 store that VRGs in the protect only mode protect them to
+type protectOnlyFixture struct {
+	k8sClient    client.Client
+	objectStorer memoryObjectStorer
+	pvc          *corev1.PersistentVolumeClaim
+	pv           *corev1.PersistentVolume
+}
+
+func newProtectOnlyFixture(t *testing.T) *protectOnlyFixture {
+	t.Helper()
+
+	f := &protectOnlyFixture{
+		objectStorer: memoryObjectStorer{},
+		pv: &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv", Generation: 1},
+			Spec:       corev1.PersistentVolumeSpec{PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimDelete},
+		},
+		pvc: &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "app"},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				VolumeName:       "pv",
+				StorageClassName: ptr.To("sc"),
+			},
+		},
+	}
+
+	f.k8sClient = newFakeClient(t, f.pv, f.pvc)
+	f.pvc = getObject(t, f.k8sClient, f.pvc)
+
+	return f
+}
+
+func (f *protectOnlyFixture) vrgInstance(state ramendrv1alpha1.ReplicationState, protectOnly bool) *VRGInstance {
+	instance := &ramendrv1alpha1.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app", ResourceVersion: string(state)},
+		Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+			ReplicationState: state,
+			ProtectOnly:      protectOnly,
+			Async:            &ramendrv1alpha1.VRGAsyncSpec{},
+		},
+	}
+
+	return &VRGInstance{
+		reconciler:     &VolumeReplicationGroupReconciler{Client: f.k8sClient},
+		ctx:            context.TODO(),
+		log:            logr.Discard(),
+		instance:       instance,
+		namespacedName: instance.Namespace + "/" + instance.Name,
+		s3StoreAccessors: []s3StoreAccessor{
+			{ObjectStorer: f.objectStorer, S3StoreProfile: ramendrv1alpha1.S3StoreProfile{S3ProfileName: "s3"}},
+		},
+	}
+}
+
+// protect protects the PVC as the primary VRG does in the protect only mode, and uploads the VRG
+func (f *protectOnlyFixture) protect(t *testing.T) *VRGInstance {
+	t.Helper()
+
+	v := f.vrgInstance(ramendrv1alpha1.Primary, true)
+	require.NoError(t, v.addArchivedAnnotationForPVC(f.pvc, v.log))
+
+	result := ctrl.Result{}
+	v.vrgObjectProtectThrottled(&result, func() {}, func() {})
+	require.False(t, result.Requeue)
+
+	return v
+}
+
+func TestProtectOnlyPrimary(t *testing.T) {
+	f := newProtectOnlyFixture(t)
+	v := f.protect(t)
+
+	assert.True(t, v.isArchivedAlready(f.pvc, v.log))
+	assert.True(t, v.pvcVRProtected(f.pvc))
+	assert.Empty(t, getObject(t, f.k8sClient, f.pvc).GetAnnotations(), "bookkeeping on the PVC")
+	assert.Empty(t, getObject(t, f.k8sClient, f.pv).GetAnnotations(), "bookkeeping on the PV")
+
+	pvcs := protectOnlyPVCs{}
+	require.NoError(t, DownloadTypedObject(f.objectStorer, v.s3KeyPrefix(), protectOnlyPVCsS3ObjectNameSuffix, &pvcs))
+	assert.Equal(t, map[string]string{"app/pvc": v.findProtectedPVC("app", "pvc").ArchivedClusterData},
+		pvcs.ArchivedClusterData)
+
+	f.pv.Generation = 2
+	require.NoError(t, f.k8sClient.Update(context.TODO(), f.pv))
+
+	assert.False(t, f.vrgInstance(ramendrv1alpha1.Primary, true).isArchivedAlready(f.pvc, v.log),
+		"PVC whose PV differs from the one uploaded")
+}
+
+func TestProtectOnlyBookkeepingUpload(t *testing.T) {
+	f := newProtectOnlyFixture(t)
+	v := f.vrgInstance(ramendrv1alpha1.Primary, false)
+
+	result := ctrl.Result{}
+	v.vrgObjectProtectThrottled(&result, func() {}, func() {})
+
+	assert.Error(t, DownloadTypedObject(f.objectStorer, v.s3KeyPrefix(), protectOnlyPVCsS3ObjectNameSuffix,
+		&protectOnlyPVCs{}))
+}
+
+func TestProtectOnlySecondaryPVCProtected(t *testing.T) {
+	tests := []struct {
+		name        string
+		pvcName     string
+		protectOnly bool
+		protected   bool
+	}{
+		{name: "protected before the VRG status is lost", pvcName: "pvc", protectOnly: true, protected: true},
+		{name: "not protected", pvcName: "other", protectOnly: true},
+		{name: "without finalizer outside the protect only mode", pvcName: "pvc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newProtectOnlyFixture(t)
+			archivedClusterData := f.protect(t).findProtectedPVC("app", "pvc").ArchivedClusterData
+
+			v := f.vrgInstance(ramendrv1alpha1.Secondary, tt.protectOnly)
+			pvc := f.pvc.DeepCopy()
+			pvc.Name = tt.pvcName
+
+			assert.Equal(t, tt.protected, v.pvcVRProtected(pvc))
+
+			if tt.protected {
+				assert.Equal(t, archivedClusterData, v.findProtectedPVC("app", tt.pvcName).ArchivedClusterData)
+			}
+		})
+	}
+}
+
+func TestProtectOnlyUnprotect(t *testing.T) {
+	f := newProtectOnlyFixture(t)
+	v := f.protect(t)
+	v.instance.Spec.ReplicationState = ramendrv1alpha1.Secondary
+	pvcResourceVersion := f.pvc.ResourceVersion
+	pvResourceVersion := getObject(t, f.k8sClient, f.pv).GetResourceVersion()
+
+	require.NoError(t, v.preparePVCForVRDeletion(f.pvc, v.log))
+	assert.Equal(t, pvcResourceVersion, getObject(t, f.k8sClient, f.pvc).GetResourceVersion())
+	assert.Equal(t, pvResourceVersion, getObject(t, f.k8sClient, f.pv).GetResourceVersion())
+	assert.Nil(t, v.findProtectedPVC("app", "pvc"))
+}
+
+func TestProtectOnlyOffloadedPVCs(t *testing.T) {
+	f := newProtectOnlyFixture(t)
+	v := f.vrgInstance(ramendrv1alpha1.Primary, true)
+	v.instance.Spec.Async.PeerClasses = []ramendrv1alpha1.PeerClass{
+		{StorageClassName: "sc", StorageID: []string{"sid"}, ReplicationID: "rid", Offloaded: true},
+	}
+	require.NoError(t, f.k8sClient.Create(context.TODO(), &storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "sc", Labels: map[string]string{
+			StorageIDLabel: "sid", StorageOffloadedLabel: "",
+		}},
+	}))
+
+	_, err := v.processOffloadedPVCs(&corev1.PersistentVolumeClaimList{
+		Items: []corev1.PersistentVolumeClaim{*f.pvc},
+	})
+	require.ErrorContains(t, err, "not added in the protect only mode")
+	assert.Empty(t, getObject(t, f.k8sClient, f.pvc).GetLabels())
+}
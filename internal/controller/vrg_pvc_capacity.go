@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+// updatePVCCapacityCondition reports whether the volume of a PVC has the capacity that it requests.
+// The requested capacity is the one that the PVC is restored with, and that its destination is
+// resized to, on the peer clusters, so a PVC that is being expanded is reported until its volume is.
+func (v *VRGInstance) updatePVCCapacityCondition(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	pvc *corev1.PersistentVolumeClaim,
+) {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	actual := pvc.Status.Capacity[corev1.ResourceStorage]
+
+	if actual.Cmp(requested) < 0 {
+		setVRGCapacityMatchedCondition(&protectedPVC.Conditions, v.instance.Generation, metav1.ConditionFalse,
+			VRGConditionReasonResizing, fmt.Sprintf("PVC is being resized from %s to %s",
+				actual.String(), requested.String()))
+
+		return
+	}
+
+	setVRGCapacityMatchedCondition(&protectedPVC.Conditions, v.instance.Generation, metav1.ConditionTrue,
+		VRGConditionReasonCapacityMatched, fmt.Sprintf("PVC capacity is %s", requested.String()))
+}
+
+// expandExistingPVC expands an existing PVC that requests less capacity than the PVC to restore, as the
+// PVC was expanded on the peer cluster after the existing PVC was last protected there
+func (v *VRGInstance) expandExistingPVC(existingPVC, pvc *corev1.PersistentVolumeClaim) error {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	existingRequested := existingPVC.Spec.Resources.Requests[corev1.ResourceStorage]
+
+	if existingRequested.Cmp(requested) >= 0 {
+		return nil
+	}
+
+	if existingPVC.Spec.Resources.Requests == nil {
+		existingPVC.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+
+	existingPVC.Spec.Resources.Requests[corev1.ResourceStorage] = requested
+
+	if err := v.reconciler.Update(v.ctx, existingPVC); err != nil {
+		return fmt.Errorf("failed to expand PVC %s/%s from %s to %s (%w)", existingPVC.Namespace, existingPVC.Name,
+			existingRequested.String(), requested.String(), err)
+	}
+
+	v.log.Info("Expanded existing PVC to the capacity of the PVC to restore", "pvc", existingPVC.Name,
+		"namespace", existingPVC.Namespace, "from", existingRequested.String(), "to", requested.String())
+
+	return nil
+}
+
+// reconcileVolSyncDestinationCapacities expands the destinations of the PVCs of a secondary to the capacity that the
+// PVCs request on the primary, and collects how they have less capacity to report it, see
+// updateVRGCapacityMatchedCondition
+func (v *VRGInstance) reconcileVolSyncDestinationCapacities() {
+	v.volSyncDestinationCapacities = map[string]string{}
+
+	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
+		pvcNamespacedName := util.ProtectedPVCNamespacedName(rdSpec.ProtectedPVC).String()
+
+		mismatch, found, err := v.volSyncHandler.ReconcileDestinationCapacity(rdSpec)
+		if err != nil {
+			v.log.Info("Failed to reconcile the capacity of the destination", "pvc", pvcNamespacedName,
+				"error", err.Error())
+
+			continue
+		}
+
+		if found {
+			v.volSyncDestinationCapacities[pvcNamespacedName] = mismatch
+		}
+	}
+}
+
+// updateVolRepPrimaryCapacityConditions reports, in their CapacityMatched condition, the PVCs protected by VolRep on a
+// secondary that request less capacity than the PVCs they replicate on the primary, as uploaded with its VRG. The
+// volumes of a secondary are replicated to, and so are only expanded once restored, see expandExistingPVC.
+func (v *VRGInstance) updateVolRepPrimaryCapacityConditions() {
+	if len(v.volRepPVCs) == 0 || len(v.s3StoreAccessors) == 0 {
+		return
+	}
+
+	primaryVRG, err := v.downloadPrimaryVRG()
+	if err != nil {
+		v.log.Info("Failed to download the primary VRG to compare the capacity of PVCs", "error", err.Error())
+
+		return
+	}
+
+	if primaryVRG.Spec.ReplicationState != ramendrv1alpha1.Primary {
+		return
+	}
+
+	for idx := range v.volRepPVCs {
+		pvc := &v.volRepPVCs[idx]
+
+		protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name)
+		if protectedPVC == nil {
+			continue
+		}
+
+		primaryRequested := v.primaryRequestedCapacity(primaryVRG, pvc)
+		requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+
+		if primaryRequested == nil || requested.Cmp(*primaryRequested) >= 0 {
+			continue
+		}
+
+		setVRGCapacityMatchedCondition(&protectedPVC.Conditions, v.instance.Generation, metav1.ConditionFalse,
+			VRGConditionReasonCapacityMismatch, fmt.Sprintf("PVC requests %s, less than the %s requested on the "+
+				"primary, and is expanded when restored", requested.String(), primaryRequested.String()))
+	}
+}
+
+// downloadPrimaryVRG downloads the VRG uploaded by the primary from the first S3 store that has it
+func (v *VRGInstance) downloadPrimaryVRG() (*ramendrv1alpha1.VolumeReplicationGroup, error) {
+	var err error
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		primaryVRG := &ramendrv1alpha1.VolumeReplicationGroup{}
+
+		if err = vrgObjectDownload(s3StoreAccessor.ObjectStorer, v.s3KeyPrefix(), primaryVRG); err == nil {
+			return primaryVRG, nil
+		}
+	}
+
+	return nil, err
+}
+
+// primaryRequestedCapacity returns the capacity that the PVC protected by VolRep on the primary, that the passed in
+// PVC replicates, requests, or nil if it is not protected by the primary
+func (v *VRGInstance) primaryRequestedCapacity(primaryVRG *ramendrv1alpha1.VolumeReplicationGroup,
+	pvc *corev1.PersistentVolumeClaim,
+) *resource.Quantity {
+	for idx := range primaryVRG.Status.ProtectedPVCs {
+		protectedPVC := &primaryVRG.Status.ProtectedPVCs[idx]
+
+		if protectedPVC.ProtectedByVolSync || protectedPVC.Name != pvc.Name ||
+			util.MappedNamespaceName(v.instance.Spec.NamespaceMapping, protectedPVC.Namespace) != pvc.Namespace {
+			continue
+		}
+
+		requested, ok := protectedPVC.Resources.Requests[corev1.ResourceStorage]
+		if !ok {
+			return nil
+		}
+
+		return &requested
+	}
+
+	return nil
+}
+
+// updateVRGCapacityMatchedCondition aggregates the CapacityMatched conditions of the PVCs, and the capacities of the
+// VolSync destinations of a secondary, in the CapacityMatched condition of the VRG. It is only present when the
+// capacity of a PVC or a destination is compared.
+func (v *VRGInstance) updateVRGCapacityMatchedCondition() {
+	mismatches, matched := []string{}, 0
+	secondary := v.instance.Spec.ReplicationState == ramendrv1alpha1.Secondary
+
+	for idx := range v.instance.Status.ProtectedPVCs {
+		protectedPVC := &v.instance.Status.ProtectedPVCs[idx]
+
+		// The PVCs protected by VolSync are reported by their destinations on a secondary
+		if secondary && protectedPVC.ProtectedByVolSync {
+			continue
+		}
+
+		condition := util.FindCondition(protectedPVC.Conditions, VRGConditionTypeCapacityMatched)
+		if condition == nil {
+			continue
+		}
+
+		if condition.Status == metav1.ConditionTrue {
+			matched++
+
+			continue
+		}
+
+		mismatches = append(mismatches, fmt.Sprintf("%s/%s: %s", protectedPVC.Namespace, protectedPVC.Name,
+			condition.Message))
+	}
+
+	for _, pvcNamespacedName := range slices.Sorted(maps.Keys(v.volSyncDestinationCapacities)) {
+		if mismatch := v.volSyncDestinationCapacities[pvcNamespacedName]; mismatch != "" {
+			mismatches = append(mismatches, pvcNamespacedName+": "+mismatch)
+
+			continue
+		}
+
+		matched++
+	}
+
+	switch {
+	case len(mismatches) > 0:
+		setVRGCapacityMatchedCondition(&v.instance.Status.Conditions, v.instance.Generation, metav1.ConditionFalse,
+			VRGConditionReasonCapacityMismatch, "Capacity of PVCs does not match: "+strings.Join(mismatches, ", "))
+	case matched > 0:
+		setVRGCapacityMatchedCondition(&v.instance.Status.Conditions, v.instance.Generation, metav1.ConditionTrue,
+			VRGConditionReasonCapacityMatched, fmt.Sprintf("Capacity of %d PVCs matches", matched))
+	default:
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeCapacityMatched)
+	}
+}
@@ -0,0 +1,241 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+func capacityResources(requested string) corev1.VolumeResourceRequirements {
+	return corev1.VolumeResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(requested)},
+	}
+}
+
+func capacityPVC(requested, capacity string) *corev1.PersistentVolumeClaim {
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "pvc", Namespace: "app"},
+		Spec:       corev1.PersistentVolumeClaimSpec{Resources: capacityResources(requested)},
+		Status: corev1.PersistentVolumeClaimStatus{
+			Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse(capacity)},
+		},
+	}
+}
+
+func capacityVRGInstance(state ramendrv1alpha1.ReplicationState, objectStorer ObjectStorer) *VRGInstance {
+	instance := &ramendrv1alpha1.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app", Generation: 2},
+		Spec:       ramendrv1alpha1.VolumeReplicationGroupSpec{ReplicationState: state},
+	}
+
+	return &VRGInstance{
+		ctx:            context.TODO(),
+		log:            logr.Discard(),
+		instance:       instance,
+		namespacedName: instance.Namespace + "/" + instance.Name,
+		s3StoreAccessors: []s3StoreAccessor{
+			{ObjectStorer: objectStorer, S3StoreProfile: ramendrv1alpha1.S3StoreProfile{S3ProfileName: "s3"}},
+		},
+	}
+}
+
+func capacityProtectedPVC(name string, volSync bool, status metav1.ConditionStatus, message string,
+) ramendrv1alpha1.ProtectedPVC {
+	protectedPVC := ramendrv1alpha1.ProtectedPVC{Name: name, Namespace: "app", ProtectedByVolSync: volSync}
+	setVRGCapacityMatchedCondition(&protectedPVC.Conditions, 1, status, VRGConditionReasonResizing, message)
+
+	return protectedPVC
+}
+
+func TestUpdatePVCCapacityCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity string
+		status   metav1.ConditionStatus
+		reason   string
+		message  string
+	}{
+		{
+			name:     "being resized",
+			capacity: "1Gi",
+			status:   metav1.ConditionFalse,
+			reason:   VRGConditionReasonResizing,
+			message:  "PVC is being resized from 1Gi to 2Gi",
+		},
+		{
+			name:     "resized",
+			capacity: "2Gi",
+			status:   metav1.ConditionTrue,
+			reason:   VRGConditionReasonCapacityMatched,
+			message:  "PVC capacity is 2Gi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := capacityVRGInstance(ramendrv1alpha1.Primary, memoryObjectStorer{})
+			protectedPVC := &ramendrv1alpha1.ProtectedPVC{Name: "pvc", Namespace: "app"}
+
+			v.updatePVCCapacityCondition(protectedPVC, capacityPVC("2Gi", tt.capacity))
+
+			condition := util.FindCondition(protectedPVC.Conditions, VRGConditionTypeCapacityMatched)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.status, condition.Status)
+			assert.Equal(t, tt.reason, condition.Reason)
+			assert.Equal(t, tt.message, condition.Message)
+		})
+	}
+}
+
+func TestUpdateVolRepPrimaryCapacityConditions(t *testing.T) {
+	tests := []struct {
+		name             string
+		requested        string
+		namespaceMapping map[string]string
+		primaryNamespace string
+		status           metav1.ConditionStatus
+		message          string
+	}{
+		{
+			name:             "less capacity than on the primary",
+			requested:        "1Gi",
+			primaryNamespace: "app",
+			status:           metav1.ConditionFalse,
+			message: "PVC requests 1Gi, less than the 2Gi requested on the primary, " +
+				"and is expanded when restored",
+		},
+		{
+			name:             "capacity of the primary",
+			requested:        "2Gi",
+			primaryNamespace: "app",
+			status:           metav1.ConditionTrue,
+			message:          "PVC capacity is 2Gi",
+		},
+		{
+			name:             "less capacity than on the primary in the mapped namespace",
+			requested:        "1Gi",
+			namespaceMapping: map[string]string{"app-east": "app"},
+			primaryNamespace: "app-east",
+			status:           metav1.ConditionFalse,
+			message: "PVC requests 1Gi, less than the 2Gi requested on the primary, " +
+				"and is expanded when restored",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objectStorer := memoryObjectStorer{}
+			v := capacityVRGInstance(ramendrv1alpha1.Secondary, objectStorer)
+			v.instance.Spec.NamespaceMapping = tt.namespaceMapping
+			v.instance.Status.ProtectedPVCs = []ramendrv1alpha1.ProtectedPVC{{Name: "pvc", Namespace: "app"}}
+
+			primaryVRG := v.instance.DeepCopy()
+			primaryVRG.Spec.ReplicationState = ramendrv1alpha1.Primary
+			primaryVRG.Status.ProtectedPVCs = []ramendrv1alpha1.ProtectedPVC{
+				{Name: "pvc", Namespace: tt.primaryNamespace, Resources: capacityResources("2Gi")},
+			}
+			require.NoError(t, VrgObjectProtect(objectStorer, *primaryVRG))
+
+			v.volRepPVCs = []corev1.PersistentVolumeClaim{*capacityPVC(tt.requested, tt.requested)}
+			v.updatePVCCapacityCondition(&v.instance.Status.ProtectedPVCs[0], &v.volRepPVCs[0])
+
+			v.updateVolRepPrimaryCapacityConditions()
+
+			condition := util.FindCondition(v.instance.Status.ProtectedPVCs[0].Conditions,
+				VRGConditionTypeCapacityMatched)
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.status, condition.Status)
+			assert.Equal(t, tt.message, condition.Message)
+		})
+	}
+}
+
+func TestUpdateVRGCapacityMatchedCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         ramendrv1alpha1.ReplicationState
+		protectedPVCs []ramendrv1alpha1.ProtectedPVC
+		destinations  map[string]string
+		status        metav1.ConditionStatus
+		reason        string
+		message       string
+	}{
+		{
+			name:  "PVCs that do not match",
+			state: ramendrv1alpha1.Primary,
+			protectedPVCs: []ramendrv1alpha1.ProtectedPVC{
+				capacityProtectedPVC("a", false, metav1.ConditionTrue, "PVC capacity is 2Gi"),
+				capacityProtectedPVC("b", true, metav1.ConditionFalse, "PVC is being resized from 1Gi to 2Gi"),
+			},
+			status:  metav1.ConditionFalse,
+			reason:  VRGConditionReasonCapacityMismatch,
+			message: "Capacity of PVCs does not match: app/b: PVC is being resized from 1Gi to 2Gi",
+		},
+		{
+			name:  "destinations of a secondary that do not match instead of its VolSync PVCs",
+			state: ramendrv1alpha1.Secondary,
+			protectedPVCs: []ramendrv1alpha1.ProtectedPVC{
+				capacityProtectedPVC("a", true, metav1.ConditionFalse, "PVC is being resized from 1Gi to 2Gi"),
+			},
+			destinations: map[string]string{
+				"app/a": "",
+				"app/b": "destination PVC dst is being resized from 1Gi to 2Gi",
+			},
+			status:  metav1.ConditionFalse,
+			reason:  VRGConditionReasonCapacityMismatch,
+			message: "Capacity of PVCs does not match: app/b: destination PVC dst is being resized from 1Gi to 2Gi",
+		},
+		{
+			name:  "destinations of a secondary that match",
+			state: ramendrv1alpha1.Secondary,
+			protectedPVCs: []ramendrv1alpha1.ProtectedPVC{
+				capacityProtectedPVC("a", true, metav1.ConditionFalse, "PVC is being resized from 1Gi to 2Gi"),
+			},
+			destinations: map[string]string{"app/a": "", "app/b": ""},
+			status:       metav1.ConditionTrue,
+			reason:       VRGConditionReasonCapacityMatched,
+			message:      "Capacity of 2 PVCs matches",
+		},
+		{
+			name:  "no capacity compared",
+			state: ramendrv1alpha1.Primary,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := capacityVRGInstance(tt.state, memoryObjectStorer{})
+			v.instance.Status.ProtectedPVCs = tt.protectedPVCs
+			v.volSyncDestinationCapacities = tt.destinations
+			setVRGCapacityMatchedCondition(&v.instance.Status.Conditions, 1, metav1.ConditionTrue,
+				VRGConditionReasonCapacityMatched, "Capacity of 1 PVCs matches")
+
+			v.updateVRGCapacityMatchedCondition()
+
+			condition := util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeCapacityMatched)
+			if tt.status == "" {
+				assert.Nil(t, condition)
+
+				return
+			}
+
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.status, condition.Status)
+			assert.Equal(t, tt.reason, condition.Reason)
+			assert.Equal(t, tt.message, condition.Message)
+			assert.Equal(t, v.instance.Generation, condition.ObservedGeneration)
+		})
+	}
+}
@@ -6,6 +6,7 @@ package controllers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"slices"
@@ -13,8 +14,10 @@ import (
 
 	"github.com/go-logr/logr"
 	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -23,6 +26,7 @@ import (
 
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
 	recipecore "github.com/ramendr/ramen/internal/controller/core"
+	"github.com/ramendr/ramen/internal/controller/hooks"
 	"github.com/ramendr/ramen/internal/controller/kubeobjects"
 	"github.com/ramendr/ramen/internal/controller/util"
 )
@@ -136,18 +140,29 @@ func RecipeElementsGet(ctx context.Context, reader client.Reader, vrg ramen.Volu
 		return recipeElements, err
 	}
 
-	parameters := getRecipeParameters(vrg, ramenConfig)
+	return recipeElementsFromRecipe(ctx, recipe, vrg, ramenConfig, log)
+}
+
+func recipeElementsFromRecipe(ctx context.Context, recipe recipev1.Recipe, vrg ramen.VolumeReplicationGroup,
+	ramenConfig ramen.RamenConfig, log logr.Logger,
+) (util.RecipeElements, error) {
+	var recipeElements util.RecipeElements
+
+	recipeNamespacedName := types.NamespacedName{
+		Namespace: vrg.Spec.KubeObjectProtection.RecipeRef.Namespace,
+		Name:      vrg.Spec.KubeObjectProtection.RecipeRef.Name,
+	}
+
+	parameters, err := recipeParametersValidate(recipe, getRecipeParameters(vrg, ramenConfig))
+	if err != nil {
+		return recipeElements, fmt.Errorf("recipe %v parameters validation error: %w", recipeNamespacedName.String(), err)
+	}
+
 	if err := RecipeParametersExpand(ctx, &recipe, parameters, log); err != nil {
 		return recipeElements, fmt.Errorf("recipe %v parameters expansion error: %w", recipeNamespacedName.String(), err)
 	}
 
-	var selector PvcSelector
-	if recipe.Spec.Volumes == nil {
-		selector = getPVCSelector(vrg, ramenConfig, nil, nil)
-	} else {
-		selector = getPVCSelector(vrg, ramenConfig, recipe.Spec.Volumes.IncludedNamespaces,
-			recipe.Spec.Volumes.LabelSelector)
-	}
+	selector := recipeVolumesPVCSelector(recipe, vrg, ramenConfig)
 
 	recipeElements = util.RecipeElements{
 		PvcSelector: util.PvcSelector{
@@ -169,6 +184,185 @@ func RecipeElementsGet(ctx context.Context, reader client.Reader, vrg ramen.Volu
 	return recipeElements, nil
 }
 
+func recipeVolumesPVCSelector(recipe recipev1.Recipe, vrg ramen.VolumeReplicationGroup,
+	ramenConfig ramen.RamenConfig,
+) PvcSelector {
+	if recipe.Spec.Volumes == nil {
+		return getPVCSelector(vrg, ramenConfig, nil, nil)
+	}
+
+	return getPVCSelector(vrg, ramenConfig, recipe.Spec.Volumes.IncludedNamespaces,
+		recipe.Spec.Volumes.LabelSelector)
+}
+
+// RecipeValidate runs, ahead of time, the recipe checks that are otherwise run only when a
+// VRG captures or recovers using the recipe: parameter expansion, workflow references to
+// groups and hooks, hook operations and select resources, and the recipe namespaces. The
+// recipe is validated as if it were referenced, with the given parameters, by a VRG in the
+// recipe namespace. Parameters referred to by the recipe but not given are reported as
+// warnings, and the namespace checks are then skipped as the namespaces are not known.
+func RecipeValidate(ctx context.Context, recipe recipev1.Recipe, parameters map[string][]string,
+	ramenConfig ramen.RamenConfig, log logr.Logger,
+) ([]string, error) {
+	var warnings []string
+
+	declarations, err := recipeParameterDeclarationsGet(recipe)
+	if err != nil {
+		return warnings, err
+	}
+
+	// Without parameters, as when no VRG refers to the recipe yet, required parameters are
+	// reported as unset rather than missing.
+	resolvedParameters, missing, err := recipeParametersResolve(declarations, parameters)
+	if err != nil {
+		return warnings, fmt.Errorf("parameters validation error: %w", err)
+	}
+
+	if parameters != nil && len(missing) > 0 {
+		return warnings, fmt.Errorf("required parameters %v are not set", missing)
+	}
+
+	unsetParameterNames := sets.List(recipeParameterNames(recipe).Difference(sets.KeySet(resolvedParameters)))
+	if len(unsetParameterNames) > 0 {
+		warnings = append(warnings, fmt.Sprintf("recipe parameters %v are not set, namespaces are not validated",
+			unsetParameterNames))
+	}
+
+	vrg := ramen.VolumeReplicationGroup{
+		ObjectMeta: metav1.ObjectMeta{Namespace: recipe.Namespace},
+		Spec: ramen.VolumeReplicationGroupSpec{
+			ProtectedNamespaces: &[]string{},
+			KubeObjectProtection: &ramen.KubeObjectProtectionSpec{
+				RecipeRef: &ramen.RecipeRef{Namespace: recipe.Namespace, Name: recipe.Name},
+				// getRecipeParameters may add to the parameters of a VRG, so use a copy
+				RecipeParameters: resolvedParameters,
+			},
+		},
+	}
+
+	expandedRecipe := *recipe.DeepCopy()
+	if err := RecipeParametersExpand(ctx, &expandedRecipe, getRecipeParameters(vrg, ramenConfig), log); err != nil {
+		return warnings, fmt.Errorf("parameters expansion error: %w", err)
+	}
+
+	recipeElements := util.RecipeElements{RecipeWithParams: &expandedRecipe}
+	errs := []error{}
+
+	if err := recipeWorkflowsGet(expandedRecipe, &recipeElements, vrg, ramenConfig); err != nil {
+		errs = append(errs, fmt.Errorf("workflows get error: %w", err))
+	}
+
+	errs = append(errs, recipeWorkflowHooksValidate(expandedRecipe))
+
+	if _, err := recipeVolumeSchedulingIntervalsGet(expandedRecipe); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(unsetParameterNames) == 0 {
+		if vrg.Namespace == RamenOperandsNamespace(ramenConfig) {
+			*vrg.Spec.ProtectedNamespaces = sets.List(recipeNamespaceNames(recipeElements))
+		}
+
+		selector := recipeVolumesPVCSelector(expandedRecipe, vrg, ramenConfig)
+		recipeElements.PvcSelector = util.PvcSelector{
+			LabelSelector:  selector.LabelSelector,
+			NamespaceNames: selector.NamespaceNames,
+		}
+
+		errs = append(errs, recipeNamespaceNamesValidate(recipeElements))
+
+		if err := recipeNamespacesValidate(recipeElements, vrg, ramenConfig); err != nil {
+			errs = append(errs, fmt.Errorf("namespaces validation error: %w", err))
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+func recipeParametersCopy(parameters map[string][]string) map[string][]string {
+	parametersCopy := make(map[string][]string, len(parameters))
+	for key, values := range parameters {
+		parametersCopy[key] = slices.Clone(values)
+	}
+
+	return parametersCopy
+}
+
+// recipeParameterNames returns the names of the parameters the recipe spec refers to.
+func recipeParameterNames(recipe recipev1.Recipe) sets.Set[string] {
+	names := sets.New[string]()
+
+	bytes, err := json.Marshal(recipe.Spec)
+	if err != nil {
+		return names
+	}
+
+	os.Expand(string(bytes), func(key string) string {
+		names.Insert(key)
+
+		return ""
+	})
+
+	return names
+}
+
+func recipeWorkflowHooksValidate(recipe recipev1.Recipe) error {
+	errs := []error{}
+
+	for _, workflow := range recipe.Spec.Workflows {
+		for _, step := range workflow.Sequence {
+			name, ok := step["hook"]
+			if !ok {
+				continue
+			}
+
+			if err := recipeWorkflowHookValidate(recipe, name); err != nil {
+				errs = append(errs, fmt.Errorf("workflow %s hook %s: %w", workflow.Name, name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func recipeWorkflowHookValidate(recipe recipev1.Recipe, name string) error {
+	prefix, suffix, err := validateAndGetHookDetails(name)
+	if err != nil {
+		return err
+	}
+
+	hook, err := getHookFromRecipe(&recipe, prefix)
+	if err != nil {
+		return err
+	}
+
+	hookSpec := getHookSpecFromHook(*hook, suffix)
+	if hookSpec.Type == "" {
+		switch hook.Type {
+		case "exec":
+			return fmt.Errorf("operation %s not found in hook %s", suffix, hook.Name)
+		case "check":
+			return fmt.Errorf("check %s not found in hook %s", suffix, hook.Name)
+		default:
+			return fmt.Errorf("unsupported hook type: %s", hook.Type)
+		}
+	}
+
+	return hooks.ValidateHookSpec(&hookSpec, &recipe)
+}
+
+func recipeNamespaceNamesValidate(recipeElements util.RecipeElements) error {
+	errs := []error{}
+
+	for _, namespaceName := range sets.List(recipeNamespaceNames(recipeElements)) {
+		for _, msg := range validation.IsDNS1123Label(namespaceName) {
+			errs = append(errs, fmt.Errorf("invalid namespace name %q: %s", namespaceName, msg))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func isRecipeReconcileToStop(parameters map[string][]string) bool {
 	if len(parameters) == 0 {
 		return false
@@ -380,10 +574,7 @@ func (m objectToReconcileRequestsMapper) recipeToVrgReconcileRequestsMapper(
 	requests := make([]reconcile.Request, 0, len(vrgList.Items))
 
 	for _, vrg := range vrgList.Items {
-		if vrg.Spec.KubeObjectProtection == nil ||
-			vrg.Spec.KubeObjectProtection.RecipeRef == nil ||
-			vrg.Spec.KubeObjectProtection.RecipeRef.Namespace != recipe.GetNamespace() ||
-			vrg.Spec.KubeObjectProtection.RecipeRef.Name != recipe.GetName() {
+		if !vrgRefersToRecipe(&vrg, recipe) {
 			continue
 		}
 
@@ -0,0 +1,230 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// RecipeParametersAnnotation declares the parameters of a recipe, as a YAML or JSON list of
+// RecipeParameterDeclaration. A recipe without the annotation accepts any parameters.
+const RecipeParametersAnnotation = "ramendr.openshift.io/recipe-parameters"
+
+const (
+	RecipeParameterTypeString    = "string"
+	RecipeParameterTypeInteger   = "integer"
+	RecipeParameterTypeBoolean   = "boolean"
+	RecipeParameterTypeNamespace = "namespace"
+)
+
+// recipeParametersReserved are interpreted by ramen rather than the recipe, and need not be
+// declared.
+var recipeParametersReserved = sets.New("STOP_RECIPE_RECONCILE")
+
+// RecipeParameterDeclaration declares a recipe parameter. Every value of the parameter
+// should be of its type and, if allowed values are declared, one of them.
+type RecipeParameterDeclaration struct {
+	Name string `json:"name"`
+
+	// Type of the values, one of string, integer, boolean or namespace. Defaults to string.
+	Type string `json:"type,omitempty"`
+
+	// Required parameters without a default should be set in the VRG recipe parameters.
+	Required bool `json:"required,omitempty"`
+
+	// Default values used when the parameter is not set.
+	Default []string `json:"default,omitempty"`
+
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// RecipeParametersError is returned when the recipe parameters declaration is invalid, or
+// when the parameters do not match it.
+type RecipeParametersError struct {
+	err error
+}
+
+func (e RecipeParametersError) Error() string {
+	return e.err.Error()
+}
+
+func (e RecipeParametersError) Unwrap() error {
+	return e.err
+}
+
+// recipeParameterDeclarationsGet returns the validated parameter declarations of a recipe, or
+// nil if it has none.
+func recipeParameterDeclarationsGet(recipe recipev1.Recipe) ([]RecipeParameterDeclaration, error) {
+	value, ok := recipe.GetAnnotations()[RecipeParametersAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	declarations := []RecipeParameterDeclaration{}
+	if err := yaml.Unmarshal([]byte(value), &declarations); err != nil {
+		return nil, RecipeParametersError{fmt.Errorf("annotation %s unmarshal error: %w",
+			RecipeParametersAnnotation, err)}
+	}
+
+	errs := []error{}
+	names := sets.New[string]()
+
+	for i := range declarations {
+		declaration := &declarations[i]
+
+		if declaration.Name == "" {
+			errs = append(errs, fmt.Errorf("parameter %d has no name", i))
+
+			continue
+		}
+
+		if names.Has(declaration.Name) {
+			errs = append(errs, fmt.Errorf("parameter %s is declared more than once", declaration.Name))
+		}
+
+		names.Insert(declaration.Name)
+
+		if declaration.Type == "" {
+			declaration.Type = RecipeParameterTypeString
+		}
+
+		if !slices.Contains([]string{
+			RecipeParameterTypeString,
+			RecipeParameterTypeInteger,
+			RecipeParameterTypeBoolean,
+			RecipeParameterTypeNamespace,
+		}, declaration.Type) {
+			errs = append(errs, fmt.Errorf("parameter %s type %s is not supported", declaration.Name,
+				declaration.Type))
+
+			continue
+		}
+
+		for _, allowedValue := range declaration.AllowedValues {
+			if err := recipeParameterValueValidate(declaration.Type, allowedValue); err != nil {
+				errs = append(errs, fmt.Errorf("parameter %s allowed value %q: %w", declaration.Name,
+					allowedValue, err))
+			}
+		}
+
+		if err := recipeParameterValuesValidate(*declaration, declaration.Default); err != nil {
+			errs = append(errs, fmt.Errorf("parameter %s default: %w", declaration.Name, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, RecipeParametersError{fmt.Errorf("annotation %s is invalid: %w", RecipeParametersAnnotation, err)}
+	}
+
+	return declarations, nil
+}
+
+// recipeParametersResolve validates the parameters against the declarations and returns a
+// copy of them with the defaults of the parameters that are not set. It also returns the
+// names of the required parameters that are not set, which are not an error by themselves.
+func recipeParametersResolve(declarations []RecipeParameterDeclaration, parameters map[string][]string,
+) (map[string][]string, []string, error) {
+	resolved := recipeParametersCopy(parameters)
+	if declarations == nil {
+		return resolved, nil, nil
+	}
+
+	errs := []error{}
+	missing := []string{}
+	declared := recipeParametersReserved.Clone()
+
+	for _, declaration := range declarations {
+		declared.Insert(declaration.Name)
+
+		values, ok := parameters[declaration.Name]
+		if !ok {
+			if declaration.Default != nil {
+				resolved[declaration.Name] = slices.Clone(declaration.Default)
+			} else if declaration.Required {
+				missing = append(missing, declaration.Name)
+			}
+
+			continue
+		}
+
+		if err := recipeParameterValuesValidate(declaration, values); err != nil {
+			errs = append(errs, fmt.Errorf("parameter %s: %w", declaration.Name, err))
+		}
+	}
+
+	for _, name := range sets.List(sets.KeySet(parameters).Difference(declared)) {
+		errs = append(errs, fmt.Errorf("parameter %s is not declared", name))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, missing, RecipeParametersError{err}
+	}
+
+	return resolved, missing, nil
+}
+
+// recipeParametersValidate resolves the parameters against the declarations of the recipe
+// and fails if a required parameter is not set.
+func recipeParametersValidate(recipe recipev1.Recipe, parameters map[string][]string) (map[string][]string, error) {
+	declarations, err := recipeParameterDeclarationsGet(recipe)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, missing, err := recipeParametersResolve(declarations, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(missing) > 0 {
+		return nil, RecipeParametersError{fmt.Errorf("required parameters %v are not set", missing)}
+	}
+
+	return resolved, nil
+}
+
+func recipeParameterValuesValidate(declaration RecipeParameterDeclaration, values []string) error {
+	errs := []error{}
+
+	for _, value := range values {
+		if err := recipeParameterValueValidate(declaration.Type, value); err != nil {
+			errs = append(errs, fmt.Errorf("value %q: %w", value, err))
+
+			continue
+		}
+
+		if declaration.AllowedValues != nil && !slices.Contains(declaration.AllowedValues, value) {
+			errs = append(errs, fmt.Errorf("value %q is not one of %v", value, declaration.AllowedValues))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func recipeParameterValueValidate(parameterType, value string) error {
+	switch parameterType {
+	case RecipeParameterTypeInteger:
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return errors.New("not an integer")
+		}
+	case RecipeParameterTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return errors.New("not a boolean")
+		}
+	case RecipeParameterTypeNamespace:
+		if errs := validation.IsDNS1123Label(value); len(errs) > 0 {
+			return fmt.Errorf("not a namespace name: %v", errs)
+		}
+	}
+
+	return nil
+}
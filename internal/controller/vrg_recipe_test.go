@@ -611,3 +611,149 @@ var _ = Describe("VolumeReplicationGroupRecipe", func() {
 		})
 	})
 })
+
+var _ = Describe("RecipeValidate", func() {
+	const namespaceName = "recipe-validate"
+
+	var recipeToValidate *recipe.Recipe
+
+	BeforeEach(func() {
+		recipeToValidate = &recipe.Recipe{
+			TypeMeta:   metav1.TypeMeta{Kind: "Recipe", APIVersion: "ramendr.openshift.io/v1alpha1"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespaceName, Name: "r"},
+			Spec: recipe.RecipeSpec{
+				Groups: []*recipe.Group{{
+					Name:               "g1",
+					Type:               "resource",
+					IncludedNamespaces: []string{"$NS"},
+				}},
+				Hooks: []*recipe.Hook{{
+					Name:          "h1",
+					Namespace:     namespaceName,
+					Type:          "exec",
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "a"}},
+					Ops: []*recipe.Operation{
+						{Name: "quiesce", Command: "/bin/true", InverseOp: "unquiesce"},
+						{Name: "unquiesce", Command: "/bin/true"},
+					},
+				}},
+				Workflows: []*recipe.Workflow{{
+					Name:     recipe.BackupWorkflowName,
+					Sequence: []map[string]string{{"hook": "h1/quiesce"}, {"group": "g1"}},
+				}},
+			},
+		}
+	})
+	recipeValidate := func(parameters map[string][]string) ([]string, error) {
+		return controllers.RecipeValidate(context.TODO(), *recipeToValidate, parameters, *ramenConfig, testLogger)
+	}
+
+	It("accepts a valid recipe", func() {
+		warnings, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(BeEmpty())
+	})
+	It("warns about parameters that are not set", func() {
+		warnings, err := recipeValidate(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(warnings).To(ConsistOf(ContainSubstring("NS")))
+	})
+	It("rejects a workflow referring to an absent group", func() {
+		recipeToValidate.Spec.Workflows[0].Sequence[1]["group"] = "g2"
+		_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+		Expect(err).To(MatchError(ContainSubstring("g2")))
+	})
+	It("rejects a workflow referring to an absent hook operation", func() {
+		recipeToValidate.Spec.Workflows[0].Sequence[0]["hook"] = "h1/freeze"
+		_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+		Expect(err).To(MatchError(ContainSubstring("operation freeze not found")))
+	})
+	It("rejects a hook operation whose inverse operation is absent", func() {
+		recipeToValidate.Spec.Hooks[0].Ops[0].InverseOp = "thaw"
+		_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+		Expect(err).To(MatchError(ContainSubstring("inverse operation thaw")))
+	})
+	It("rejects an invalid namespace name", func() {
+		_, err := recipeValidate(map[string][]string{"NS": {"Not_A_Namespace"}})
+		Expect(err).To(MatchError(ContainSubstring("invalid namespace name")))
+	})
+	Context("with declared parameters", func() {
+		declare := func(declarations string) {
+			recipeToValidate.Annotations = map[string]string{controllers.RecipeParametersAnnotation: declarations}
+		}
+
+		It("accepts parameters matching the declaration", func() {
+			declare(`[{name: NS, type: namespace, required: true}]`)
+			warnings, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+		It("uses the default of a parameter that is not set", func() {
+			declare(`[{name: NS, type: namespace, default: [` + namespaceName + `]}]`)
+			warnings, err := recipeValidate(map[string][]string{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(BeEmpty())
+		})
+		It("rejects a missing required parameter", func() {
+			declare(`[{name: NS, type: namespace, required: true}]`)
+			_, err := recipeValidate(map[string][]string{})
+			Expect(err).To(MatchError(ContainSubstring("required parameters [NS] are not set")))
+		})
+		It("warns about a missing required parameter without parameters", func() {
+			declare(`[{name: NS, type: namespace, required: true}]`)
+			warnings, err := recipeValidate(nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(ContainSubstring("NS")))
+		})
+		It("rejects an undeclared parameter", func() {
+			declare(`[{name: NS, type: namespace}]`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}, "NAMESPACE": {namespaceName}})
+			Expect(err).To(MatchError(ContainSubstring("parameter NAMESPACE is not declared")))
+		})
+		It("rejects a value of the wrong type", func() {
+			declare(`[{name: NS, type: namespace}, {name: REPLICAS, type: integer}]`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}, "REPLICAS": {"two"}})
+			Expect(err).To(MatchError(ContainSubstring("not an integer")))
+		})
+		It("rejects a value that is not allowed", func() {
+			declare(`[{name: NS, type: namespace, allowedValues: [` + namespaceName + `]}]`)
+			_, err := recipeValidate(map[string][]string{"NS": {"other"}})
+			Expect(err).To(MatchError(ContainSubstring("is not one of")))
+		})
+		It("rejects an invalid declaration", func() {
+			declare(`[{name: NS, type: list}]`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+			Expect(err).To(MatchError(ContainSubstring("type list is not supported")))
+		})
+	})
+	Context("with volume scheduling intervals", func() {
+		BeforeEach(func() {
+			recipeToValidate.Spec.Volumes = &recipe.Group{
+				Name:               "v1",
+				Type:               "volume",
+				IncludedNamespaces: []string{"$NS"},
+			}
+		})
+		schedulingIntervals := func(intervals string) {
+			recipeToValidate.Annotations = map[string]string{
+				controllers.RecipeVolumeSchedulingIntervalsAnnotation: intervals,
+			}
+		}
+
+		It("accepts an interval of a volume group", func() {
+			schedulingIntervals(`{v1: 10m}`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("rejects an interval of a resource group", func() {
+			schedulingIntervals(`{g1: 10m}`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+			Expect(err).To(MatchError(ContainSubstring("group g1 is not a volume group")))
+		})
+		It("rejects an invalid interval", func() {
+			schedulingIntervals(`{v1: 10s}`)
+			_, err := recipeValidate(map[string][]string{"NS": {namespaceName}})
+			Expect(err).To(MatchError(ContainSubstring("is not of the form")))
+		})
+	})
+})
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/go-logr/logr"
+	recipev1 "github.com/ramendr/recipe/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// SchedulingIntervalAnnotation overrides, on a PVC, the VRG scheduling interval at which the
+	// PVC is replicated.
+	SchedulingIntervalAnnotation = "volumereplicationgroups.ramendr.openshift.io/scheduling-interval"
+
+	// RecipeVolumeSchedulingIntervalsAnnotation overrides, on a recipe, the VRG scheduling
+	// interval of the PVCs of its volume groups, as a YAML or JSON map of group name to interval.
+	RecipeVolumeSchedulingIntervalsAnnotation = "ramendr.openshift.io/volume-scheduling-intervals"
+)
+
+// schedulingIntervalRegexp matches the DRPolicy scheduling interval format
+var schedulingIntervalRegexp = regexp.MustCompile(`^\d+[mhd]$`)
+
+func schedulingIntervalValidate(schedulingInterval string) error {
+	if !schedulingIntervalRegexp.MatchString(schedulingInterval) {
+		return fmt.Errorf("scheduling interval %q is not of the form <num><m,h,d>", schedulingInterval)
+	}
+
+	return nil
+}
+
+// recipeVolumeSchedulingIntervalsGet returns the validated scheduling intervals of the volume
+// groups of a recipe, or nil if it has none.
+func recipeVolumeSchedulingIntervalsGet(recipe recipev1.Recipe) (map[string]string, error) {
+	value, ok := recipe.GetAnnotations()[RecipeVolumeSchedulingIntervalsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	intervals := map[string]string{}
+	if err := yaml.Unmarshal([]byte(value), &intervals); err != nil {
+		return nil, fmt.Errorf("annotation %s unmarshal error: %w", RecipeVolumeSchedulingIntervalsAnnotation, err)
+	}
+
+	volumeGroupNames := []string{}
+	for _, group := range recipeVolumeGroups(recipe) {
+		volumeGroupNames = append(volumeGroupNames, group.Name)
+	}
+
+	errs := []error{}
+
+	for name, interval := range intervals {
+		if !slices.Contains(volumeGroupNames, name) {
+			errs = append(errs, fmt.Errorf("group %s is not a volume group", name))
+		}
+
+		if err := schedulingIntervalValidate(interval); err != nil {
+			errs = append(errs, fmt.Errorf("group %s: %w", name, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("annotation %s is invalid: %w", RecipeVolumeSchedulingIntervalsAnnotation, err)
+	}
+
+	return intervals, nil
+}
+
+// recipeVolumeGroups returns the volumes group of a recipe, followed by its other groups of
+// volume type.
+func recipeVolumeGroups(recipe recipev1.Recipe) []*recipev1.Group {
+	groups := []*recipev1.Group{}
+
+	if recipe.Spec.Volumes != nil {
+		groups = append(groups, recipe.Spec.Volumes)
+	}
+
+	for _, group := range recipe.Spec.Groups {
+		if group.Type == "volume" {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+func recipeVolumeGroupSelectsPVC(group *recipev1.Group, pvc *corev1.PersistentVolumeClaim) (bool, error) {
+	if len(group.IncludedNamespaces) > 0 && !slices.Contains(group.IncludedNamespaces, pvc.Namespace) {
+		return false, nil
+	}
+
+	if group.LabelSelector == nil {
+		return true, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(group.LabelSelector)
+	if err != nil {
+		return false, err
+	}
+
+	return selector.Matches(labels.Set(pvc.GetLabels())), nil
+}
+
+// pvcSchedulingInterval returns the scheduling interval at which a PVC is replicated: the one of
+// its annotation, else the one of the first recipe volume group selecting it, else the VRG one.
+// Invalid overrides are logged and ignored.
+func (v *VRGInstance) pvcSchedulingInterval(pvc *corev1.PersistentVolumeClaim) string {
+	if v.instance.Spec.Async == nil {
+		return ""
+	}
+
+	log := v.log.WithValues("pvc", pvc.Namespace+"/"+pvc.Name)
+
+	if interval, ok := pvc.GetAnnotations()[SchedulingIntervalAnnotation]; ok {
+		err := schedulingIntervalValidate(interval)
+		if err == nil {
+			return interval
+		}
+
+		log.Info("Ignoring PVC scheduling interval annotation", "error", err.Error())
+	}
+
+	if interval := v.recipeVolumeGroupSchedulingInterval(pvc, log); interval != "" {
+		return interval
+	}
+
+	return v.instance.Spec.Async.SchedulingInterval
+}
+
+func (v *VRGInstance) recipeVolumeGroupSchedulingInterval(pvc *corev1.PersistentVolumeClaim,
+	log logr.Logger,
+) string {
+	recipe := v.recipeElements.RecipeWithParams
+	if recipe == nil {
+		return ""
+	}
+
+	intervals, err := recipeVolumeSchedulingIntervalsGet(*recipe)
+	if err != nil {
+		log.Info("Ignoring recipe volume scheduling intervals", "error", err.Error())
+
+		return ""
+	}
+
+	for _, group := range recipeVolumeGroups(*recipe) {
+		interval, ok := intervals[group.Name]
+		if !ok {
+			continue
+		}
+
+		selected, err := recipeVolumeGroupSelectsPVC(group, pvc)
+		if err != nil {
+			log.Info("Ignoring recipe volume group with invalid label selector", "group", group.Name,
+				"error", err.Error())
+
+			continue
+		}
+
+		if selected {
+			return interval
+		}
+	}
+
+	return ""
+}
@@ -44,16 +44,8 @@ func (v *VRGInstance) reconcileVolGroupRepsAsPrimary(groupPVCs map[types.Namespa
 			continue
 		}
 
-		for idx := range pvcs {
-			pvc := pvcs[idx]
-
-			if err := v.uploadPVandPVCtoS3Stores(pvc, log); err != nil {
-				log.Error(err, "Requeuing due to failure to upload PV/PVC object to S3 store(s)")
-
-				v.requeue()
-
-				continue
-			}
+		if !v.uploadPVsandPVCstoS3Stores(pvcs, log) {
+			v.requeue()
 		}
 
 		if err := v.uploadVGRandVGRCtoS3Stores(vgrNamespacedName, log); err != nil {
@@ -1062,6 +1054,7 @@ func (v *VRGInstance) cleanupVGRForRestore(vgr *volrep.VolumeGroupReplication) e
 	vgr.ObjectMeta.Finalizers = []string{}
 	vgr.ObjectMeta.ResourceVersion = ""
 	vgr.ObjectMeta.OwnerReferences = nil
+	vgr.Namespace = rmnutil.MappedNamespaceName(v.instance.Spec.NamespaceMapping, vgr.Namespace)
 
 	if !vrgInAdminNamespace(v.instance, v.ramenConfig) {
 		if err := ctrl.SetControllerReference(v.instance, vgr, v.reconciler.Scheme); err != nil {
@@ -64,6 +64,9 @@ func logWithPvcName(log logr.Logger, pvc *corev1.PersistentVolumeClaim) logr.Log
 func (v *VRGInstance) reconcileVolRepsAsPrimary() {
 	groupPVCs := make(map[types.NamespacedName][]*corev1.PersistentVolumeClaim)
 
+	uploadPVCs := []*corev1.PersistentVolumeClaim{}
+	uploadPVCCount := 0
+
 	v.log.Info(fmt.Sprintf("Reconciling VolRep as Primary. %d VolRepPVCs", len(v.volRepPVCs)))
 
 	for idx := range v.volRepPVCs {
@@ -76,19 +79,24 @@ func (v *VRGInstance) reconcileVolRepsAsPrimary() {
 		}
 
 		if err := v.updateProtectedPVCs(pvc); err != nil {
+			// The PVC may be eligible for upload, so the cluster data manifest is deferred
+			uploadPVCCount++
+
 			v.requeue()
 
 			continue
 		}
 
 		requeueResult, skip := v.preparePVCForVRProtection(pvc, log)
-		if requeueResult {
-			v.requeue()
-
+		if skip {
 			continue
 		}
 
-		if skip {
+		uploadPVCCount++
+
+		if requeueResult {
+			v.requeue()
+
 			continue
 		}
 
@@ -114,24 +122,24 @@ func (v *VRGInstance) reconcileVolRepsAsPrimary() {
 			continue
 		}
 
-		// Protect the PVC's PV object stored in etcd by uploading it to S3
-		// store(s).  Note that the VRG is responsible only to protect the PV
-		// object of each PVC of the subscription.  However, the PVC object
-		// itself is assumed to be protected along with other k8s objects in the
-		// subscription, such as, the deployment, pods, services, etc., by an
-		// entity external to the VRG a la IaC.
-		if err := v.uploadPVandPVCtoS3Stores(pvc, log); err != nil {
-			log.Error(err, "Requeuing due to failure to upload PV object to S3 store(s)")
-
-			v.requeue()
+		log.Info("Successfully processed VolumeReplication for PersistentVolumeClaim")
 
-			continue
-		}
+		uploadPVCs = append(uploadPVCs, pvc)
+	}
 
-		log.Info("Successfully processed VolumeReplication for PersistentVolumeClaim")
+	// Protect the PVC's PV object stored in etcd by uploading it to S3
+	// store(s).  Note that the VRG is responsible only to protect the PV
+	// object of each PVC of the subscription.  However, the PVC object
+	// itself is assumed to be protected along with other k8s objects in the
+	// subscription, such as, the deployment, pods, services, etc., by an
+	// entity external to the VRG a la IaC.
+	if !v.uploadPVsandPVCstoS3Stores(uploadPVCs, v.log) {
+		v.requeue()
 	}
 
 	v.reconcileVolGroupRepsAsPrimary(groupPVCs)
+
+	v.clusterDataManifestUploadIfComplete(uploadPVCCount)
 }
 
 // reconcileVolRepsAsSecondary reconciles VolumeReplication resources for the VRG as secondary
@@ -149,7 +157,7 @@ func (v *VRGInstance) reconcileVolRepsAsSecondary() bool {
 		log := logWithPvcName(v.log, pvc)
 
 		// Potentially for PVCs that are not deleted, e.g Failover of STS without required auto delete options
-		if !slices.Contains(pvc.Finalizers, PvcVRFinalizerProtected) {
+		if !v.pvcVRProtected(pvc) {
 			log.Info("pvc does not contain VR protection finalizer. Skipping it")
 
 			v.pvcStatusDeleteIfPresent(pvc.Namespace, pvc.Name, log)
@@ -211,6 +219,7 @@ func (v *VRGInstance) reconcileVolRepsAsSecondary() bool {
 	}
 
 	v.reconcileVolGroupRepsAsSecondary(&requeue, groupPVCs)
+	v.updateVolRepPrimaryCapacityConditions()
 
 	return requeue
 }
@@ -323,6 +332,13 @@ func (v *VRGInstance) updateProtectedPVCs(pvc *corev1.PersistentVolumeClaim) err
 	protectedPVC.AccessModes = pvc.Spec.AccessModes
 	protectedPVC.Resources = pvc.Spec.Resources
 	protectedPVC.VolumeMode = pvc.Spec.VolumeMode
+	protectedPVC.SchedulingInterval = v.instance.Spec.Async.SchedulingInterval
+
+	if !selectVolumeGroup {
+		protectedPVC.SchedulingInterval = v.pvcSchedulingInterval(pvc)
+	}
+
+	v.updatePVCCapacityCondition(protectedPVC, pvc)
 
 	return v.setPVCStorageIdentifiers(protectedPVC, storageClass, pvc)
 }
@@ -411,6 +427,11 @@ func (v *VRGInstance) preparePVCForVRProtection(pvc *corev1.PersistentVolumeClai
 		return !requeue, skip
 	}
 
+	// The protection of the PVC is kept track of in the status instead
+	if v.protectOnly() {
+		return !requeue, !skip
+	}
+
 	return v.protectPVC(pvc, log), !skip
 }
 
@@ -520,6 +541,10 @@ func (v *VRGInstance) preparePVCForVRDeletion(pvc *corev1.PersistentVolumeClaim,
 
 		return err
 	}
+
+	// PVs and PVCs without ramen annotations, labels, and finalizers, as in the protect only mode, are not updated
+	pvUnmodified := pv.DeepCopy()
+	pvcUnmodified := pvc.DeepCopy()
 	// For Async mode, we want to change the retention policy back to delete
 	// and remove the annotation.
 	// For Sync mode, we don't want to set the retention policy to delete as
@@ -536,7 +561,7 @@ func (v *VRGInstance) preparePVCForVRDeletion(pvc *corev1.PersistentVolumeClaim,
 
 	delete(pv.Annotations, pvcVRAnnotationArchivedKey)
 
-	if err := v.reconciler.Update(v.ctx, &pv); err != nil {
+	if err := v.updateIfModified(pvUnmodified, &pv); err != nil {
 		log.Error(err, "Failed to update PersistentVolume for VR deletion")
 
 		return fmt.Errorf("failed to update PersistentVolume %s claimed by %s/%s"+
@@ -556,7 +581,7 @@ func (v *VRGInstance) preparePVCForVRDeletion(pvc *corev1.PersistentVolumeClaim,
 
 	log1 := log.WithValues("owner removed", ownerRemoved, "finalizer removed", finalizerRemoved)
 
-	if err := v.reconciler.Update(v.ctx, pvc); err != nil {
+	if err := v.updateIfModified(pvcUnmodified, pvc); err != nil {
 		log1.Error(err, "Failed to update PersistentVolumeClaim for VR deletion")
 
 		return fmt.Errorf("failed to update PersistentVolumeClaim %s/%s"+
@@ -622,6 +647,10 @@ func (v *VRGInstance) isArchivedAlready(pvc *corev1.PersistentVolumeClaim, log l
 		return false
 	}
 
+	if v.protectOnly() {
+		return v.isArchivedAlreadyInStatus(pvc, &pv)
+	}
+
 	newHashValue := rmnutil.HashPVC(pvc)
 	if pvc.Annotations[pvcVRAnnotationArchivedKey] != newHashValue {
 		return false
@@ -641,51 +670,105 @@ func (v *VRGInstance) isPVCResizeCompleted(pvc *corev1.PersistentVolumeClaim) bo
 	return requested.Cmp(actual) == 0
 }
 
-// Upload PV to the list of S3 stores in the VRG spec
-func (v *VRGInstance) uploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim, log logr.Logger) (err error) {
+// pvClusterDataUpload is an upload of the cluster data of a PVC and its PV to the S3 stores in the VRG spec
+type pvClusterDataUpload struct {
+	pvc *corev1.PersistentVolumeClaim
+	pv  corev1.PersistentVolume
+
+	// errs are the errors of the uploads, by S3 profile in the VRG spec
+	errs []error
+}
+
+// uploadPVsandPVCstoS3Stores uploads the PVs and PVCs of the PVCs, that are not uploaded already, to the
+// S3 stores in the VRG spec. At most the S3 upload concurrency of the RamenConfig uploads are in flight at
+// a time. It returns false if the cluster data of any of the PVCs is not protected.
+func (v *VRGInstance) uploadPVsandPVCstoS3Stores(pvcs []*corev1.PersistentVolumeClaim, log logr.Logger) bool {
+	protected := true
+	uploads := make([]*pvClusterDataUpload, 0, len(pvcs))
+
+	for _, pvc := range pvcs {
+		upload, err := v.pvClusterDataUploadNew(pvc, log)
+		if err != nil {
+			logWithPvcName(log, pvc).Error(err, "Requeuing due to failure to upload PV/PVC object to S3 store(s)")
+
+			protected = false
+
+			continue
+		}
+
+		if upload != nil {
+			uploads = append(uploads, upload)
+		}
+	}
+
+	v.UploadPVandPVCtoS3Stores(uploads)
+
+	for _, upload := range uploads {
+		if err := v.pvClusterDataUploaded(upload, log); err != nil {
+			logWithPvcName(log, upload.pvc).Error(err, "Requeuing due to failure to upload PV/PVC object to S3 store(s)")
+
+			protected = false
+		}
+	}
+
+	return protected
+}
+
+// pvClusterDataUploadNew returns the upload of the cluster data of a PVC and its PV, or nil if they
+// are uploaded already
+func (v *VRGInstance) pvClusterDataUploadNew(pvc *corev1.PersistentVolumeClaim, log logr.Logger,
+) (*pvClusterDataUpload, error) {
 	if v.isArchivedAlready(pvc, log) {
 		msg := fmt.Sprintf("PV cluster data already protected for PVC %s", pvc.Name)
 		v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
 			VRGConditionReasonUploaded, msg)
+		v.clusterDataManifestAdd(pvc)
 
-		return nil
+		return nil, nil
 	}
 
 	// If the result of above check is false, it symbolizes change in hash. To ensure PVC resize is complete,
 	// IOW underlying PV is also resized, pvc spec is compared with pvc status and wait till it is achieved
 	// and then upload to S3.
 	if !v.isPVCResizeCompleted(pvc) {
-		return fmt.Errorf("resize is in progress for pvc %s", pvc.Name)
+		return nil, fmt.Errorf("resize is in progress for pvc %s", pvc.Name)
 	}
 
 	// Error out if VRG has no S3 profiles
-	numProfilesToUpload := len(v.instance.Spec.S3Profiles)
-	if numProfilesToUpload == 0 {
+	if len(v.instance.Spec.S3Profiles) == 0 {
 		msg := "Error uploading PV cluster data because VRG spec has no S3 profiles"
 		v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
 			VRGConditionReasonUploadError, msg)
 		v.log.Info(msg)
 
-		return fmt.Errorf("error uploading cluster data of PV %s because VRG spec has no S3 profiles",
+		return nil, fmt.Errorf("error uploading cluster data of PV %s because VRG spec has no S3 profiles",
 			pvc.Name)
 	}
 
-	s3Profiles, err := v.UploadPVandPVCtoS3Stores(pvc, log)
+	pv, err := v.getPVFromPVC(pvc)
 	if err != nil {
-		return fmt.Errorf("failed to upload PV/PVC with error (%w). Uploaded to %v S3 profile(s)", err, s3Profiles)
+		return nil, fmt.Errorf("error getting PV for PVC, failed to protect cluster data for PVC %s, %w",
+			pvc.Name, err)
 	}
 
-	numProfilesUploaded := len(s3Profiles)
+	return &pvClusterDataUpload{pvc: pvc, pv: pv}, nil
+}
 
-	if numProfilesUploaded != numProfilesToUpload {
-		// Merely defensive as we don't expect to reach here
-		msg := fmt.Sprintf("uploaded PV/PVC cluster data to only  %d of %d S3 profile(s): %v",
-			numProfilesUploaded, numProfilesToUpload, s3Profiles)
-		v.log.Info(msg)
-		v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
-			VRGConditionReasonUploadError, msg)
+// pvClusterDataUploaded records the result of the upload of the cluster data of a PVC and its PV
+func (v *VRGInstance) pvClusterDataUploaded(upload *pvClusterDataUpload, log logr.Logger) error {
+	pvc := upload.pvc
+	s3Profiles := []string{}
 
-		return errors.New(msg)
+	for idx, err := range upload.errs {
+		if err != nil {
+			v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name, VRGConditionReasonUploadError, err.Error())
+			rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
+				rmnutil.EventReasonUploadFailed, err.Error())
+
+			return fmt.Errorf("failed to upload PV/PVC with error (%w). Uploaded to %v S3 profile(s)", err, s3Profiles)
+		}
+
+		s3Profiles = append(s3Profiles, v.instance.Spec.S3Profiles[idx])
 	}
 
 	if err := v.addArchivedAnnotationForPVC(pvc, log); err != nil {
@@ -697,84 +780,91 @@ func (v *VRGInstance) uploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim
 	}
 
 	msg := fmt.Sprintf("Done uploading PV/PVC cluster data to %d of %d S3 profile(s): %v",
-		numProfilesUploaded, numProfilesToUpload, s3Profiles)
+		len(s3Profiles), len(v.instance.Spec.S3Profiles), s3Profiles)
 	v.log.Info(msg)
 	v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name,
 		VRGConditionReasonUploaded, msg)
+	v.clusterDataManifestAdd(pvc)
 
 	return nil
 }
 
-func (v *VRGInstance) UploadPVandPVCtoS3Store(s3ProfileName string, pvc *corev1.PersistentVolumeClaim) error {
+func (v *VRGInstance) getUploadObjectStorer(s3ProfileName string) (ObjectStorer, error) {
 	if s3ProfileName == "" {
-		return fmt.Errorf("missing S3 profiles, failed to protect cluster data for PVC %s", pvc.Name)
+		return nil, fmt.Errorf("missing S3 profiles, failed to protect cluster data")
 	}
 
 	objectStore, err := v.getObjectStorer(s3ProfileName)
 	if err != nil {
-		return fmt.Errorf("error getting object store, failed to protect cluster data for PVC %s, %w", pvc.Name, err)
+		return nil, fmt.Errorf("error getting object store, failed to protect cluster data, %w", err)
 	}
 
-	pv, err := v.getPVFromPVC(pvc)
-	if err != nil {
-		return fmt.Errorf("error getting PV for PVC, failed to protect cluster data for PVC %s to s3Profile %s, %w",
-			pvc.Name, s3ProfileName, err)
+	return objectStore, nil
+}
+
+// UploadPVandPVCtoS3Stores uploads the PVs and PVCs of the uploads to each of the S3 stores in the VRG spec,
+// in parallel, and records the errors of the uploads in them
+func (v *VRGInstance) UploadPVandPVCtoS3Stores(uploads []*pvClusterDataUpload) {
+	if len(uploads) == 0 {
+		return
 	}
 
-	return v.UploadPVAndPVCtoS3(s3ProfileName, objectStore, &pv, pvc)
-}
+	s3Profiles := v.instance.Spec.S3Profiles
+	objectStores := make([]ObjectStorer, len(s3Profiles))
+	objectStoreErrs := make([]error, len(s3Profiles))
+
+	// Object storers are cached, so they are got before the uploads
+	for idx, s3ProfileName := range s3Profiles {
+		objectStores[idx], objectStoreErrs[idx] = v.getUploadObjectStorer(s3ProfileName)
+	}
+
+	errs := doConcurrently(len(uploads)*len(s3Profiles), s3UploadConcurrencyOrDefault(v.ramenConfig),
+		func(i int) error {
+			upload, idx := uploads[i/len(s3Profiles)], i%len(s3Profiles)
+			if objectStoreErrs[idx] != nil {
+				return fmt.Errorf("%w for PVC %s", objectStoreErrs[idx], upload.pvc.Name)
+			}
+
+			return UploadPVAndPVCtoS3(v.s3KeyPrefix(), s3Profiles[idx], objectStores[idx], &upload.pv, upload.pvc)
+		})
+
+	for _, upload := range uploads {
+		upload.errs = make([]error, len(s3Profiles))
+	}
+
+	for i, err := range errs {
+		uploads[i/len(s3Profiles)].errs[i%len(s3Profiles)] = err
 
-func (v *VRGInstance) UploadPVAndPVCtoS3(s3ProfileName string, objectStore ObjectStorer,
-	pv *corev1.PersistentVolume, pvc *corev1.PersistentVolumeClaim,
-) error {
-	if err := UploadPV(objectStore, v.s3KeyPrefix(), pv.Name, *pv); err != nil {
 		var aerr awserr.Error
 		if errors.As(err, &aerr) {
 			// Treat any aws error as a persistent error
+			s3ProfileName := s3Profiles[i%len(s3Profiles)]
 			v.cacheObjectStorer(s3ProfileName, nil,
 				fmt.Errorf("persistent error while uploading to s3 profile %s, will retry later", s3ProfileName))
 		}
+	}
+}
 
-		err := fmt.Errorf("error uploading PV to s3Profile %s, failed to protect cluster data for PVC %s, %w",
+// UploadPVAndPVCtoS3 uploads a PV and its PVC to an S3 store. It is safe to call concurrently.
+func UploadPVAndPVCtoS3(keyPrefix, s3ProfileName string, objectStore ObjectStorer,
+	pv *corev1.PersistentVolume, pvc *corev1.PersistentVolumeClaim,
+) error {
+	if err := UploadPV(objectStore, keyPrefix, pv.Name, *pv); err != nil {
+		return fmt.Errorf("error uploading PV to s3Profile %s, failed to protect cluster data for PVC %s, %w",
 			s3ProfileName, pvc.Name, err)
-
-		return err
 	}
 
 	pvcNamespacedName := types.NamespacedName{Namespace: pvc.Namespace, Name: pvc.Name}
 	pvcNamespacedNameString := pvcNamespacedName.String()
 
-	if err := UploadPVC(objectStore, v.s3KeyPrefix(), pvcNamespacedNameString, *pvc); err != nil {
-		err := fmt.Errorf("error uploading PVC to s3Profile %s, failed to protect cluster data for PVC %s, %w",
+	if err := UploadPVC(objectStore, keyPrefix, pvcNamespacedNameString, *pvc); err != nil {
+		return fmt.Errorf("error uploading PVC to s3Profile %s, failed to protect cluster data for PVC %s, %w",
 			s3ProfileName, pvcNamespacedNameString, err)
-
-		return err
 	}
 
 	return nil
 }
 
-func (v *VRGInstance) UploadPVandPVCtoS3Stores(pvc *corev1.PersistentVolumeClaim,
-	log logr.Logger,
-) ([]string, error) {
-	succeededProfiles := []string{}
-	// Upload the PV to all the S3 profiles in the VRG spec
-	for _, s3ProfileName := range v.instance.Spec.S3Profiles {
-		err := v.UploadPVandPVCtoS3Store(s3ProfileName, pvc)
-		if err != nil {
-			v.updatePVCClusterDataProtectedCondition(pvc.Namespace, pvc.Name, VRGConditionReasonUploadError, err.Error())
-			rmnutil.ReportIfNotPresent(v.reconciler.eventRecorder, v.instance, corev1.EventTypeWarning,
-				rmnutil.EventReasonUploadFailed, err.Error())
-
-			return succeededProfiles, err
-		}
-
-		succeededProfiles = append(succeededProfiles, s3ProfileName)
-	}
-
-	return succeededProfiles, nil
-}
-
 func (v *VRGInstance) getPVFromPVC(pvc *corev1.PersistentVolumeClaim) (corev1.PersistentVolume, error) {
 	pv := corev1.PersistentVolume{}
 	volumeName := pvc.Spec.VolumeName
@@ -880,6 +970,15 @@ func (v *VRGInstance) pvcUnprotectVolRep(pvc corev1.PersistentVolumeClaim, log l
 	}
 }
 
+// updateIfModified updates an object if it is modified from its unmodified copy
+func (v *VRGInstance) updateIfModified(unmodified, object client.Object) error {
+	if reflect.DeepEqual(unmodified, object) {
+		return nil
+	}
+
+	return v.reconciler.Update(v.ctx, object)
+}
+
 //nolint:funlen,gocognit,cyclop
 func (v *VRGInstance) pvcsUnprotectVolRep(pvcs []corev1.PersistentVolumeClaim) {
 	groupPVCs := make(map[types.NamespacedName][]*corev1.PersistentVolumeClaim)
@@ -895,7 +994,7 @@ func (v *VRGInstance) pvcsUnprotectVolRep(pvcs []corev1.PersistentVolumeClaim) {
 		//    the VR protection finalizer has been successfully removed. No need to process.
 		// If not all PVCs are processed during deletion,
 		// requeue the deletion request, as related events are not guaranteed
-		if !slices.Contains(pvc.Finalizers, PvcVRFinalizerProtected) {
+		if !v.pvcVRProtected(pvc) {
 			log.Info("pvc does not contain VR protection finalizer. Skipping it")
 
 			continue
@@ -1071,6 +1170,7 @@ func (v *VRGInstance) deleteClusterDataInS3Stores(log logr.Logger) error {
 	log.Info("Delete cluster data in", "s3Profiles", v.instance.Spec.S3Profiles)
 
 	keyPrefix := v.s3KeyPrefix()
+	v.reconciler.clusterDataManifests.Delete(v.namespacedName)
 
 	return v.s3StoresDo(
 		func(s ObjectStorer) error { return s.DeleteObjectsWithKeyPrefix(keyPrefix) },
@@ -1094,12 +1194,94 @@ func (v *VRGInstance) pvAndPvcObjectReplicasDelete(pvc corev1.PersistentVolumeCl
 		keys = append(keys, TypedObjectKey(keyPrefix, pvc.Name, corev1.PersistentVolumeClaim{}))
 	}
 
+	// The manifest lists the replicas, so it is deleted with them until it is uploaded again
+	keys = append(keys, clusterDataManifestKey(keyPrefix))
+	v.reconciler.clusterDataManifests.Delete(v.namespacedName)
+
 	return v.s3StoresDo(
 		func(s ObjectStorer) error { return s.DeleteObjects(keys...) },
 		fmt.Sprintf("delete object replicas %v", keys),
 	)
 }
 
+// clusterDataManifestAdd adds the keys of the cluster data of a PVC and its PV, which are uploaded to
+// the S3 stores, to the manifest
+func (v *VRGInstance) clusterDataManifestAdd(pvc *corev1.PersistentVolumeClaim) {
+	keyPrefix := v.s3KeyPrefix()
+
+	v.clusterDataManifest.PVKeys = append(v.clusterDataManifest.PVKeys,
+		TypedObjectKey(keyPrefix, pvc.Spec.VolumeName, corev1.PersistentVolume{}))
+	v.clusterDataManifest.PVCKeys = append(v.clusterDataManifest.PVCKeys,
+		TypedObjectKey(keyPrefix, client.ObjectKeyFromObject(pvc).String(), corev1.PersistentVolumeClaim{}))
+}
+
+// uploadedClusterDataManifest is the cluster data manifest last uploaded by a VRG, with the generation
+// and replication state the VRG had then. Another cluster uploads its own manifest with the same keys
+// while the VRG is not primary, so the manifest is uploaded again once either of them changes.
+type uploadedClusterDataManifest struct {
+	uid              types.UID
+	generation       int64
+	replicationState ramendrv1alpha1.ReplicationState
+	manifest         ClusterDataManifest
+}
+
+// clusterDataManifestUploaded returns true if the given manifest was uploaded by the VRG, with its
+// current generation and replication state
+func (v *VRGInstance) clusterDataManifestUploaded(manifest ClusterDataManifest) bool {
+	value, ok := v.reconciler.clusterDataManifests.Load(v.namespacedName)
+	if !ok {
+		return false
+	}
+
+	uploaded, ok := value.(uploadedClusterDataManifest)
+
+	return ok &&
+		uploaded.uid == v.instance.UID &&
+		uploaded.generation == v.instance.Generation &&
+		uploaded.replicationState == v.instance.Spec.ReplicationState &&
+		reflect.DeepEqual(uploaded.manifest, manifest)
+}
+
+// clusterDataManifestUploadIfComplete uploads the manifest of the cluster data to the S3 stores, if the
+// cluster data of all PVCs eligible for upload is uploaded, and the manifest is not uploaded already
+func (v *VRGInstance) clusterDataManifestUploadIfComplete(uploadPVCCount int) {
+	manifest := v.clusterDataManifest
+
+	if len(manifest.PVCKeys) != uploadPVCCount {
+		v.log.Info("Cluster data manifest upload deferred until the cluster data of all PVCs is uploaded",
+			"uploaded", len(manifest.PVCKeys), "eligible", uploadPVCCount)
+
+		return
+	}
+
+	slices.Sort(manifest.PVKeys)
+	slices.Sort(manifest.PVCKeys)
+
+	if v.clusterDataManifestUploaded(manifest) {
+		return
+	}
+
+	keyPrefix := v.s3KeyPrefix()
+
+	if err := v.s3StoresDo(
+		func(s ObjectStorer) error { return uploadClusterDataManifest(s, keyPrefix, manifest) },
+		"upload cluster data manifest",
+	); err != nil {
+		v.log.Error(err, "Requeuing due to failure to upload cluster data manifest to S3 store(s)")
+		v.requeue()
+
+		return
+	}
+
+	v.reconciler.clusterDataManifests.Store(v.namespacedName, uploadedClusterDataManifest{
+		uid:              v.instance.UID,
+		generation:       v.instance.Generation,
+		replicationState: v.instance.Spec.ReplicationState,
+		manifest:         manifest,
+	})
+	v.log.Info("Uploaded cluster data manifest", "PVs", len(manifest.PVKeys), "PVCs", len(manifest.PVCKeys))
+}
+
 func (v *VRGInstance) s3StoresDo(do func(ObjectStorer) error, msg string) error {
 	for _, s3ProfileName := range v.instance.Spec.S3Profiles {
 		if s3ProfileName == NoS3StoreAvailable {
@@ -1422,6 +1604,12 @@ func (v *VRGInstance) selectVolumeReplicationClass(
 
 	matchingReplicationClassList := []client.Object{}
 
+	// PVCs of a volume group are replicated together, at the VRG scheduling interval
+	pvcSchedulingInterval := v.instance.Spec.Async.SchedulingInterval
+	if !selectVolumeGroup {
+		pvcSchedulingInterval = v.pvcSchedulingInterval(pvc)
+	}
+
 	filterMatchingReplicationClass := func(replicationClass client.Object, parameters map[string]string,
 		provisioner string, rIDLabel string,
 	) {
@@ -1432,8 +1620,8 @@ func (v *VRGInstance) selectVolumeReplicationClass(
 			return
 		}
 
-		// ReplicationClass that matches both VRG schedule and pvc provisioner
-		if schedulingInterval != v.instance.Spec.Async.SchedulingInterval {
+		// ReplicationClass that matches both PVC schedule and pvc provisioner
+		if schedulingInterval != pvcSchedulingInterval {
 			return
 		}
 
@@ -1489,12 +1677,12 @@ func (v *VRGInstance) selectVolumeReplicationClass(
 	switch len(matchingReplicationClassList) {
 	case 0:
 		v.log.Info(fmt.Sprintf("No %s found to match provisioner and schedule %s/%s", objType,
-			storageClass.Provisioner, v.instance.Spec.Async.SchedulingInterval))
+			storageClass.Provisioner, pvcSchedulingInterval))
 
 		return nil, fmt.Errorf("no %s found to match provisioner and schedule", objType)
 	case 1:
 		v.log.Info(fmt.Sprintf("Found %s that matches provisioner and schedule %s/%s", objType,
-			storageClass.Provisioner, v.instance.Spec.Async.SchedulingInterval))
+			storageClass.Provisioner, pvcSchedulingInterval))
 
 		return matchingReplicationClassList[0], nil
 	}
@@ -1589,6 +1777,8 @@ func (v *VRGInstance) checkVRStatus(pvcs []*corev1.PersistentVolumeClaim, volRep
 		return false
 	}
 
+	v.updatePVCsVolumeReplicationState(pvcs, status)
+
 	switch {
 	case v.instance.Spec.ReplicationState == ramendrv1alpha1.Primary:
 		return v.validateVRStatus(pvcs, volRep, ramendrv1alpha1.Primary, status)
@@ -2100,6 +2290,10 @@ func (v *VRGInstance) addProtectedAnnotationForPVC(pvc *corev1.PersistentVolumeC
 }
 
 func (v *VRGInstance) addArchivedAnnotationForPVC(pvc *corev1.PersistentVolumeClaim, log logr.Logger) error {
+	if v.protectOnly() {
+		return v.setArchivedInStatus(pvc)
+	}
+
 	err := v.addAnnotationForResource(pvc, "PersistentVolumeClaim", pvcVRAnnotationArchivedKey, rmnutil.HashPVC(pvc), log)
 	if err != nil {
 		return err
@@ -2168,10 +2362,19 @@ func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, error)
 			continue
 		}
 
+		var manifest *ClusterDataManifest
+
+		manifest, err = downloadClusterDataManifest(objectStore, v.s3KeyPrefix())
+		if err != nil {
+			v.log.Error(err, "Cluster data manifest inaccessible", "profile", s3ProfileName)
+
+			continue
+		}
+
 		var pvCount, pvcCount int
 
 		// Restore all PVs found in the s3 store. If any failure, the next profile will be retried
-		pvCount, err = v.restorePVsFromObjectStore(objectStore, s3ProfileName)
+		pvCount, err = v.restorePVsFromObjectStore(objectStore, s3ProfileName, manifest)
 		if err != nil {
 			continue
 		}
@@ -2183,7 +2386,7 @@ func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, error)
 		// CrunchyDB is responsible for creating and managing the lifecycle of their own PVCs, a newly created
 		// PVC may cause a new PV to be created.
 		// Ignoring PVC restore errors helps with the upgrade from ODF-4.12.x to 4.13
-		pvcCount, err = v.restorePVCsFromObjectStore(objectStore, s3ProfileName)
+		pvcCount, err = v.restorePVCsFromObjectStore(objectStore, s3ProfileName, manifest)
 		if err != nil || pvCount != pvcCount {
 			v.log.Info(fmt.Sprintf("Warning: Mismatch in PV/PVC count %d/%d (%v)",
 				pvCount, pvcCount, err))
@@ -2205,8 +2408,12 @@ func (v *VRGInstance) restorePVsAndPVCsFromS3(result *ctrl.Result) (int, error)
 	return 0, err
 }
 
-func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3ProfileName string) (int, error) {
-	pvList, err := downloadPVs(objectStore, v.s3KeyPrefix())
+func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3ProfileName string,
+	manifest *ClusterDataManifest,
+) (int, error) {
+	keyPrefix := v.s3KeyPrefix()
+
+	pvList, err := downloadPVs(objectStore, keyPrefix, s3UploadConcurrencyOrDefault(v.ramenConfig))
 	if err != nil {
 		v.log.Error(err, fmt.Sprintf("error fetching PV cluster data from S3 profile %s", s3ProfileName))
 
@@ -2215,6 +2422,17 @@ func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3Prof
 
 	v.log.Info(fmt.Sprintf("Found %d PVs in s3 store using profile %s", len(pvList), s3ProfileName))
 
+	if manifest != nil {
+		keys := make([]string, len(pvList))
+		for idx := range pvList {
+			keys[idx] = TypedObjectKey(keyPrefix, pvList[idx].Name, corev1.PersistentVolume{})
+		}
+
+		if err := v.checkClusterDataComplete(manifest.PVKeys, keys, s3ProfileName); err != nil {
+			return 0, err
+		}
+	}
+
 	if err = v.checkPVClusterData(pvList); err != nil {
 		errMsg := fmt.Sprintf("Error found in PV cluster data in S3 store %s", s3ProfileName)
 		v.log.Info(errMsg)
@@ -2226,8 +2444,12 @@ func (v *VRGInstance) restorePVsFromObjectStore(objectStore ObjectStorer, s3Prof
 	return restoreClusterDataObjects(v, pvList, "PV", v.cleanupPVForRestore, v.validateExistingPV)
 }
 
-func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3ProfileName string) (int, error) {
-	pvcList, err := downloadPVCs(objectStore, v.s3KeyPrefix())
+func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3ProfileName string,
+	manifest *ClusterDataManifest,
+) (int, error) {
+	keyPrefix := v.s3KeyPrefix()
+
+	pvcList, err := downloadPVCs(objectStore, keyPrefix, s3UploadConcurrencyOrDefault(v.ramenConfig))
 	if err != nil {
 		v.log.Error(err, fmt.Sprintf("error fetching PVC cluster data from S3 profile %s", s3ProfileName))
 
@@ -2236,9 +2458,41 @@ func (v *VRGInstance) restorePVCsFromObjectStore(objectStore ObjectStorer, s3Pro
 
 	v.log.Info(fmt.Sprintf("Found %d PVCs in s3 store using profile %s", len(pvcList), s3ProfileName))
 
+	if manifest != nil {
+		keys := make([]string, len(pvcList))
+		for idx := range pvcList {
+			keys[idx] = TypedObjectKey(keyPrefix, client.ObjectKeyFromObject(&pvcList[idx]).String(),
+				corev1.PersistentVolumeClaim{})
+		}
+
+		if err := v.checkClusterDataComplete(manifest.PVCKeys, keys, s3ProfileName); err != nil {
+			return 0, err
+		}
+	}
+
+	// PVCs of a peer cluster are restored in the namespaces they are mapped to on this cluster
+	for idx := range pvcList {
+		pvcList[idx].Namespace = rmnutil.MappedNamespaceName(v.instance.Spec.NamespaceMapping, pvcList[idx].Namespace)
+	}
+
 	v.volRepPVCs = append(v.volRepPVCs, pvcList...)
 
-	return restoreClusterDataObjects(v, pvcList, "PVC", cleanupPVCForRestore, v.validateExistingPVC)
+	return restoreClusterDataObjects(v, pvcList, "PVC", v.cleanupPVCForRestore, v.validateExistingPVC)
+}
+
+// checkClusterDataComplete returns an error if any of the keys listed in the cluster data manifest of an S3
+// store is not among the keys of the cluster data downloaded from it, such as when an upload was interrupted
+func (v *VRGInstance) checkClusterDataComplete(manifestKeys, keys []string, s3ProfileName string) error {
+	missingKeys := missingClusterDataKeys(manifestKeys, keys)
+	if len(missingKeys) == 0 {
+		return nil
+	}
+
+	err := fmt.Errorf("incomplete cluster data in S3 profile %s, missing %d of %d objects listed in its manifest: %v",
+		s3ProfileName, len(missingKeys), len(manifestKeys), missingKeys)
+	v.log.Info(err.Error())
+
+	return err
 }
 
 // checkPVClusterData returns an error if there are PVs in the input pvList
@@ -2443,6 +2697,10 @@ func (v *VRGInstance) validateExistingPVC(pvc *corev1.PersistentVolumeClaim) err
 			pvcNSName.String(), existingPVC.Spec.VolumeName, pvc.Spec.VolumeName)
 	}
 
+	if err := v.expandExistingPVC(existingPVC, pvc); err != nil {
+		return err
+	}
+
 	v.log.Info(fmt.Sprintf("PVC %s exists and bound to desired PV %s", pvcNSName.String(), existingPVC.Spec.VolumeName))
 
 	return nil
@@ -2567,23 +2825,32 @@ func (v *VRGInstance) processPVSecrets(pv *corev1.PersistentVolume) error {
 }
 
 // cleanupForRestore cleans up required PV or PVC fields, to ensure restore succeeds
-// to a new cluster, and rebinding the PVC to an existing PV with the same claimRef
+// to a new cluster, and rebinding the PVC to an existing PV with the same claimRef.
+// The StorageClass and the claimRef namespace of a peer cluster are mapped to those
+// on this cluster.
 func (v *VRGInstance) cleanupPVForRestore(pv *corev1.PersistentVolume) error {
 	pv.ResourceVersion = ""
 	if pv.Spec.ClaimRef != nil {
 		pv.Spec.ClaimRef.UID = ""
 		pv.Spec.ClaimRef.ResourceVersion = ""
 		pv.Spec.ClaimRef.APIVersion = ""
+		pv.Spec.ClaimRef.Namespace = rmnutil.MappedNamespaceName(v.instance.Spec.NamespaceMapping,
+			pv.Spec.ClaimRef.Namespace)
 	}
 
+	pv.Spec.StorageClassName = *rmnutil.MappedStorageClassName(v.instance.Spec.StorageClassMapping,
+		&pv.Spec.StorageClassName)
+
 	return v.processPVSecrets(pv)
 }
 
-func cleanupPVCForRestore(pvc *corev1.PersistentVolumeClaim) error {
+func (v *VRGInstance) cleanupPVCForRestore(pvc *corev1.PersistentVolumeClaim) error {
 	pvc.ObjectMeta.Annotations = PruneAnnotations(pvc.GetAnnotations())
 	pvc.ObjectMeta.Finalizers = []string{}
 	pvc.ObjectMeta.ResourceVersion = ""
 	pvc.ObjectMeta.OwnerReferences = nil
+	pvc.Spec.StorageClassName = rmnutil.MappedStorageClassName(v.instance.Spec.StorageClassMapping,
+		pvc.Spec.StorageClassName)
 
 	return nil
 }
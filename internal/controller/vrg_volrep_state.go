@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	rmnutil "github.com/ramendr/ramen/internal/controller/util"
+)
+
+// vrConditionReasonSplitBrain is the default reason of the Degraded condition with which storage drivers report a
+// volume in split-brain, which the replication API does not define, see
+// RamenConfig.VolumeReplicationSplitBrainReasons
+const vrConditionReasonSplitBrain = "SplitBrain"
+
+// vrSplitBrainReasons returns the reasons of the Degraded condition that report a volume in split-brain
+func vrSplitBrainReasons(ramenConfig *ramendrv1alpha1.RamenConfig) []string {
+	if ramenConfig == nil || len(ramenConfig.VolumeReplicationSplitBrainReasons) == 0 {
+		return []string{vrConditionReasonSplitBrain}
+	}
+
+	return ramenConfig.VolumeReplicationSplitBrainReasons
+}
+
+// updatePVCsVolumeReplicationState copies the state of a VolumeReplication, or VolumeGroupReplication, into the
+// protected PVCs of its PVCs
+func (v *VRGInstance) updatePVCsVolumeReplicationState(pvcs []*corev1.PersistentVolumeClaim,
+	status *volrep.VolumeReplicationStatus,
+) {
+	now := metav1.Now()
+	splitBrainReasons := vrSplitBrainReasons(v.ramenConfig)
+
+	for idx := range pvcs {
+		protectedPVC := v.findProtectedPVC(pvcs[idx].Namespace, pvcs[idx].Name)
+		if protectedPVC == nil {
+			continue
+		}
+
+		protectedPVC.VolumeReplicationState = volumeReplicationStateNew(protectedPVC.VolumeReplicationState,
+			status, splitBrainReasons, now)
+	}
+}
+
+// volumeReplicationStateNew returns the state of a VolumeReplication from its status, with the resync counters of
+// its previous state advanced if a resync started since
+func volumeReplicationStateNew(previous *ramendrv1alpha1.VolumeReplicationState,
+	status *volrep.VolumeReplicationStatus, splitBrainReasons []string, now metav1.Time,
+) *ramendrv1alpha1.VolumeReplicationState {
+	messages := []string{}
+	addMessage := func(message string) {
+		if message != "" && !slices.Contains(messages, message) {
+			messages = append(messages, message)
+		}
+	}
+
+	addMessage(status.Message)
+
+	conditionTrue := func(conditionType string) bool {
+		condition := rmnutil.FindCondition(status.Conditions, conditionType)
+		if condition == nil || condition.Status != metav1.ConditionTrue {
+			return false
+		}
+
+		addMessage(condition.Message)
+
+		return true
+	}
+
+	resyncing := conditionTrue(volrep.ConditionResyncing)
+	degraded := conditionTrue(volrep.ConditionDegraded)
+
+	state := &ramendrv1alpha1.VolumeReplicationState{
+		State:        string(status.State),
+		Message:      strings.Join(messages, "; "),
+		Degraded:     degraded && !resyncing,
+		Resyncing:    resyncing,
+		SplitBrain:   vrSplitBrain(status, splitBrainReasons),
+		LastSyncTime: status.LastSyncTime,
+	}
+
+	if previous != nil {
+		state.ResyncCount = previous.ResyncCount
+		state.LastResyncStartTime = previous.LastResyncStartTime
+	}
+
+	if resyncing && (previous == nil || !previous.Resyncing) {
+		state.ResyncCount++
+		state.LastResyncStartTime = &now
+	}
+
+	return state
+}
+
+// vrSplitBrain returns whether the storage driver reports a volume in split-brain with one of the split-brain
+// reasons on the true Degraded condition of its VolumeReplication
+func vrSplitBrain(status *volrep.VolumeReplicationStatus, splitBrainReasons []string) bool {
+	condition := rmnutil.FindCondition(status.Conditions, volrep.ConditionDegraded)
+
+	return condition != nil && condition.Status == metav1.ConditionTrue &&
+		slices.Contains(splitBrainReasons, condition.Reason)
+}
+
+// updateVRGReplicationDegradedCondition sets the ReplicationDegraded condition from the state of the
+// VolumeReplications of the protected PVCs, which is only present when the state of any is known
+func (v *VRGInstance) updateVRGReplicationDegradedCondition() {
+	var splitBrain, degraded []string
+
+	known := false
+
+	for idx := range v.instance.Status.ProtectedPVCs {
+		protectedPVC := &v.instance.Status.ProtectedPVCs[idx]
+
+		state := protectedPVC.VolumeReplicationState
+		if state == nil {
+			continue
+		}
+
+		known = true
+		pvcName := rmnutil.ProtectedPVCNamespacedName(*protectedPVC).String()
+
+		switch {
+		case state.SplitBrain:
+			splitBrain = append(splitBrain, pvcName)
+		case state.Degraded:
+			degraded = append(degraded, pvcName)
+		}
+	}
+
+	if !known {
+		meta.RemoveStatusCondition(&v.instance.Status.Conditions, VRGConditionTypeReplicationDegraded)
+
+		return
+	}
+
+	condition := metav1.Condition{
+		Type:               VRGConditionTypeReplicationDegraded,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: v.instance.Generation,
+		Reason:             VRGConditionReasonReplicationHealthy,
+		Message:            "Replication of PVCs protected by VolumeReplication is healthy",
+	}
+
+	switch {
+	case len(splitBrain) != 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = VRGConditionReasonSplitBrain
+		condition.Message = fmt.Sprintf("PVCs are in split-brain: %v", splitBrain)
+	case len(degraded) != 0:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = VRGConditionReasonDegraded
+		condition.Message = fmt.Sprintf("Replication of PVCs is degraded: %v", degraded)
+	}
+
+	rmnutil.SetStatusCondition(&v.instance.Status.Conditions, condition)
+}
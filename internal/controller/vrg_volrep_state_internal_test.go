@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	volrep "github.com/csi-addons/kubernetes-csi-addons/api/replication.storage/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+)
+
+func vrCondition(conditionType string, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
+
+func vrDegraded(reason, message string) metav1.Condition {
+	return vrCondition(volrep.ConditionDegraded, metav1.ConditionTrue, reason, message)
+}
+
+func TestVRSplitBrain(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  []metav1.Condition
+		ramenConfig *ramendrv1alpha1.RamenConfig
+		splitBrain  bool
+	}{
+		{
+			name:       "split-brain",
+			conditions: []metav1.Condition{vrDegraded(vrConditionReasonSplitBrain, "")},
+			splitBrain: true,
+		},
+		{
+			name:       "degraded",
+			conditions: []metav1.Condition{vrDegraded(volrep.VolumeDegraded, "split-brain")},
+		},
+		{
+			name: "healthy",
+			conditions: []metav1.Condition{
+				vrCondition(volrep.ConditionDegraded, metav1.ConditionFalse, volrep.Healthy, ""),
+			},
+		},
+		{
+			name: "no longer split-brain",
+			conditions: []metav1.Condition{
+				vrCondition(volrep.ConditionDegraded, metav1.ConditionFalse, vrConditionReasonSplitBrain, ""),
+			},
+		},
+		{
+			name: "split-brain reason of another condition",
+			conditions: []metav1.Condition{
+				vrCondition(volrep.ConditionCompleted, metav1.ConditionTrue, vrConditionReasonSplitBrain, ""),
+			},
+		},
+		{
+			name: "no conditions",
+		},
+		{
+			name:       "configured split-brain reason",
+			conditions: []metav1.Condition{vrDegraded("Diverged", "")},
+			ramenConfig: &ramendrv1alpha1.RamenConfig{
+				VolumeReplicationSplitBrainReasons: []string{"Diverged", "SplitBrainDetected"},
+			},
+			splitBrain: true,
+		},
+		{
+			name:       "default split-brain reason when others are configured",
+			conditions: []metav1.Condition{vrDegraded(vrConditionReasonSplitBrain, "")},
+			ramenConfig: &ramendrv1alpha1.RamenConfig{
+				VolumeReplicationSplitBrainReasons: []string{"Diverged"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.splitBrain, vrSplitBrain(&volrep.VolumeReplicationStatus{Conditions: tt.conditions},
+				vrSplitBrainReasons(tt.ramenConfig)))
+		})
+	}
+}
+
+func TestVolumeReplicationStateNew(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC))
+	earlier := metav1.NewTime(now.Add(-time.Hour))
+	resyncing := vrCondition(volrep.ConditionResyncing, metav1.ConditionTrue, volrep.ResyncTriggered,
+		"volume is resyncing")
+	notResyncing := vrCondition(volrep.ConditionResyncing, metav1.ConditionFalse, volrep.NotResyncing,
+		"volume is not resyncing")
+	healthy := vrCondition(volrep.ConditionDegraded, metav1.ConditionFalse, volrep.Healthy, "volume is healthy")
+
+	tests := []struct {
+		name     string
+		previous *ramendrv1alpha1.VolumeReplicationState
+		status   volrep.VolumeReplicationStatus
+		expected ramendrv1alpha1.VolumeReplicationState
+	}{
+		{
+			name: "healthy",
+			status: volrep.VolumeReplicationStatus{
+				State:        volrep.PrimaryState,
+				Message:      "volume is promoted",
+				Conditions:   []metav1.Condition{healthy, notResyncing},
+				LastSyncTime: &earlier,
+			},
+			expected: ramendrv1alpha1.VolumeReplicationState{
+				State:        string(volrep.PrimaryState),
+				Message:      "volume is promoted",
+				LastSyncTime: &earlier,
+			},
+		},
+		{
+			name: "degraded",
+			status: volrep.VolumeReplicationStatus{
+				State:      volrep.SecondaryState,
+				Message:    "volume is demoted",
+				Conditions: []metav1.Condition{vrDegraded(volrep.VolumeDegraded, "volume is degraded"), notResyncing},
+			},
+			expected: ramendrv1alpha1.VolumeReplicationState{
+				State:    string(volrep.SecondaryState),
+				Message:  "volume is demoted; volume is degraded",
+				Degraded: true,
+			},
+		},
+		{
+			name: "resync started",
+			status: volrep.VolumeReplicationStatus{
+				State:      volrep.SecondaryState,
+				Message:    "volume is resyncing",
+				Conditions: []metav1.Condition{vrDegraded(volrep.VolumeDegraded, "volume is degraded"), resyncing},
+			},
+			expected: ramendrv1alpha1.VolumeReplicationState{
+				State:               string(volrep.SecondaryState),
+				Message:             "volume is resyncing; volume is degraded",
+				Resyncing:           true,
+				ResyncCount:         1,
+				LastResyncStartTime: &now,
+			},
+		},
+		{
+			name:     "resync continues",
+			previous: &ramendrv1alpha1.VolumeReplicationState{Resyncing: true, ResyncCount: 1, LastResyncStartTime: &earlier},
+			status:   volrep.VolumeReplicationStatus{Conditions: []metav1.Condition{resyncing}},
+			expected: ramendrv1alpha1.VolumeReplicationState{
+				Message:             "volume is resyncing",
+				Resyncing:           true,
+				ResyncCount:         1,
+				LastResyncStartTime: &earlier,
+			},
+		},
+		{
+			name:     "resync completed",
+			previous: &ramendrv1alpha1.VolumeReplicationState{Resyncing: true, ResyncCount: 1, LastResyncStartTime: &earlier},
+			status:   volrep.VolumeReplicationStatus{Conditions: []metav1.Condition{notResyncing}},
+			expected: ramendrv1alpha1.VolumeReplicationState{ResyncCount: 1, LastResyncStartTime: &earlier},
+		},
+		{
+			name: "split-brain",
+			status: volrep.VolumeReplicationStatus{
+				State:      volrep.PrimaryState,
+				Conditions: []metav1.Condition{vrDegraded(vrConditionReasonSplitBrain, "volume is in split-brain")},
+			},
+			expected: ramendrv1alpha1.VolumeReplicationState{
+				State:      string(volrep.PrimaryState),
+				Message:    "volume is in split-brain",
+				Degraded:   true,
+				SplitBrain: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, &tt.expected,
+				volumeReplicationStateNew(tt.previous, &tt.status, vrSplitBrainReasons(nil), now))
+		})
+	}
+}
+
+func TestUpdateVRGReplicationDegradedCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		states   []*ramendrv1alpha1.VolumeReplicationState
+		expected *metav1.Condition
+	}{
+		{
+			name:   "no state known",
+			states: []*ramendrv1alpha1.VolumeReplicationState{nil},
+		},
+		{
+			name:   "healthy",
+			states: []*ramendrv1alpha1.VolumeReplicationState{nil, {Resyncing: true}},
+			expected: &metav1.Condition{
+				Status:  metav1.ConditionFalse,
+				Reason:  VRGConditionReasonReplicationHealthy,
+				Message: "Replication of PVCs protected by VolumeReplication is healthy",
+			},
+		},
+		{
+			name:   "degraded",
+			states: []*ramendrv1alpha1.VolumeReplicationState{{Degraded: true}, {}},
+			expected: &metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  VRGConditionReasonDegraded,
+				Message: "Replication of PVCs is degraded: [app/a]",
+			},
+		},
+		{
+			name:   "split-brain over degraded",
+			states: []*ramendrv1alpha1.VolumeReplicationState{{Degraded: true}, {Degraded: true, SplitBrain: true}},
+			expected: &metav1.Condition{
+				Status:  metav1.ConditionTrue,
+				Reason:  VRGConditionReasonSplitBrain,
+				Message: "PVCs are in split-brain: [app/b]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &VRGInstance{
+				log: logr.Discard(),
+				instance: &ramendrv1alpha1.VolumeReplicationGroup{
+					ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app", Generation: 2},
+				},
+			}
+			util.SetStatusCondition(&v.instance.Status.Conditions, metav1.Condition{
+				Type:   VRGConditionTypeReplicationDegraded,
+				Status: metav1.ConditionTrue,
+				Reason: VRGConditionReasonDegraded,
+			})
+
+			for idx, state := range tt.states {
+				v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, ramendrv1alpha1.ProtectedPVC{
+					Name:                   string(rune('a' + idx)),
+					Namespace:              "app",
+					VolumeReplicationState: state,
+				})
+			}
+
+			v.updateVRGReplicationDegradedCondition()
+
+			condition := util.FindCondition(v.instance.Status.Conditions, VRGConditionTypeReplicationDegraded)
+			if tt.expected == nil {
+				assert.Nil(t, condition)
+
+				return
+			}
+
+			require.NotNil(t, condition)
+			assert.Equal(t, tt.expected.Status, condition.Status)
+			assert.Equal(t, tt.expected.Reason, condition.Reason)
+			assert.Equal(t, tt.expected.Message, condition.Message)
+			assert.Equal(t, v.instance.Generation, condition.ObservedGeneration)
+		})
+	}
+}
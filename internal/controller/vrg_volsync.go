@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
 	"github.com/go-logr/logr"
@@ -15,9 +16,9 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
-	"github.com/ramendr/ramen/internal/controller/cephfscg"
 	"github.com/ramendr/ramen/internal/controller/util"
 	"github.com/ramendr/ramen/internal/controller/volsync"
+	"github.com/ramendr/ramen/internal/controller/volsynccg"
 )
 
 //nolint:gocognit,funlen,cyclop
@@ -41,18 +42,19 @@ func (v *VRGInstance) restorePVsAndPVCsForVolSync() (int, error) {
 		rdSpec.ProtectedPVC.Conditions = nil
 
 		cgLabelVal, ok := rdSpec.ProtectedPVC.Labels[util.ConsistencyGroupLabel]
-		if ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
+		if ok && v.volSyncCGEnabled() {
 			v.log.Info("The CG label from the primary cluster found in RDSpec", "Label", cgLabelVal)
 			// Get the CG label value for this cluster
-			cgLabelVal, err = v.getCGLabelValue(rdSpec.ProtectedPVC.StorageClassName,
+			cgLabelVal, err = v.getCGLabelValue(
+				v.volSyncHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 			if err == nil {
-				cephfsCGHandler := cephfscg.NewVSCGHandler(
+				cgHandler := volsynccg.NewVSCGHandler(
 					v.ctx, v.reconciler.Client, v.instance,
 					&metav1.LabelSelector{MatchLabels: map[string]string{util.ConsistencyGroupLabel: cgLabelVal}},
 					v.volSyncHandler, cgLabelVal, v.log,
 				)
-				err = cephfsCGHandler.EnsurePVCfromRGD(rdSpec, failoverAction)
+				err = cgHandler.EnsurePVCfromRGD(rdSpec, failoverAction)
 			}
 		} else {
 			// Create a PVC from snapshot or for direct copy
@@ -137,6 +139,8 @@ func (v *VRGInstance) reconcileVolSyncAsPrimary(finalSyncPrepared *bool) (requeu
 		}
 	}
 
+	v.updateVolSyncRecoveryPoints(volSyncPVCNames(v.volSyncPVCs))
+
 	if requeue {
 		v.log.Info("Not all ReplicationSources completed setup. We'll retry...")
 
@@ -159,6 +163,8 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		return true
 	}
 
+	v.updatePVCCapacityCondition(protectedPVC, &pvc)
+
 	if util.IsSubmarinerEnabled(v.instance.GetAnnotations()) {
 		rsSpec = ramendrv1alpha1.VolSyncReplicationSourceSpec{
 			ProtectedPVC: *protectedPVC,
@@ -175,10 +181,13 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		rsSpec = *rsSpecInfo
 	}
 
+	// The RS spec from the DRPC carries no scheduling interval override of the PVC
+	rsSpec.ProtectedPVC.SchedulingInterval = protectedPVC.SchedulingInterval
+
 	v.log.Info("PVC has CG label", "name", pvc.Name, "Labels", pvc.Labels)
 	cg, ok := v.getCGLablelFromPVC(&pvc, v.instance.Spec.RunFinalSync)
 
-	isCGEnabled := ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader)
+	isCGEnabled := ok && v.volSyncCGEnabled()
 
 	pvcNamespacedName := util.ProtectedPVCNamespacedName(*protectedPVC)
 
@@ -196,38 +205,66 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 
 	*finalSyncPrepared = true
 
+	// Scheduled syncs outside of the sync windows are deferred, while the final sync is not
+	protectedPVC.SyncDeferredUntil = nil
+	if !v.instance.Spec.RunFinalSync {
+		protectedPVC.SyncDeferredUntil = v.volSyncHandler.SyncDeferredUntil(time.Now())
+	}
+
 	if isCGEnabled {
+		// PVCs of a consistency group are replicated together, at the VRG scheduling interval
+		if v.instance.Spec.Async != nil {
+			protectedPVC.SchedulingInterval = v.instance.Spec.Async.SchedulingInterval
+		}
+
 		if v.instance.Spec.PrepareForFinalSync {
 			v.log.Info("PrepareForFinalSync is true, so we will not run final sync for CG PVCs", "CG", cg)
 
 			return true // requeue
 		}
 
-		cephfsCGHandler := cephfscg.NewVSCGHandler(
+		cgHandler := volsynccg.NewVSCGHandler(
 			v.ctx, v.reconciler.Client, v.instance,
 			&metav1.LabelSelector{MatchLabels: map[string]string{util.ConsistencyGroupLabel: cg}},
 			v.volSyncHandler, cg, v.log,
 		)
 
-		rgs, finalSyncComplete, err := cephfsCGHandler.CreateOrUpdateReplicationGroupSource(
+		rgs, finalSyncComplete, err := cgHandler.CreateOrUpdateReplicationGroupSource(
 			pvc.Namespace, v.instance.Spec.RunFinalSync,
 		)
 		if err != nil {
 			v.log.Info("Failed to CreateOrUpdateReplicationGroupSource", "err", err)
 
+			// The errors of the final sync name the PVCs of the group that failed it
 			setVRGConditionTypeVolSyncRepSourceSetupError(&protectedPVC.Conditions, v.instance.Generation,
-				"VolSync setup failed")
+				fmt.Sprintf("VolSync setup failed: %v", err))
 
 			return true
 		}
 
 		setVRGConditionTypeVolSyncRepSourceSetupComplete(&protectedPVC.Conditions, v.instance.Generation, "Ready")
 
+		var member *ramendrv1alpha1.ReplicationGroupMemberStatus
+
 		if rgs != nil {
+			// The PVCs of a group are synced at the time of the group, each by its own mover
 			protectedPVC.LastSyncTime = rgs.Status.LastSyncTime
 			protectedPVC.LastSyncDuration = rgs.Status.LastSyncDuration
+			protectedPVC.LastSyncBytes = nil
+			protectedPVC.LastSyncError = ""
+
+			if member = volsynccg.FindMember(rgs.Status.Members, pvc.Name); member != nil {
+				if member.LastSyncDuration != nil {
+					protectedPVC.LastSyncDuration = member.LastSyncDuration
+				}
+
+				protectedPVC.LastSyncBytes = member.LastSyncBytes
+				protectedPVC.LastSyncError = member.Error
+			}
 		}
 
+		v.updateVolSyncGroupMemberSyncProgress(protectedPVC, member)
+
 		return v.instance.Spec.RunFinalSync && !finalSyncComplete
 	}
 
@@ -255,9 +292,46 @@ func (v *VRGInstance) reconcilePVCAsVolSyncPrimary(pvc corev1.PersistentVolumeCl
 		protectedPVC.LastSyncDuration = rs.Status.LastSyncDuration
 	}
 
+	v.updateVolSyncSyncProgress(protectedPVC)
+
+	if !v.instance.Spec.PrepareForFinalSync && !v.instance.Spec.RunFinalSync {
+		v.reconcileVolSyncVerificationAsPrimary(protectedPVC)
+	}
+
 	return v.instance.Spec.RunFinalSync && !finalSyncComplete
 }
 
+// updateVolSyncSyncProgress reports the progress of the sync in progress of a PVC, as far as it
+// is known when it fails to be fully determined
+func (v *VRGInstance) updateVolSyncSyncProgress(protectedPVC *ramendrv1alpha1.ProtectedPVC) {
+	progress, err := v.volSyncHandler.SyncProgress(protectedPVC.Name, protectedPVC.Namespace, time.Now())
+	if err != nil {
+		v.log.Info("Failed to get the progress of the sync of PVC", "pvc", protectedPVC.Name, "error", err)
+	}
+
+	protectedPVC.SyncProgress = progress
+}
+
+// updateVolSyncGroupMemberSyncProgress reports the progress of the sync in progress of a PVC of a
+// consistency group, by the ReplicationSource of its restored PVC, which is named after the
+// restored PVC rather than the PVC
+func (v *VRGInstance) updateVolSyncGroupMemberSyncProgress(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	member *ramendrv1alpha1.ReplicationGroupMemberStatus,
+) {
+	if member == nil {
+		protectedPVC.SyncProgress = nil
+
+		return
+	}
+
+	progress, err := v.volSyncHandler.GroupMemberSyncProgress(member.Name, protectedPVC.Namespace, time.Now())
+	if err != nil {
+		v.log.Info("Failed to get the progress of the sync of PVC", "pvc", protectedPVC.Name, "error", err)
+	}
+
+	protectedPVC.SyncProgress = progress
+}
+
 func (v *VRGInstance) buildProtectedPVCForPVC(pvc corev1.PersistentVolumeClaim) (*ramendrv1alpha1.ProtectedPVC, bool) {
 	newProtectedPVC := &ramendrv1alpha1.ProtectedPVC{
 		Name:               pvc.Name,
@@ -269,21 +343,24 @@ func (v *VRGInstance) buildProtectedPVCForPVC(pvc corev1.PersistentVolumeClaim)
 		AccessModes:        pvc.Spec.AccessModes,
 		Resources:          pvc.Spec.Resources,
 		VolumeMode:         pvc.Spec.VolumeMode,
+		SchedulingInterval: v.pvcSchedulingInterval(&pvc),
 	}
 
 	if v.pvcUnprotectVolSyncIfDeleted(pvc, v.log) {
 		return nil, false
 	}
 
-	err := util.NewResourceUpdater(&pvc).
-		AddFinalizer(volsync.PVCFinalizerProtected).
-		AddLabel(util.LabelOwnerNamespaceName, v.instance.Namespace).
-		AddLabel(util.LabelOwnerName, v.instance.Name).
-		Update(v.ctx, v.reconciler.Client)
-	if err != nil {
-		v.log.Info(fmt.Sprintf("Unable to add finalizer for PVC. We'll retry later. %v", err))
+	if !v.protectOnly() {
+		err := util.NewResourceUpdater(&pvc).
+			AddFinalizer(volsync.PVCFinalizerProtected).
+			AddLabel(util.LabelOwnerNamespaceName, v.instance.Namespace).
+			AddLabel(util.LabelOwnerName, v.instance.Name).
+			Update(v.ctx, v.reconciler.Client)
+		if err != nil {
+			v.log.Info(fmt.Sprintf("Unable to add finalizer for PVC. We'll retry later. %v", err))
 
-		return nil, true // requeue
+			return nil, true // requeue
+		}
 	}
 
 	protectedPVC := v.findProtectedPVC(pvc.Namespace, pvc.Name)
@@ -292,6 +369,7 @@ func (v *VRGInstance) buildProtectedPVCForPVC(pvc corev1.PersistentVolumeClaim)
 		protectedPVC = &v.instance.Status.ProtectedPVCs[len(v.instance.Status.ProtectedPVCs)-1]
 	} else if !reflect.DeepEqual(protectedPVC, newProtectedPVC) {
 		newProtectedPVC.Conditions = protectedPVC.Conditions
+		newProtectedPVC.Verification = protectedPVC.Verification
 		newProtectedPVC.DeepCopyInto(protectedPVC)
 	}
 
@@ -401,7 +479,12 @@ func (v *VRGInstance) reconcileRDSpecForDeletionOrReplication() bool {
 		requeue = true
 	}
 
+	v.reconcileVolSyncDestinationCapacities()
+
 	if !requeue {
+		v.reconcileVolSyncVerificationsAsSecondary()
+		v.reconcileVolSyncRecoveryPointsAsSecondary()
+
 		v.log.Info("Successfully reconciled VolSync as Secondary")
 	}
 
@@ -430,10 +513,11 @@ func (v *VRGInstance) reconcileCGMembership() (map[string]struct{}, bool, error)
 		rdSpec := v.instance.Spec.VolSync.RDSpec[index]
 
 		cgLabelVal, ok := rdSpec.ProtectedPVC.Labels[util.ConsistencyGroupLabel]
-		if ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
+		if ok && v.volSyncCGEnabled() {
 			v.log.Info("RDSpec contains the CG label from the primary cluster", "Label", cgLabelVal)
 			// Get the CG label value for this cluster
-			cgLabelVal, err := v.getCGLabelValue(rdSpec.ProtectedPVC.StorageClassName,
+			cgLabelVal, err := v.getCGLabelValue(
+				v.volSyncHandler.DestinationStorageClassName(rdSpec.ProtectedPVC.StorageClassName),
 				rdSpec.ProtectedPVC.Name, rdSpec.ProtectedPVC.Namespace)
 			if err != nil {
 				v.log.Error(err, "Failed to get cgLabelVal")
@@ -511,7 +595,7 @@ func (v *VRGInstance) createOrUpdateReplicationDestinations(
 	requeue := false
 
 	for groupKey, groupVal := range groups {
-		cephfsCGHandler := cephfscg.NewVSCGHandler(
+		cgHandler := volsynccg.NewVSCGHandler(
 			v.ctx, v.reconciler.Client, v.instance,
 			&metav1.LabelSelector{MatchLabels: map[string]string{util.ConsistencyGroupLabel: groupKey}},
 			v.volSyncHandler, groupKey, v.log,
@@ -527,7 +611,7 @@ func (v *VRGInstance) createOrUpdateReplicationDestinations(
 			return true, fmt.Errorf("RDSpecs in the group %s have different namespaces", groupKey)
 		}
 
-		replicationGroupDestination, err := cephfsCGHandler.CreateOrUpdateReplicationGroupDestination(
+		replicationGroupDestination, err := cgHandler.CreateOrUpdateReplicationGroupDestination(
 			v.instance.Name, namespace, groups[groupKey],
 		)
 		if err != nil {
@@ -853,7 +937,7 @@ func (v *VRGInstance) pvcUnprotectVolSync(pvc corev1.PersistentVolumeClaim, log
 	}
 
 	_, ok := pvc.Labels[util.ConsistencyGroupLabel]
-	if ok && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader) {
+	if ok && v.volSyncCGEnabled() {
 		// At this moment, we don't support unprotecting CG PVCs.
 		log.Info("Unprotecting CG PVCs is not supported", "PVC", pvc.Name)
 
@@ -931,15 +1015,19 @@ func (v *VRGInstance) doCleanupResources(name, namespace string) error {
 		return err
 	}
 
+	if err := v.volSyncHandler.CleanupVerification(name, namespace); err != nil {
+		return err
+	}
+
 	if err := v.volSyncHandler.DeleteSnapshots(namespace); err != nil {
 		return err
 	}
 
-	if err := cephfscg.DeleteRGS(v.ctx, v.reconciler.Client, v.instance.Name, v.instance.Namespace, v.log); err != nil {
+	if err := volsynccg.DeleteRGS(v.ctx, v.reconciler.Client, v.instance.Name, v.instance.Namespace, v.log); err != nil {
 		return err
 	}
 
-	if err := cephfscg.DeleteRGD(v.ctx, v.reconciler.Client, v.instance.Name, v.instance.Namespace, v.log); err != nil {
+	if err := volsynccg.DeleteRGD(v.ctx, v.reconciler.Client, v.instance.Name, v.instance.Namespace, v.log); err != nil {
 		return err
 	}
 
@@ -1022,3 +1110,43 @@ func (v *VRGInstance) getCGLablelFromPVC(pvc *corev1.PersistentVolumeClaim, fina
 
 	return "", false
 }
+
+// volSyncCGEnabled returns true if VolSync PVCs with a consistency group label are protected
+// as a group. Consistency groups replicate with the rsync-TLS mover only.
+func (v *VRGInstance) volSyncCGEnabled() bool {
+	return !v.volSyncHandler.IsObjectStoreMover() && util.IsCGEnabledForVolSync(v.ctx, v.reconciler.APIReader)
+}
+
+// volSyncObjectStoreRepository returns the repository of the Restic and Rclone VolSync movers, in
+// the bucket of the mover S3 profile, or of the first of the VRG S3 profiles. Both clusters have the
+// same S3 profiles, in the same order, so they share the repository.
+func (v *VRGInstance) volSyncObjectStoreRepository() (*volsync.ObjectStoreRepository, error) {
+	s3ProfileName := v.instance.Spec.VolSync.MoverS3ProfileName
+	if s3ProfileName == "" {
+		if len(v.instance.Spec.S3Profiles) == 0 {
+			return nil, fmt.Errorf("no S3 profile for the VolSync %s mover", v.instance.Spec.VolSync.Mover)
+		}
+
+		s3ProfileName = v.instance.Spec.S3Profiles[0]
+	}
+
+	s3StoreProfile, err := GetRamenConfigS3StoreProfile(v.ctx, v.reconciler.APIReader, s3ProfileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get VolSync mover s3 profile %s: %w", s3ProfileName, err)
+	}
+
+	accessID, secretAccessKey, err := GetS3Secret(v.ctx, v.reconciler.APIReader, s3StoreProfile.S3SecretRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %v of s3 profile %s: %w", s3StoreProfile.S3SecretRef,
+			s3ProfileName, err)
+	}
+
+	return &volsync.ObjectStoreRepository{
+		Endpoint:        s3StoreProfile.S3CompatibleEndpoint,
+		Bucket:          s3StoreProfile.S3Bucket,
+		Region:          s3StoreProfile.S3Region,
+		AccessKeyID:     accessID,
+		SecretAccessKey: secretAccessKey,
+		CACertificates:  s3StoreProfile.CACertificates,
+	}, nil
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+)
+
+// reconcileVolSyncRecoveryPointsAsSecondary retains the latest images of the destinations of the
+// PVCs, and prunes their past images, by the snapshot retention of the VRG
+func (v *VRGInstance) reconcileVolSyncRecoveryPointsAsSecondary() {
+	pvcs := make([]types.NamespacedName, 0, len(v.instance.Spec.VolSync.RDSpec))
+
+	for _, rdSpec := range v.instance.Spec.VolSync.RDSpec {
+		pvc := types.NamespacedName{Namespace: rdSpec.ProtectedPVC.Namespace, Name: rdSpec.ProtectedPVC.Name}
+		pvcs = append(pvcs, pvc)
+
+		if err := v.volSyncHandler.ReconcileRecoveryPoints(pvc.Name, pvc.Namespace,
+			v.instance.Spec.VolSync.SnapshotRetention); err != nil {
+			v.log.Info("Failed to reconcile recovery points", "pvc", pvc, "error", err)
+		}
+	}
+
+	v.updateVolSyncRecoveryPoints(pvcs)
+}
+
+// updateVolSyncRecoveryPoints lists the recovery points of the PVCs in the status. They are listed
+// as a primary too, as the recovery points of a PVC remain after it fails over, until the VRG is
+// secondary again and prunes them.
+func (v *VRGInstance) updateVolSyncRecoveryPoints(pvcs []types.NamespacedName) {
+	recoveryPoints := []ramendrv1alpha1.VolSyncRecoveryPoints{}
+
+	for _, pvc := range pvcs {
+		points, err := v.volSyncHandler.ListRecoveryPoints(pvc.Name, pvc.Namespace)
+		if err != nil {
+			v.log.Info("Failed to list recovery points", "pvc", pvc, "error", err)
+
+			// Keep the recovery points listed before
+			for _, pvcRecoveryPoints := range v.instance.Status.VolSyncRecoveryPoints {
+				if pvcRecoveryPoints.Namespace == pvc.Namespace && pvcRecoveryPoints.Name == pvc.Name {
+					recoveryPoints = append(recoveryPoints, pvcRecoveryPoints)
+				}
+			}
+
+			continue
+		}
+
+		if len(points) == 0 {
+			continue
+		}
+
+		recoveryPoints = append(recoveryPoints, ramendrv1alpha1.VolSyncRecoveryPoints{
+			Namespace: pvc.Namespace,
+			Name:      pvc.Name,
+			Points:    points,
+		})
+	}
+
+	if len(recoveryPoints) == 0 {
+		recoveryPoints = nil
+	}
+
+	v.instance.Status.VolSyncRecoveryPoints = recoveryPoints
+}
+
+func volSyncPVCNames(pvcs []corev1.PersistentVolumeClaim) []types.NamespacedName {
+	names := make([]types.NamespacedName, 0, len(pvcs))
+	for idx := range pvcs {
+		names = append(names, client.ObjectKeyFromObject(&pvcs[idx]))
+	}
+
+	return names
+}
@@ -0,0 +1,438 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/util"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+// The manifests of the source and the destination of a PVC are exchanged through the S3 stores
+// of the VRG, below its key prefix, with the time at which the source was snapshotted in their
+// names, so that the peer cluster finds them by listing.
+const (
+	volSyncVerificationKeyPrefix          = "volsync-verification/"
+	volSyncVerificationSourceKeyName      = "source-"
+	volSyncVerificationDestinationKeyName = "destination-"
+
+	// volSyncVerificationDivergedFiles is the number of paths of diverged files in the status
+	volSyncVerificationDivergedFiles = 10
+)
+
+// volSyncVerificationManifest is the manifest of the source or the destination of a PVC
+type volSyncVerificationManifest struct {
+	// Error is the reason the destination could not be checksummed
+	Error string `json:"error,omitempty"`
+
+	Manifest volsync.VerificationManifest `json:"manifest,omitempty"`
+}
+
+// volSyncVerifiable returns true if the files of a PVC can be checksummed. Block volumes have
+// no files, and PVCs of a consistency group are replicated by ReplicationGroupSources.
+func (v *VRGInstance) volSyncVerifiable(protectedPVC *ramendrv1alpha1.ProtectedPVC) bool {
+	if protectedPVC.VolumeMode != nil && *protectedPVC.VolumeMode == corev1.PersistentVolumeBlock {
+		return false
+	}
+
+	_, ok := protectedPVC.Labels[util.ConsistencyGroupLabel]
+
+	return !ok || !v.volSyncCGEnabled()
+}
+
+// volSyncVerificationInterval returns the duration of an interval of the scheduling interval form
+func volSyncVerificationInterval(interval string) (time.Duration, error) {
+	if err := schedulingIntervalValidate(interval); err != nil {
+		return 0, err
+	}
+
+	count, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil {
+		return 0, err
+	}
+
+	units := map[byte]time.Duration{'m': time.Minute, 'h': time.Hour, 'd': 24 * time.Hour}
+
+	return time.Duration(count) * units[interval[len(interval)-1]], nil
+}
+
+func (v *VRGInstance) volSyncVerificationPVCKeyPrefix(protectedPVC *ramendrv1alpha1.ProtectedPVC) string {
+	return v.s3KeyPrefix() + volSyncVerificationKeyPrefix + protectedPVC.Namespace + "/" + protectedPVC.Name + "/"
+}
+
+// volSyncVerificationKeyTimes returns the times of the source manifests and of the destination
+// manifests of a PVC in the first S3 store.
+func (v *VRGInstance) volSyncVerificationKeyTimes(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+) ([]int64, []int64, error) {
+	if len(v.s3StoreAccessors) == 0 {
+		return nil, nil, fmt.Errorf("no S3 store")
+	}
+
+	keyPrefix := v.volSyncVerificationPVCKeyPrefix(protectedPVC)
+
+	keys, err := v.s3StoreAccessors[0].ListKeys(keyPrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourceTimes, destinationTimes := []int64{}, []int64{}
+
+	for _, key := range keys {
+		name := strings.TrimPrefix(key, keyPrefix)
+
+		if sourceTime, ok := strings.CutPrefix(name, volSyncVerificationSourceKeyName); ok {
+			if t, err := strconv.ParseInt(sourceTime, 10, 64); err == nil {
+				sourceTimes = append(sourceTimes, t)
+			}
+		}
+
+		if destinationTime, ok := strings.CutPrefix(name, volSyncVerificationDestinationKeyName); ok {
+			if t, err := strconv.ParseInt(destinationTime, 10, 64); err == nil {
+				destinationTimes = append(destinationTimes, t)
+			}
+		}
+	}
+
+	return sourceTimes, destinationTimes, nil
+}
+
+func (v *VRGInstance) volSyncVerificationUpload(protectedPVC *ramendrv1alpha1.ProtectedPVC, keyName string,
+	sourceTime metav1.Time, manifest volSyncVerificationManifest,
+) error {
+	key := v.volSyncVerificationPVCKeyPrefix(protectedPVC) + keyName + strconv.FormatInt(sourceTime.Unix(), 10)
+
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		if err := s3StoreAccessor.UploadObject(key, manifest); err != nil {
+			return fmt.Errorf("failed to upload %s to s3 profile %s: %w", key, s3StoreAccessor.S3ProfileName, err)
+		}
+	}
+
+	return nil
+}
+
+func (v *VRGInstance) volSyncVerificationDownload(protectedPVC *ramendrv1alpha1.ProtectedPVC, keyName string,
+	sourceTime metav1.Time,
+) (*volSyncVerificationManifest, error) {
+	key := v.volSyncVerificationPVCKeyPrefix(protectedPVC) + keyName + strconv.FormatInt(sourceTime.Unix(), 10)
+	manifest := &volSyncVerificationManifest{}
+
+	if err := v.s3StoreAccessors[0].DownloadObject(key, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+func (v *VRGInstance) volSyncVerificationDelete(protectedPVC *ramendrv1alpha1.ProtectedPVC) error {
+	for _, s3StoreAccessor := range v.s3StoreAccessors {
+		if err := s3StoreAccessor.DeleteObjectsWithKeyPrefix(v.volSyncVerificationPVCKeyPrefix(protectedPVC)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileVolSyncVerificationAsPrimary checksums a snapshot of the source of a PVC at the
+// verification interval, and compares it with the checksums of the destination once the
+// secondary uploads them.
+func (v *VRGInstance) reconcileVolSyncVerificationAsPrimary(protectedPVC *ramendrv1alpha1.ProtectedPVC) {
+	verification := v.instance.Spec.VolSync.Verification
+	log := v.log.WithValues("pvc", protectedPVC.Namespace+"/"+protectedPVC.Name)
+
+	if verification == nil || !v.volSyncVerifiable(protectedPVC) || len(v.s3StoreAccessors) == 0 {
+		if protectedPVC.Verification != nil {
+			if err := v.volSyncHandler.CleanupVerification(protectedPVC.Name, protectedPVC.Namespace); err != nil {
+				log.Info("Failed to clean up verification", "error", err.Error())
+
+				return
+			}
+
+			protectedPVC.Verification = nil
+		}
+
+		return
+	}
+
+	interval, err := volSyncVerificationInterval(verification.Interval)
+	if err != nil {
+		log.Info("Invalid verification interval", "error", err.Error())
+
+		return
+	}
+
+	if protectedPVC.Verification == nil {
+		protectedPVC.Verification = &ramendrv1alpha1.VolSyncVerificationStatus{}
+	}
+
+	if protectedPVC.Verification.InProgressSince != nil {
+		v.volSyncVerificationCompare(protectedPVC, interval, log)
+
+		return
+	}
+
+	v.volSyncVerificationChecksumSource(protectedPVC, interval, log)
+}
+
+func (v *VRGInstance) volSyncVerificationChecksumSource(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	interval time.Duration, log logr.Logger,
+) {
+	status := protectedPVC.Verification
+
+	inProgress, err := v.volSyncHandler.IsVerificationInProgress(protectedPVC.Name, protectedPVC.Namespace)
+	if err != nil {
+		log.Info("Failed to get verification", "error", err.Error())
+
+		return
+	}
+
+	if !inProgress && status.Time != nil && time.Since(status.Time.Time) < interval {
+		return
+	}
+
+	result, err := v.volSyncHandler.ReconcileSourceVerification(*protectedPVC,
+		v.instance.Spec.VolSync.Verification.Mode, volSyncVerificationImageOrDefault(v.ramenConfig),
+		v.GetVRGMoverConfig(protectedPVC.Name, protectedPVC.Namespace))
+	if err != nil {
+		log.Info("Failed to checksum the source for verification", "error", err.Error())
+
+		if errors.Is(err, volsync.ErrVerificationFailed) {
+			now := metav1.Now()
+			v.volSyncVerificationCompleted(protectedPVC, &now, ramendrv1alpha1.VolSyncVerificationFailed,
+				"Source: "+err.Error())
+		}
+
+		return
+	}
+
+	if result == nil {
+		return
+	}
+
+	if err := v.volSyncVerificationDelete(protectedPVC); err != nil {
+		log.Info("Failed to delete previous verification manifests", "error", err.Error())
+
+		return
+	}
+
+	if err := v.volSyncVerificationUpload(protectedPVC, volSyncVerificationSourceKeyName, result.Time,
+		volSyncVerificationManifest{Manifest: result.Manifest}); err != nil {
+		log.Info("Failed to upload the source verification manifest", "error", err.Error())
+
+		return
+	}
+
+	log.Info("Source checksummed for verification", "time", result.Time, "files", len(result.Manifest))
+
+	status.InProgressSince = &result.Time
+
+	if err := v.volSyncHandler.CleanupVerification(protectedPVC.Name, protectedPVC.Namespace); err != nil {
+		log.Info("Failed to clean up verification", "error", err.Error())
+	}
+}
+
+func (v *VRGInstance) volSyncVerificationCompare(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	interval time.Duration, log logr.Logger,
+) {
+	sourceTime := *protectedPVC.Verification.InProgressSince
+
+	_, destinationTimes, err := v.volSyncVerificationKeyTimes(protectedPVC)
+	if err != nil {
+		log.Info("Failed to list verification manifests", "error", err.Error())
+
+		return
+	}
+
+	if !slices.Contains(destinationTimes, sourceTime.Unix()) {
+		if time.Since(sourceTime.Time) > interval {
+			v.volSyncVerificationCompleted(protectedPVC, &sourceTime, ramendrv1alpha1.VolSyncVerificationFailed,
+				"Destination was not checksummed within the verification interval")
+		}
+
+		return
+	}
+
+	destination, err := v.volSyncVerificationDownload(protectedPVC, volSyncVerificationDestinationKeyName, sourceTime)
+	if err != nil {
+		log.Info("Failed to download the destination verification manifest", "error", err.Error())
+
+		return
+	}
+
+	if destination.Error != "" {
+		v.volSyncVerificationCompleted(protectedPVC, &sourceTime, ramendrv1alpha1.VolSyncVerificationFailed,
+			"Destination: "+destination.Error)
+
+		return
+	}
+
+	source, err := v.volSyncVerificationDownload(protectedPVC, volSyncVerificationSourceKeyName, sourceTime)
+	if err != nil {
+		log.Info("Failed to download the source verification manifest", "error", err.Error())
+
+		return
+	}
+
+	compared, diverged := volsync.CompareVerificationManifests(source.Manifest, destination.Manifest)
+
+	protectedPVC.Verification.FilesCompared = compared
+	protectedPVC.Verification.DivergedFiles = diverged[:min(len(diverged), volSyncVerificationDivergedFiles)]
+
+	if len(diverged) > 0 {
+		v.volSyncVerificationCompleted(protectedPVC, &sourceTime, ramendrv1alpha1.VolSyncVerificationDiverged,
+			fmt.Sprintf("%d of %d files compared have different checksums", len(diverged), compared))
+	} else {
+		v.volSyncVerificationCompleted(protectedPVC, &sourceTime, ramendrv1alpha1.VolSyncVerificationVerified,
+			fmt.Sprintf("%d files compared have the same checksums", compared))
+	}
+
+	log.Info("Verified destination", "result", protectedPVC.Verification.Result, "filesCompared", compared,
+		"divergedFiles", len(diverged))
+
+	if err := v.volSyncVerificationDelete(protectedPVC); err != nil {
+		log.Info("Failed to delete verification manifests", "error", err.Error())
+	}
+}
+
+func (v *VRGInstance) volSyncVerificationCompleted(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	sourceTime *metav1.Time, result ramendrv1alpha1.VolSyncVerificationResult, message string,
+) {
+	status := protectedPVC.Verification
+
+	if result != ramendrv1alpha1.VolSyncVerificationVerified && result != ramendrv1alpha1.VolSyncVerificationDiverged {
+		status.FilesCompared = 0
+		status.DivergedFiles = nil
+	}
+
+	status.Time = sourceTime
+	status.Result = result
+	status.Message = message
+	status.InProgressSince = nil
+
+	if result == ramendrv1alpha1.VolSyncVerificationFailed {
+		if err := v.volSyncHandler.CleanupVerification(protectedPVC.Name, protectedPVC.Namespace); err != nil {
+			v.log.Info("Failed to clean up verification", "pvc", protectedPVC.Name, "error", err.Error())
+		}
+	}
+}
+
+// reconcileVolSyncVerificationsAsSecondary checksums the latest images of the destinations of
+// the PVCs whose sources were checksummed by the primary, and uploads their manifests.
+func (v *VRGInstance) reconcileVolSyncVerificationsAsSecondary() {
+	verification := v.instance.Spec.VolSync.Verification
+	if verification == nil {
+		return
+	}
+
+	for idx := range v.instance.Spec.VolSync.RDSpec {
+		protectedPVC := &v.instance.Spec.VolSync.RDSpec[idx].ProtectedPVC
+		if !v.volSyncVerifiable(protectedPVC) {
+			continue
+		}
+
+		v.reconcileVolSyncVerificationAsSecondary(protectedPVC, verification)
+	}
+}
+
+func (v *VRGInstance) reconcileVolSyncVerificationAsSecondary(protectedPVC *ramendrv1alpha1.ProtectedPVC,
+	verification *ramendrv1alpha1.VolSyncVerification,
+) {
+	log := v.log.WithValues("pvc", protectedPVC.Namespace+"/"+protectedPVC.Name)
+
+	sourceTimes, destinationTimes, err := v.volSyncVerificationKeyTimes(protectedPVC)
+	if err != nil {
+		log.Info("Failed to list verification manifests", "error", err.Error())
+
+		return
+	}
+
+	if len(sourceTimes) == 0 || slices.Contains(destinationTimes, slices.Max(sourceTimes)) {
+		return
+	}
+
+	sourceTime := metav1.NewTime(time.Unix(slices.Max(sourceTimes), 0))
+	manifest := volSyncVerificationManifest{}
+
+	result, err := v.volSyncHandler.ReconcileDestinationVerification(*protectedPVC, sourceTime, verification.Mode,
+		volSyncVerificationImageOrDefault(v.ramenConfig),
+		v.GetVRGMoverConfig(protectedPVC.Name, protectedPVC.Namespace))
+
+	switch {
+	case errors.Is(err, volsync.ErrVerificationFailed):
+		manifest.Error = err.Error()
+	case err != nil:
+		log.Info("Failed to checksum the destination for verification", "error", err.Error())
+
+		return
+	case result == nil:
+		return
+	default:
+		manifest.Manifest = result.Manifest
+	}
+
+	if err := v.volSyncVerificationUpload(protectedPVC, volSyncVerificationDestinationKeyName, sourceTime,
+		manifest); err != nil {
+		log.Info("Failed to upload the destination verification manifest", "error", err.Error())
+
+		return
+	}
+
+	log.Info("Destination checksummed for verification", "sourceTime", sourceTime, "files", len(manifest.Manifest),
+		"error", manifest.Error)
+
+	if err := v.volSyncHandler.CleanupVerification(protectedPVC.Name, protectedPVC.Namespace); err != nil {
+		log.Info("Failed to clean up verification", "error", err.Error())
+	}
+}
+
+// aggregateVolSyncDataVerifiedCondition returns the DataVerified condition of the verifications of
+// the PVCs, or nil if they are not verified.
+func (v *VRGInstance) aggregateVolSyncDataVerifiedCondition() *metav1.Condition {
+	if v.instance.Spec.VolSync.Verification == nil || v.instance.Spec.ReplicationState != ramendrv1alpha1.Primary {
+		return nil
+	}
+
+	diverged, failed, verified := []string{}, []string{}, 0
+
+	for _, protectedPVC := range v.instance.Status.ProtectedPVCs {
+		if protectedPVC.Verification == nil {
+			continue
+		}
+
+		switch protectedPVC.Verification.Result {
+		case ramendrv1alpha1.VolSyncVerificationDiverged:
+			diverged = append(diverged, protectedPVC.Name)
+		case ramendrv1alpha1.VolSyncVerificationFailed:
+			failed = append(failed, protectedPVC.Name)
+		case ramendrv1alpha1.VolSyncVerificationVerified:
+			verified++
+		}
+	}
+
+	switch {
+	case len(diverged) > 0:
+		return newVRGDataVerifiedCondition(v.instance.Generation, metav1.ConditionFalse,
+			VRGConditionReasonDataDiverged, fmt.Sprintf("Destinations of PVCs %v diverged from their sources", diverged))
+	case len(failed) > 0:
+		return newVRGDataVerifiedCondition(v.instance.Generation, metav1.ConditionUnknown,
+			VRGConditionReasonVerificationFailed, fmt.Sprintf("Verifications of PVCs %v failed", failed))
+	case verified > 0:
+		return newVRGDataVerifiedCondition(v.instance.Generation, metav1.ConditionTrue,
+			VRGConditionReasonDataVerified, fmt.Sprintf("Destinations of %d PVCs verified", verified))
+	default:
+		return newVRGDataVerifiedCondition(v.instance.Generation, metav1.ConditionUnknown,
+			VRGConditionReasonInitializing, "No PVC verified yet")
+	}
+}
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: The RamenDR authors
+// SPDX-License-Identifier: Apache-2.0
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"io/fs"
+	"strings"
+	"time"
+
+	snapv1 "github.com/kubernetes-csi/external-snapshotter/client/v8/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ramendrv1alpha1 "github.com/ramendr/ramen/api/v1alpha1"
+	"github.com/ramendr/ramen/internal/controller/volsync"
+)
+
+// memoryObjectStorer keeps the objects uploaded to it in memory, as json
+type memoryObjectStorer map[string][]byte
+
+func (m memoryObjectStorer) UploadObject(key string, object interface{}) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return err
+	}
+
+	m[key] = data
+
+	return nil
+}
+
+func (m memoryObjectStorer) DownloadObject(key string, objectPointer interface{}) error {
+	data, ok := m[key]
+	if !ok {
+		return fs.ErrNotExist
+	}
+
+	return json.Unmarshal(data, objectPointer)
+}
+
+func (m memoryObjectStorer) ListKeys(keyPrefix string) ([]string, error) {
+	keys := []string{}
+
+	for key := range m {
+		if strings.HasPrefix(key, keyPrefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, nil
+}
+
+func (m memoryObjectStorer) DeleteObject(key string) error {
+	delete(m, key)
+
+	return nil
+}
+
+func (m memoryObjectStorer) DeleteObjects(keys ...string) error {
+	for _, key := range keys {
+		delete(m, key)
+	}
+
+	return nil
+}
+
+func (m memoryObjectStorer) DeleteObjectsWithKeyPrefix(keyPrefix string) error {
+	for key := range m {
+		if strings.HasPrefix(key, keyPrefix) {
+			delete(m, key)
+		}
+	}
+
+	return nil
+}
+
+var _ = Describe("VolSync verification results", func() {
+	var (
+		v            *VRGInstance
+		objectStorer memoryObjectStorer
+		protectedPVC *ramendrv1alpha1.ProtectedPVC
+		sourceTime   metav1.Time
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(snapv1.AddToScheme(scheme)).To(Succeed())
+
+		instance := &ramendrv1alpha1.VolumeReplicationGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: "vrg", Namespace: "app", Generation: 2},
+			Spec: ramendrv1alpha1.VolumeReplicationGroupSpec{
+				ReplicationState: ramendrv1alpha1.Primary,
+				VolSync: ramendrv1alpha1.VolSyncSpec{
+					Verification: &ramendrv1alpha1.VolSyncVerification{Interval: "1h"},
+				},
+			},
+		}
+		objectStorer = memoryObjectStorer{}
+		v = &VRGInstance{
+			ctx:            context.TODO(),
+			log:            ctrl.Log.WithName("test"),
+			instance:       instance,
+			namespacedName: instance.Namespace + "/" + instance.Name,
+			s3StoreAccessors: []s3StoreAccessor{
+				{ObjectStorer: objectStorer, S3StoreProfile: ramendrv1alpha1.S3StoreProfile{S3ProfileName: "s3"}},
+			},
+			volSyncHandler: volsync.NewVSHandler(context.TODO(),
+				fake.NewClientBuilder().WithScheme(scheme).Build(), ctrl.Log.WithName("test"), instance, nil, "", "",
+				false),
+		}
+
+		sourceTime = metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+		protectedPVC = &ramendrv1alpha1.ProtectedPVC{
+			Name:         "pvc",
+			Namespace:    "app",
+			Verification: &ramendrv1alpha1.VolSyncVerificationStatus{InProgressSince: &sourceTime},
+		}
+	})
+
+	upload := func(keyName string, manifest volSyncVerificationManifest) {
+		Expect(v.volSyncVerificationUpload(protectedPVC, keyName, sourceTime, manifest)).To(Succeed())
+	}
+
+	compare := func() *ramendrv1alpha1.VolSyncVerificationStatus {
+		v.volSyncVerificationCompare(protectedPVC, time.Hour, v.log)
+
+		return protectedPVC.Verification
+	}
+
+	source := volsync.VerificationManifest{
+		"./a": {ModTime: 1, Size: 1, Checksum: "a"},
+		"./b": {ModTime: 1, Size: 1, Checksum: "b"},
+	}
+
+	It("Should wait for the destination within the verification interval", func() {
+		upload(volSyncVerificationSourceKeyName, volSyncVerificationManifest{Manifest: source})
+
+		status := compare()
+		Expect(status.InProgressSince).To(Equal(&sourceTime))
+		Expect(status.Result).To(BeEmpty())
+	})
+
+	It("Should fail when the destination is not checksummed within the verification interval", func() {
+		sourceTime = metav1.NewTime(time.Now().Add(-2 * time.Hour).Truncate(time.Second))
+		protectedPVC.Verification.InProgressSince = &sourceTime
+
+		status := compare()
+		Expect(status.InProgressSince).To(BeNil())
+		Expect(status.Result).To(Equal(ramendrv1alpha1.VolSyncVerificationFailed))
+		Expect(status.Message).To(ContainSubstring("within the verification interval"))
+	})
+
+	It("Should fail when the destination fails to be checksummed", func() {
+		upload(volSyncVerificationSourceKeyName, volSyncVerificationManifest{Manifest: source})
+		upload(volSyncVerificationDestinationKeyName, volSyncVerificationManifest{Error: "malformed line 2"})
+
+		status := compare()
+		Expect(status.Result).To(Equal(ramendrv1alpha1.VolSyncVerificationFailed))
+		Expect(status.Message).To(Equal("Destination: malformed line 2"))
+		Expect(status.FilesCompared).To(BeZero())
+	})
+
+	It("Should verify a destination with the same checksums", func() {
+		upload(volSyncVerificationSourceKeyName, volSyncVerificationManifest{Manifest: source})
+		upload(volSyncVerificationDestinationKeyName, volSyncVerificationManifest{Manifest: source})
+
+		status := compare()
+		Expect(status.Result).To(Equal(ramendrv1alpha1.VolSyncVerificationVerified))
+		Expect(status.Time).To(Equal(&sourceTime))
+		Expect(status.FilesCompared).To(Equal(2))
+		Expect(status.DivergedFiles).To(BeEmpty())
+		Expect(objectStorer).To(BeEmpty())
+	})
+
+	It("Should report the diverged files of a destination", func() {
+		upload(volSyncVerificationSourceKeyName, volSyncVerificationManifest{Manifest: source})
+		upload(volSyncVerificationDestinationKeyName, volSyncVerificationManifest{
+			Manifest: volsync.VerificationManifest{
+				"./a": {ModTime: 1, Size: 1, Checksum: "a"},
+				"./b": {ModTime: 1, Size: 1, Checksum: "c"},
+			},
+		})
+
+		status := compare()
+		Expect(status.Result).To(Equal(ramendrv1alpha1.VolSyncVerificationDiverged))
+		Expect(status.FilesCompared).To(Equal(2))
+		Expect(status.DivergedFiles).To(Equal([]string{"./b"}))
+		Expect(status.Message).To(Equal("1 of 2 files compared have different checksums"))
+	})
+
+	It("Should clear the files compared of a failed verification", func() {
+		protectedPVC.Verification.FilesCompared = 2
+		protectedPVC.Verification.DivergedFiles = []string{"./b"}
+
+		v.volSyncVerificationCompleted(protectedPVC, &sourceTime, ramendrv1alpha1.VolSyncVerificationFailed,
+			"Source: verification job failed")
+		Expect(protectedPVC.Verification.FilesCompared).To(BeZero())
+		Expect(protectedPVC.Verification.DivergedFiles).To(BeNil())
+	})
+
+	DescribeTable("Should aggregate the results in the DataVerified condition",
+		func(results []ramendrv1alpha1.VolSyncVerificationResult, status metav1.ConditionStatus, reason string) {
+			for _, result := range results {
+				v.instance.Status.ProtectedPVCs = append(v.instance.Status.ProtectedPVCs, ramendrv1alpha1.ProtectedPVC{
+					Name:         string(result),
+					Verification: &ramendrv1alpha1.VolSyncVerificationStatus{Result: result},
+				})
+			}
+
+			condition := v.aggregateVolSyncDataVerifiedCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(status))
+			Expect(condition.Reason).To(Equal(reason))
+			Expect(condition.ObservedGeneration).To(Equal(v.instance.Generation))
+		},
+		Entry("without results", nil, metav1.ConditionUnknown, VRGConditionReasonInitializing),
+		Entry("of verified PVCs", []ramendrv1alpha1.VolSyncVerificationResult{
+			ramendrv1alpha1.VolSyncVerificationVerified, ramendrv1alpha1.VolSyncVerificationVerified,
+		}, metav1.ConditionTrue, VRGConditionReasonDataVerified),
+		Entry("with a failed verification", []ramendrv1alpha1.VolSyncVerificationResult{
+			ramendrv1alpha1.VolSyncVerificationVerified, ramendrv1alpha1.VolSyncVerificationFailed,
+		}, metav1.ConditionUnknown, VRGConditionReasonVerificationFailed),
+		Entry("with a diverged PVC", []ramendrv1alpha1.VolSyncVerificationResult{
+			ramendrv1alpha1.VolSyncVerificationFailed, ramendrv1alpha1.VolSyncVerificationDiverged,
+		}, metav1.ConditionFalse, VRGConditionReasonDataDiverged),
+	)
+
+	It("Should not report the DataVerified condition of a secondary", func() {
+		v.instance.Spec.ReplicationState = ramendrv1alpha1.Secondary
+
+		Expect(v.aggregateVolSyncDataVerifiedCondition()).To(BeNil())
+	})
+})
@@ -40,7 +40,12 @@ func (v *VRGInstance) vrgObjectProtectThrottled(result *ctrl.Result,
 	for _, s3StoreAccessor := range v.s3StoreAccessors {
 		log1 := log.WithValues("profile", s3StoreAccessor.S3ProfileName)
 
-		if err := VrgObjectProtect(s3StoreAccessor.ObjectStorer, *vrg); err != nil {
+		err := VrgObjectProtect(s3StoreAccessor.ObjectStorer, *vrg)
+		if err == nil && v.protectOnly() {
+			err = protectOnlyPVCsProtect(s3StoreAccessor.ObjectStorer, *vrg)
+		}
+
+		if err != nil {
 			util.ReportIfNotPresent(
 				eventReporter, vrg, corev1.EventTypeWarning, util.EventReasonVrgUploadFailed, err.Error(),
 			)